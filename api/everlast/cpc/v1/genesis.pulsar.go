@@ -13,11 +13,167 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*CustomPrecompiledContractMeta
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMeta)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMeta)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(CustomPrecompiledContractMeta)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(CustomPrecompiledContractMeta)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*GenesisErc20Allowance
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisErc20Allowance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisErc20Allowance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisErc20Allowance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(GenesisErc20Allowance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*GenesisErc20PermitNonce
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisErc20PermitNonce)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisErc20PermitNonce)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisErc20PermitNonce)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(GenesisErc20PermitNonce)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                         protoreflect.MessageDescriptor
 	fd_GenesisState_params                  protoreflect.FieldDescriptor
 	fd_GenesisState_deploy_erc20_native     protoreflect.FieldDescriptor
 	fd_GenesisState_deploy_staking_contract protoreflect.FieldDescriptor
+	fd_GenesisState_deployed_contracts      protoreflect.FieldDescriptor
+	fd_GenesisState_erc20_allowances        protoreflect.FieldDescriptor
+	fd_GenesisState_erc20_permit_nonces     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +182,9 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_deploy_erc20_native = md_GenesisState.Fields().ByName("deploy_erc20_native")
 	fd_GenesisState_deploy_staking_contract = md_GenesisState.Fields().ByName("deploy_staking_contract")
+	fd_GenesisState_deployed_contracts = md_GenesisState.Fields().ByName("deployed_contracts")
+	fd_GenesisState_erc20_allowances = md_GenesisState.Fields().ByName("erc20_allowances")
+	fd_GenesisState_erc20_permit_nonces = md_GenesisState.Fields().ByName("erc20_permit_nonces")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -111,6 +270,24 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.DeployedContracts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DeployedContracts})
+		if !f(fd_GenesisState_deployed_contracts, value) {
+			return
+		}
+	}
+	if len(x.Erc20Allowances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Erc20Allowances})
+		if !f(fd_GenesisState_erc20_allowances, value) {
+			return
+		}
+	}
+	if len(x.Erc20PermitNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Erc20PermitNonces})
+		if !f(fd_GenesisState_erc20_permit_nonces, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -132,6 +309,12 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.DeployErc20Native != false
 	case "everlast.cpc.v1.GenesisState.deploy_staking_contract":
 		return x.DeployStakingContract != false
+	case "everlast.cpc.v1.GenesisState.deployed_contracts":
+		return len(x.DeployedContracts) != 0
+	case "everlast.cpc.v1.GenesisState.erc20_allowances":
+		return len(x.Erc20Allowances) != 0
+	case "everlast.cpc.v1.GenesisState.erc20_permit_nonces":
+		return len(x.Erc20PermitNonces) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisState"))
@@ -154,6 +337,12 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.DeployErc20Native = false
 	case "everlast.cpc.v1.GenesisState.deploy_staking_contract":
 		x.DeployStakingContract = false
+	case "everlast.cpc.v1.GenesisState.deployed_contracts":
+		x.DeployedContracts = nil
+	case "everlast.cpc.v1.GenesisState.erc20_allowances":
+		x.Erc20Allowances = nil
+	case "everlast.cpc.v1.GenesisState.erc20_permit_nonces":
+		x.Erc20PermitNonces = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisState"))
@@ -179,6 +368,24 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "everlast.cpc.v1.GenesisState.deploy_staking_contract":
 		value := x.DeployStakingContract
 		return protoreflect.ValueOfBool(value)
+	case "everlast.cpc.v1.GenesisState.deployed_contracts":
+		if len(x.DeployedContracts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.DeployedContracts}
+		return protoreflect.ValueOfList(listValue)
+	case "everlast.cpc.v1.GenesisState.erc20_allowances":
+		if len(x.Erc20Allowances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Erc20Allowances}
+		return protoreflect.ValueOfList(listValue)
+	case "everlast.cpc.v1.GenesisState.erc20_permit_nonces":
+		if len(x.Erc20PermitNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Erc20PermitNonces}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisState"))
@@ -205,6 +412,18 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.DeployErc20Native = value.Bool()
 	case "everlast.cpc.v1.GenesisState.deploy_staking_contract":
 		x.DeployStakingContract = value.Bool()
+	case "everlast.cpc.v1.GenesisState.deployed_contracts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.DeployedContracts = *clv.list
+	case "everlast.cpc.v1.GenesisState.erc20_allowances":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Erc20Allowances = *clv.list
+	case "everlast.cpc.v1.GenesisState.erc20_permit_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Erc20PermitNonces = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisState"))
@@ -230,6 +449,24 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "everlast.cpc.v1.GenesisState.deployed_contracts":
+		if x.DeployedContracts == nil {
+			x.DeployedContracts = []*CustomPrecompiledContractMeta{}
+		}
+		value := &_GenesisState_4_list{list: &x.DeployedContracts}
+		return protoreflect.ValueOfList(value)
+	case "everlast.cpc.v1.GenesisState.erc20_allowances":
+		if x.Erc20Allowances == nil {
+			x.Erc20Allowances = []*GenesisErc20Allowance{}
+		}
+		value := &_GenesisState_5_list{list: &x.Erc20Allowances}
+		return protoreflect.ValueOfList(value)
+	case "everlast.cpc.v1.GenesisState.erc20_permit_nonces":
+		if x.Erc20PermitNonces == nil {
+			x.Erc20PermitNonces = []*GenesisErc20PermitNonce{}
+		}
+		value := &_GenesisState_6_list{list: &x.Erc20PermitNonces}
+		return protoreflect.ValueOfList(value)
 	case "everlast.cpc.v1.GenesisState.deploy_erc20_native":
 		panic(fmt.Errorf("field deploy_erc20_native of message everlast.cpc.v1.GenesisState is not mutable"))
 	case "everlast.cpc.v1.GenesisState.deploy_staking_contract":
@@ -254,6 +491,15 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfBool(false)
 	case "everlast.cpc.v1.GenesisState.deploy_staking_contract":
 		return protoreflect.ValueOfBool(false)
+	case "everlast.cpc.v1.GenesisState.deployed_contracts":
+		list := []*CustomPrecompiledContractMeta{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "everlast.cpc.v1.GenesisState.erc20_allowances":
+		list := []*GenesisErc20Allowance{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "everlast.cpc.v1.GenesisState.erc20_permit_nonces":
+		list := []*GenesisErc20PermitNonce{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisState"))
@@ -333,6 +579,24 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.DeployStakingContract {
 			n += 2
 		}
+		if len(x.DeployedContracts) > 0 {
+			for _, e := range x.DeployedContracts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Erc20Allowances) > 0 {
+			for _, e := range x.Erc20Allowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Erc20PermitNonces) > 0 {
+			for _, e := range x.Erc20PermitNonces {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -362,6 +626,54 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Erc20PermitNonces) > 0 {
+			for iNdEx := len(x.Erc20PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Erc20PermitNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Erc20Allowances) > 0 {
+			for iNdEx := len(x.Erc20Allowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Erc20Allowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.DeployedContracts) > 0 {
+			for iNdEx := len(x.DeployedContracts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DeployedContracts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.DeployStakingContract {
 			i--
 			if x.DeployStakingContract {
@@ -521,6 +833,108 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.DeployStakingContract = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeployedContracts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeployedContracts = append(x.DeployedContracts, &CustomPrecompiledContractMeta{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeployedContracts[len(x.DeployedContracts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Allowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Allowances = append(x.Erc20Allowances, &GenesisErc20Allowance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Erc20Allowances[len(x.Erc20Allowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20PermitNonces", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20PermitNonces = append(x.Erc20PermitNonces, &GenesisErc20PermitNonce{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Erc20PermitNonces[len(x.Erc20PermitNonces)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -556,122 +970,80 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Params_2_list)(nil)
+var (
+	md_GenesisErc20Allowance                  protoreflect.MessageDescriptor
+	fd_GenesisErc20Allowance_owner            protoreflect.FieldDescriptor
+	fd_GenesisErc20Allowance_spender          protoreflect.FieldDescriptor
+	fd_GenesisErc20Allowance_amount           protoreflect.FieldDescriptor
+	fd_GenesisErc20Allowance_contract_address protoreflect.FieldDescriptor
+)
 
-type _Params_2_list struct {
-	list *[]string
+func init() {
+	file_everlast_cpc_v1_genesis_proto_init()
+	md_GenesisErc20Allowance = File_everlast_cpc_v1_genesis_proto.Messages().ByName("GenesisErc20Allowance")
+	fd_GenesisErc20Allowance_owner = md_GenesisErc20Allowance.Fields().ByName("owner")
+	fd_GenesisErc20Allowance_spender = md_GenesisErc20Allowance.Fields().ByName("spender")
+	fd_GenesisErc20Allowance_amount = md_GenesisErc20Allowance.Fields().ByName("amount")
+	fd_GenesisErc20Allowance_contract_address = md_GenesisErc20Allowance.Fields().ByName("contract_address")
 }
 
-func (x *_Params_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_GenesisErc20Allowance)(nil)
 
-func (x *_Params_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_GenesisErc20Allowance GenesisErc20Allowance
 
-func (x *_Params_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *GenesisErc20Allowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisErc20Allowance)(x)
 }
 
-func (x *_Params_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *GenesisErc20Allowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_Params_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field WhitelistedDeployers as it is not of Message kind"))
-}
-
-func (x *_Params_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_Params_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_protocol_version      protoreflect.FieldDescriptor
-	fd_Params_whitelisted_deployers protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_everlast_cpc_v1_genesis_proto_init()
-	md_Params = File_everlast_cpc_v1_genesis_proto.Messages().ByName("Params")
-	fd_Params_protocol_version = md_Params.Fields().ByName("protocol_version")
-	fd_Params_whitelisted_deployers = md_Params.Fields().ByName("whitelisted_deployers")
-}
-
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
-
-type fastReflection_Params Params
-
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
-}
-
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_GenesisErc20Allowance_messageType fastReflection_GenesisErc20Allowance_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisErc20Allowance_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_GenesisErc20Allowance_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_GenesisErc20Allowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisErc20Allowance)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_GenesisErc20Allowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisErc20Allowance)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_GenesisErc20Allowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisErc20Allowance
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_GenesisErc20Allowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisErc20Allowance
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_GenesisErc20Allowance) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisErc20Allowance_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_GenesisErc20Allowance) New() protoreflect.Message {
+	return new(fastReflection_GenesisErc20Allowance)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_GenesisErc20Allowance) Interface() protoreflect.ProtoMessage {
+	return (*GenesisErc20Allowance)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -679,16 +1051,28 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProtocolVersion != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ProtocolVersion)
-		if !f(fd_Params_protocol_version, value) {
+func (x *fastReflection_GenesisErc20Allowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_GenesisErc20Allowance_owner, value) {
 			return
 		}
 	}
-	if len(x.WhitelistedDeployers) != 0 {
-		value := protoreflect.ValueOfList(&_Params_2_list{list: &x.WhitelistedDeployers})
-		if !f(fd_Params_whitelisted_deployers, value) {
+	if x.Spender != "" {
+		value := protoreflect.ValueOfString(x.Spender)
+		if !f(fd_GenesisErc20Allowance_spender, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_GenesisErc20Allowance_amount, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_GenesisErc20Allowance_contract_address, value) {
 			return
 		}
 	}
@@ -705,17 +1089,21 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GenesisErc20Allowance) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "everlast.cpc.v1.Params.protocol_version":
-		return x.ProtocolVersion != uint32(0)
-	case "everlast.cpc.v1.Params.whitelisted_deployers":
-		return len(x.WhitelistedDeployers) != 0
+	case "everlast.cpc.v1.GenesisErc20Allowance.owner":
+		return x.Owner != ""
+	case "everlast.cpc.v1.GenesisErc20Allowance.spender":
+		return x.Spender != ""
+	case "everlast.cpc.v1.GenesisErc20Allowance.amount":
+		return x.Amount != ""
+	case "everlast.cpc.v1.GenesisErc20Allowance.contract_address":
+		return x.ContractAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20Allowance"))
 		}
-		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20Allowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -725,17 +1113,21 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GenesisErc20Allowance) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "everlast.cpc.v1.Params.protocol_version":
-		x.ProtocolVersion = uint32(0)
-	case "everlast.cpc.v1.Params.whitelisted_deployers":
-		x.WhitelistedDeployers = nil
+	case "everlast.cpc.v1.GenesisErc20Allowance.owner":
+		x.Owner = ""
+	case "everlast.cpc.v1.GenesisErc20Allowance.spender":
+		x.Spender = ""
+	case "everlast.cpc.v1.GenesisErc20Allowance.amount":
+		x.Amount = ""
+	case "everlast.cpc.v1.GenesisErc20Allowance.contract_address":
+		x.ContractAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20Allowance"))
 		}
-		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20Allowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -745,22 +1137,25 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisErc20Allowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "everlast.cpc.v1.Params.protocol_version":
-		value := x.ProtocolVersion
-		return protoreflect.ValueOfUint32(value)
-	case "everlast.cpc.v1.Params.whitelisted_deployers":
-		if len(x.WhitelistedDeployers) == 0 {
-			return protoreflect.ValueOfList(&_Params_2_list{})
-		}
-		listValue := &_Params_2_list{list: &x.WhitelistedDeployers}
-		return protoreflect.ValueOfList(listValue)
+	case "everlast.cpc.v1.GenesisErc20Allowance.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.GenesisErc20Allowance.spender":
+		value := x.Spender
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.GenesisErc20Allowance.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.GenesisErc20Allowance.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20Allowance"))
 		}
-		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20Allowance does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -774,19 +1169,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GenesisErc20Allowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "everlast.cpc.v1.Params.protocol_version":
-		x.ProtocolVersion = uint32(value.Uint())
-	case "everlast.cpc.v1.Params.whitelisted_deployers":
-		lv := value.List()
-		clv := lv.(*_Params_2_list)
-		x.WhitelistedDeployers = *clv.list
+	case "everlast.cpc.v1.GenesisErc20Allowance.owner":
+		x.Owner = value.Interface().(string)
+	case "everlast.cpc.v1.GenesisErc20Allowance.spender":
+		x.Spender = value.Interface().(string)
+	case "everlast.cpc.v1.GenesisErc20Allowance.amount":
+		x.Amount = value.Interface().(string)
+	case "everlast.cpc.v1.GenesisErc20Allowance.contract_address":
+		x.ContractAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20Allowance"))
 		}
-		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20Allowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -800,49 +1197,52 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisErc20Allowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "everlast.cpc.v1.Params.whitelisted_deployers":
-		if x.WhitelistedDeployers == nil {
-			x.WhitelistedDeployers = []string{}
-		}
-		value := &_Params_2_list{list: &x.WhitelistedDeployers}
-		return protoreflect.ValueOfList(value)
-	case "everlast.cpc.v1.Params.protocol_version":
-		panic(fmt.Errorf("field protocol_version of message everlast.cpc.v1.Params is not mutable"))
+	case "everlast.cpc.v1.GenesisErc20Allowance.owner":
+		panic(fmt.Errorf("field owner of message everlast.cpc.v1.GenesisErc20Allowance is not mutable"))
+	case "everlast.cpc.v1.GenesisErc20Allowance.spender":
+		panic(fmt.Errorf("field spender of message everlast.cpc.v1.GenesisErc20Allowance is not mutable"))
+	case "everlast.cpc.v1.GenesisErc20Allowance.amount":
+		panic(fmt.Errorf("field amount of message everlast.cpc.v1.GenesisErc20Allowance is not mutable"))
+	case "everlast.cpc.v1.GenesisErc20Allowance.contract_address":
+		panic(fmt.Errorf("field contract_address of message everlast.cpc.v1.GenesisErc20Allowance is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20Allowance"))
 		}
-		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20Allowance does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisErc20Allowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "everlast.cpc.v1.Params.protocol_version":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "everlast.cpc.v1.Params.whitelisted_deployers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
+	case "everlast.cpc.v1.GenesisErc20Allowance.owner":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.GenesisErc20Allowance.spender":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.GenesisErc20Allowance.amount":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.GenesisErc20Allowance.contract_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20Allowance"))
 		}
-		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20Allowance does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GenesisErc20Allowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.GenesisErc20Allowance", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -850,7 +1250,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GenesisErc20Allowance) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -861,7 +1261,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GenesisErc20Allowance) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -873,7 +1273,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_GenesisErc20Allowance) IsValid() bool {
 	return x != nil
 }
 
@@ -883,9 +1283,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GenesisErc20Allowance) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*GenesisErc20Allowance)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -897,14 +1297,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProtocolVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProtocolVersion))
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.WhitelistedDeployers) > 0 {
-			for _, s := range x.WhitelistedDeployers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Spender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -916,7 +1323,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*GenesisErc20Allowance)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -935,19 +1342,33 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.WhitelistedDeployers) > 0 {
-			for iNdEx := len(x.WhitelistedDeployers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.WhitelistedDeployers[iNdEx])
-				copy(dAtA[i:], x.WhitelistedDeployers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WhitelistedDeployers[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x22
 		}
-		if x.ProtocolVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolVersion))
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x1a
+		}
+		if len(x.Spender) > 0 {
+			i -= len(x.Spender)
+			copy(dAtA[i:], x.Spender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Spender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -960,7 +1381,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*GenesisErc20Allowance)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -992,17 +1413,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisErc20Allowance: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisErc20Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 				}
-				x.ProtocolVersion = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1012,14 +1433,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProtocolVersion |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1047,7 +1481,71 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WhitelistedDeployers = append(x.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+				x.Spender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1084,11 +1582,2481 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: everlast/cpc/v1/genesis.proto
+var (
+	md_GenesisErc20PermitNonce                  protoreflect.MessageDescriptor
+	fd_GenesisErc20PermitNonce_contract_address protoreflect.FieldDescriptor
+	fd_GenesisErc20PermitNonce_owner            protoreflect.FieldDescriptor
+	fd_GenesisErc20PermitNonce_nonce            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_genesis_proto_init()
+	md_GenesisErc20PermitNonce = File_everlast_cpc_v1_genesis_proto.Messages().ByName("GenesisErc20PermitNonce")
+	fd_GenesisErc20PermitNonce_contract_address = md_GenesisErc20PermitNonce.Fields().ByName("contract_address")
+	fd_GenesisErc20PermitNonce_owner = md_GenesisErc20PermitNonce.Fields().ByName("owner")
+	fd_GenesisErc20PermitNonce_nonce = md_GenesisErc20PermitNonce.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisErc20PermitNonce)(nil)
+
+type fastReflection_GenesisErc20PermitNonce GenesisErc20PermitNonce
+
+func (x *GenesisErc20PermitNonce) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisErc20PermitNonce)(x)
+}
+
+func (x *GenesisErc20PermitNonce) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisErc20PermitNonce_messageType fastReflection_GenesisErc20PermitNonce_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisErc20PermitNonce_messageType{}
+
+type fastReflection_GenesisErc20PermitNonce_messageType struct{}
+
+func (x fastReflection_GenesisErc20PermitNonce_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisErc20PermitNonce)(nil)
+}
+func (x fastReflection_GenesisErc20PermitNonce_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisErc20PermitNonce)
+}
+func (x fastReflection_GenesisErc20PermitNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisErc20PermitNonce
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisErc20PermitNonce) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisErc20PermitNonce
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisErc20PermitNonce) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisErc20PermitNonce_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisErc20PermitNonce) New() protoreflect.Message {
+	return new(fastReflection_GenesisErc20PermitNonce)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisErc20PermitNonce) Interface() protoreflect.ProtoMessage {
+	return (*GenesisErc20PermitNonce)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisErc20PermitNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_GenesisErc20PermitNonce_contract_address, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_GenesisErc20PermitNonce_owner, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_GenesisErc20PermitNonce_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisErc20PermitNonce) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.contract_address":
+		return x.ContractAddress != ""
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.owner":
+		return x.Owner != ""
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.nonce":
+		return x.Nonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20PermitNonce"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20PermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisErc20PermitNonce) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.contract_address":
+		x.ContractAddress = ""
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.owner":
+		x.Owner = ""
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.nonce":
+		x.Nonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20PermitNonce"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20PermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisErc20PermitNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20PermitNonce"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20PermitNonce does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisErc20PermitNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.owner":
+		x.Owner = value.Interface().(string)
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.nonce":
+		x.Nonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20PermitNonce"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20PermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisErc20PermitNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.contract_address":
+		panic(fmt.Errorf("field contract_address of message everlast.cpc.v1.GenesisErc20PermitNonce is not mutable"))
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.owner":
+		panic(fmt.Errorf("field owner of message everlast.cpc.v1.GenesisErc20PermitNonce is not mutable"))
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.nonce":
+		panic(fmt.Errorf("field nonce of message everlast.cpc.v1.GenesisErc20PermitNonce is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20PermitNonce"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20PermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisErc20PermitNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.contract_address":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.owner":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.GenesisErc20PermitNonce.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.GenesisErc20PermitNonce"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.GenesisErc20PermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisErc20PermitNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.GenesisErc20PermitNonce", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisErc20PermitNonce) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisErc20PermitNonce) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisErc20PermitNonce) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisErc20PermitNonce) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisErc20PermitNonce)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisErc20PermitNonce)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisErc20PermitNonce)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisErc20PermitNonce: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisErc20PermitNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_2_list)(nil)
+
+type _Params_2_list struct {
+	list *[]string
+}
+
+func (x *_Params_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field WhitelistedDeployers as it is not of Message kind"))
+}
+
+func (x *_Params_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]*CustomPrecompiledContractMethodGas
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMethodGas)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMethodGas)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(CustomPrecompiledContractMethodGas)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(CustomPrecompiledContractMethodGas)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]*AutoDeployErc20IbcDenom
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoDeployErc20IbcDenom)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoDeployErc20IbcDenom)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	v := new(AutoDeployErc20IbcDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := new(AutoDeployErc20IbcDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                  protoreflect.MessageDescriptor
+	fd_Params_protocol_version                 protoreflect.FieldDescriptor
+	fd_Params_whitelisted_deployers            protoreflect.FieldDescriptor
+	fd_Params_auto_deploy_erc20_for_ibc_denoms protoreflect.FieldDescriptor
+	fd_Params_gas_schedule                     protoreflect.FieldDescriptor
+	fd_Params_auto_deploy_erc20_ibc_denoms     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_genesis_proto_init()
+	md_Params = File_everlast_cpc_v1_genesis_proto.Messages().ByName("Params")
+	fd_Params_protocol_version = md_Params.Fields().ByName("protocol_version")
+	fd_Params_whitelisted_deployers = md_Params.Fields().ByName("whitelisted_deployers")
+	fd_Params_auto_deploy_erc20_for_ibc_denoms = md_Params.Fields().ByName("auto_deploy_erc20_for_ibc_denoms")
+	fd_Params_gas_schedule = md_Params.Fields().ByName("gas_schedule")
+	fd_Params_auto_deploy_erc20_ibc_denoms = md_Params.Fields().ByName("auto_deploy_erc20_ibc_denoms")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProtocolVersion != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ProtocolVersion)
+		if !f(fd_Params_protocol_version, value) {
+			return
+		}
+	}
+	if len(x.WhitelistedDeployers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_2_list{list: &x.WhitelistedDeployers})
+		if !f(fd_Params_whitelisted_deployers, value) {
+			return
+		}
+	}
+	if x.AutoDeployErc20ForIbcDenoms != false {
+		value := protoreflect.ValueOfBool(x.AutoDeployErc20ForIbcDenoms)
+		if !f(fd_Params_auto_deploy_erc20_for_ibc_denoms, value) {
+			return
+		}
+	}
+	if len(x.GasSchedule) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.GasSchedule})
+		if !f(fd_Params_gas_schedule, value) {
+			return
+		}
+	}
+	if len(x.AutoDeployErc20IbcDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.AutoDeployErc20IbcDenoms})
+		if !f(fd_Params_auto_deploy_erc20_ibc_denoms, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.Params.protocol_version":
+		return x.ProtocolVersion != uint32(0)
+	case "everlast.cpc.v1.Params.whitelisted_deployers":
+		return len(x.WhitelistedDeployers) != 0
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_for_ibc_denoms":
+		return x.AutoDeployErc20ForIbcDenoms != false
+	case "everlast.cpc.v1.Params.gas_schedule":
+		return len(x.GasSchedule) != 0
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_ibc_denoms":
+		return len(x.AutoDeployErc20IbcDenoms) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.Params.protocol_version":
+		x.ProtocolVersion = uint32(0)
+	case "everlast.cpc.v1.Params.whitelisted_deployers":
+		x.WhitelistedDeployers = nil
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_for_ibc_denoms":
+		x.AutoDeployErc20ForIbcDenoms = false
+	case "everlast.cpc.v1.Params.gas_schedule":
+		x.GasSchedule = nil
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_ibc_denoms":
+		x.AutoDeployErc20IbcDenoms = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.Params.protocol_version":
+		value := x.ProtocolVersion
+		return protoreflect.ValueOfUint32(value)
+	case "everlast.cpc.v1.Params.whitelisted_deployers":
+		if len(x.WhitelistedDeployers) == 0 {
+			return protoreflect.ValueOfList(&_Params_2_list{})
+		}
+		listValue := &_Params_2_list{list: &x.WhitelistedDeployers}
+		return protoreflect.ValueOfList(listValue)
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_for_ibc_denoms":
+		value := x.AutoDeployErc20ForIbcDenoms
+		return protoreflect.ValueOfBool(value)
+	case "everlast.cpc.v1.Params.gas_schedule":
+		if len(x.GasSchedule) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.GasSchedule}
+		return protoreflect.ValueOfList(listValue)
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_ibc_denoms":
+		if len(x.AutoDeployErc20IbcDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.AutoDeployErc20IbcDenoms}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.Params.protocol_version":
+		x.ProtocolVersion = uint32(value.Uint())
+	case "everlast.cpc.v1.Params.whitelisted_deployers":
+		lv := value.List()
+		clv := lv.(*_Params_2_list)
+		x.WhitelistedDeployers = *clv.list
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_for_ibc_denoms":
+		x.AutoDeployErc20ForIbcDenoms = value.Bool()
+	case "everlast.cpc.v1.Params.gas_schedule":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.GasSchedule = *clv.list
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_ibc_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.AutoDeployErc20IbcDenoms = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.Params.whitelisted_deployers":
+		if x.WhitelistedDeployers == nil {
+			x.WhitelistedDeployers = []string{}
+		}
+		value := &_Params_2_list{list: &x.WhitelistedDeployers}
+		return protoreflect.ValueOfList(value)
+	case "everlast.cpc.v1.Params.gas_schedule":
+		if x.GasSchedule == nil {
+			x.GasSchedule = []*CustomPrecompiledContractMethodGas{}
+		}
+		value := &_Params_4_list{list: &x.GasSchedule}
+		return protoreflect.ValueOfList(value)
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_ibc_denoms":
+		if x.AutoDeployErc20IbcDenoms == nil {
+			x.AutoDeployErc20IbcDenoms = []*AutoDeployErc20IbcDenom{}
+		}
+		value := &_Params_5_list{list: &x.AutoDeployErc20IbcDenoms}
+		return protoreflect.ValueOfList(value)
+	case "everlast.cpc.v1.Params.protocol_version":
+		panic(fmt.Errorf("field protocol_version of message everlast.cpc.v1.Params is not mutable"))
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_for_ibc_denoms":
+		panic(fmt.Errorf("field auto_deploy_erc20_for_ibc_denoms of message everlast.cpc.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.Params.protocol_version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "everlast.cpc.v1.Params.whitelisted_deployers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_for_ibc_denoms":
+		return protoreflect.ValueOfBool(false)
+	case "everlast.cpc.v1.Params.gas_schedule":
+		list := []*CustomPrecompiledContractMethodGas{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "everlast.cpc.v1.Params.auto_deploy_erc20_ibc_denoms":
+		list := []*AutoDeployErc20IbcDenom{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProtocolVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProtocolVersion))
+		}
+		if len(x.WhitelistedDeployers) > 0 {
+			for _, s := range x.WhitelistedDeployers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AutoDeployErc20ForIbcDenoms {
+			n += 2
+		}
+		if len(x.GasSchedule) > 0 {
+			for _, e := range x.GasSchedule {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AutoDeployErc20IbcDenoms) > 0 {
+			for _, e := range x.AutoDeployErc20IbcDenoms {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AutoDeployErc20IbcDenoms) > 0 {
+			for iNdEx := len(x.AutoDeployErc20IbcDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AutoDeployErc20IbcDenoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.GasSchedule) > 0 {
+			for iNdEx := len(x.GasSchedule) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GasSchedule[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.AutoDeployErc20ForIbcDenoms {
+			i--
+			if x.AutoDeployErc20ForIbcDenoms {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.WhitelistedDeployers) > 0 {
+			for iNdEx := len(x.WhitelistedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.WhitelistedDeployers[iNdEx])
+				copy(dAtA[i:], x.WhitelistedDeployers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WhitelistedDeployers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.ProtocolVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolVersion))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+				}
+				x.ProtocolVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProtocolVersion |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WhitelistedDeployers = append(x.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoDeployErc20ForIbcDenoms", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoDeployErc20ForIbcDenoms = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasSchedule = append(x.GasSchedule, &CustomPrecompiledContractMethodGas{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasSchedule[len(x.GasSchedule)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoDeployErc20IbcDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AutoDeployErc20IbcDenoms = append(x.AutoDeployErc20IbcDenoms, &AutoDeployErc20IbcDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoDeployErc20IbcDenoms[len(x.AutoDeployErc20IbcDenoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AutoDeployErc20IbcDenom                 protoreflect.MessageDescriptor
+	fd_AutoDeployErc20IbcDenom_full_denom_path protoreflect.FieldDescriptor
+	fd_AutoDeployErc20IbcDenom_decimals        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_genesis_proto_init()
+	md_AutoDeployErc20IbcDenom = File_everlast_cpc_v1_genesis_proto.Messages().ByName("AutoDeployErc20IbcDenom")
+	fd_AutoDeployErc20IbcDenom_full_denom_path = md_AutoDeployErc20IbcDenom.Fields().ByName("full_denom_path")
+	fd_AutoDeployErc20IbcDenom_decimals = md_AutoDeployErc20IbcDenom.Fields().ByName("decimals")
+}
+
+var _ protoreflect.Message = (*fastReflection_AutoDeployErc20IbcDenom)(nil)
+
+type fastReflection_AutoDeployErc20IbcDenom AutoDeployErc20IbcDenom
+
+func (x *AutoDeployErc20IbcDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AutoDeployErc20IbcDenom)(x)
+}
+
+func (x *AutoDeployErc20IbcDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AutoDeployErc20IbcDenom_messageType fastReflection_AutoDeployErc20IbcDenom_messageType
+var _ protoreflect.MessageType = fastReflection_AutoDeployErc20IbcDenom_messageType{}
+
+type fastReflection_AutoDeployErc20IbcDenom_messageType struct{}
+
+func (x fastReflection_AutoDeployErc20IbcDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AutoDeployErc20IbcDenom)(nil)
+}
+func (x fastReflection_AutoDeployErc20IbcDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_AutoDeployErc20IbcDenom)
+}
+func (x fastReflection_AutoDeployErc20IbcDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoDeployErc20IbcDenom
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoDeployErc20IbcDenom
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Type() protoreflect.MessageType {
+	return _fastReflection_AutoDeployErc20IbcDenom_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AutoDeployErc20IbcDenom) New() protoreflect.Message {
+	return new(fastReflection_AutoDeployErc20IbcDenom)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Interface() protoreflect.ProtoMessage {
+	return (*AutoDeployErc20IbcDenom)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FullDenomPath != "" {
+		value := protoreflect.ValueOfString(x.FullDenomPath)
+		if !f(fd_AutoDeployErc20IbcDenom_full_denom_path, value) {
+			return
+		}
+	}
+	if x.Decimals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Decimals)
+		if !f(fd_AutoDeployErc20IbcDenom_decimals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.full_denom_path":
+		return x.FullDenomPath != ""
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.decimals":
+		return x.Decimals != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.AutoDeployErc20IbcDenom"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.AutoDeployErc20IbcDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.full_denom_path":
+		x.FullDenomPath = ""
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.decimals":
+		x.Decimals = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.AutoDeployErc20IbcDenom"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.AutoDeployErc20IbcDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.full_denom_path":
+		value := x.FullDenomPath
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.AutoDeployErc20IbcDenom"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.AutoDeployErc20IbcDenom does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.full_denom_path":
+		x.FullDenomPath = value.Interface().(string)
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.decimals":
+		x.Decimals = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.AutoDeployErc20IbcDenom"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.AutoDeployErc20IbcDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoDeployErc20IbcDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.full_denom_path":
+		panic(fmt.Errorf("field full_denom_path of message everlast.cpc.v1.AutoDeployErc20IbcDenom is not mutable"))
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.decimals":
+		panic(fmt.Errorf("field decimals of message everlast.cpc.v1.AutoDeployErc20IbcDenom is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.AutoDeployErc20IbcDenom"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.AutoDeployErc20IbcDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AutoDeployErc20IbcDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.full_denom_path":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.AutoDeployErc20IbcDenom.decimals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.AutoDeployErc20IbcDenom"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.AutoDeployErc20IbcDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AutoDeployErc20IbcDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.AutoDeployErc20IbcDenom", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AutoDeployErc20IbcDenom) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoDeployErc20IbcDenom) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AutoDeployErc20IbcDenom) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AutoDeployErc20IbcDenom) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AutoDeployErc20IbcDenom)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.FullDenomPath)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AutoDeployErc20IbcDenom)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.FullDenomPath) > 0 {
+			i -= len(x.FullDenomPath)
+			copy(dAtA[i:], x.FullDenomPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FullDenomPath)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AutoDeployErc20IbcDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoDeployErc20IbcDenom: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoDeployErc20IbcDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FullDenomPath", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FullDenomPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CustomPrecompiledContractMethodGas                         protoreflect.MessageDescriptor
+	fd_CustomPrecompiledContractMethodGas_custom_precompiled_type protoreflect.FieldDescriptor
+	fd_CustomPrecompiledContractMethodGas_method_selector         protoreflect.FieldDescriptor
+	fd_CustomPrecompiledContractMethodGas_base_gas                protoreflect.FieldDescriptor
+	fd_CustomPrecompiledContractMethodGas_gas_per_byte            protoreflect.FieldDescriptor
+	fd_CustomPrecompiledContractMethodGas_gas_per_iteration       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_genesis_proto_init()
+	md_CustomPrecompiledContractMethodGas = File_everlast_cpc_v1_genesis_proto.Messages().ByName("CustomPrecompiledContractMethodGas")
+	fd_CustomPrecompiledContractMethodGas_custom_precompiled_type = md_CustomPrecompiledContractMethodGas.Fields().ByName("custom_precompiled_type")
+	fd_CustomPrecompiledContractMethodGas_method_selector = md_CustomPrecompiledContractMethodGas.Fields().ByName("method_selector")
+	fd_CustomPrecompiledContractMethodGas_base_gas = md_CustomPrecompiledContractMethodGas.Fields().ByName("base_gas")
+	fd_CustomPrecompiledContractMethodGas_gas_per_byte = md_CustomPrecompiledContractMethodGas.Fields().ByName("gas_per_byte")
+	fd_CustomPrecompiledContractMethodGas_gas_per_iteration = md_CustomPrecompiledContractMethodGas.Fields().ByName("gas_per_iteration")
+}
+
+var _ protoreflect.Message = (*fastReflection_CustomPrecompiledContractMethodGas)(nil)
+
+type fastReflection_CustomPrecompiledContractMethodGas CustomPrecompiledContractMethodGas
+
+func (x *CustomPrecompiledContractMethodGas) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CustomPrecompiledContractMethodGas)(x)
+}
+
+func (x *CustomPrecompiledContractMethodGas) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CustomPrecompiledContractMethodGas_messageType fastReflection_CustomPrecompiledContractMethodGas_messageType
+var _ protoreflect.MessageType = fastReflection_CustomPrecompiledContractMethodGas_messageType{}
+
+type fastReflection_CustomPrecompiledContractMethodGas_messageType struct{}
+
+func (x fastReflection_CustomPrecompiledContractMethodGas_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CustomPrecompiledContractMethodGas)(nil)
+}
+func (x fastReflection_CustomPrecompiledContractMethodGas_messageType) New() protoreflect.Message {
+	return new(fastReflection_CustomPrecompiledContractMethodGas)
+}
+func (x fastReflection_CustomPrecompiledContractMethodGas_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CustomPrecompiledContractMethodGas
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Descriptor() protoreflect.MessageDescriptor {
+	return md_CustomPrecompiledContractMethodGas
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Type() protoreflect.MessageType {
+	return _fastReflection_CustomPrecompiledContractMethodGas_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) New() protoreflect.Message {
+	return new(fastReflection_CustomPrecompiledContractMethodGas)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Interface() protoreflect.ProtoMessage {
+	return (*CustomPrecompiledContractMethodGas)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CustomPrecompiledType != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.CustomPrecompiledType)
+		if !f(fd_CustomPrecompiledContractMethodGas_custom_precompiled_type, value) {
+			return
+		}
+	}
+	if x.MethodSelector != "" {
+		value := protoreflect.ValueOfString(x.MethodSelector)
+		if !f(fd_CustomPrecompiledContractMethodGas_method_selector, value) {
+			return
+		}
+	}
+	if x.BaseGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseGas)
+		if !f(fd_CustomPrecompiledContractMethodGas_base_gas, value) {
+			return
+		}
+	}
+	if x.GasPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerByte)
+		if !f(fd_CustomPrecompiledContractMethodGas_gas_per_byte, value) {
+			return
+		}
+	}
+	if x.GasPerIteration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerIteration)
+		if !f(fd_CustomPrecompiledContractMethodGas_gas_per_iteration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.custom_precompiled_type":
+		return x.CustomPrecompiledType != uint32(0)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.method_selector":
+		return x.MethodSelector != ""
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.base_gas":
+		return x.BaseGas != uint64(0)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_byte":
+		return x.GasPerByte != uint64(0)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_iteration":
+		return x.GasPerIteration != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethodGas"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.custom_precompiled_type":
+		x.CustomPrecompiledType = uint32(0)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.method_selector":
+		x.MethodSelector = ""
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.base_gas":
+		x.BaseGas = uint64(0)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_byte":
+		x.GasPerByte = uint64(0)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_iteration":
+		x.GasPerIteration = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethodGas"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.custom_precompiled_type":
+		value := x.CustomPrecompiledType
+		return protoreflect.ValueOfUint32(value)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.method_selector":
+		value := x.MethodSelector
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.base_gas":
+		value := x.BaseGas
+		return protoreflect.ValueOfUint64(value)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_byte":
+		value := x.GasPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_iteration":
+		value := x.GasPerIteration
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethodGas"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethodGas does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.custom_precompiled_type":
+		x.CustomPrecompiledType = uint32(value.Uint())
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.method_selector":
+		x.MethodSelector = value.Interface().(string)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.base_gas":
+		x.BaseGas = value.Uint()
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_byte":
+		x.GasPerByte = value.Uint()
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_iteration":
+		x.GasPerIteration = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethodGas"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.custom_precompiled_type":
+		panic(fmt.Errorf("field custom_precompiled_type of message everlast.cpc.v1.CustomPrecompiledContractMethodGas is not mutable"))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.method_selector":
+		panic(fmt.Errorf("field method_selector of message everlast.cpc.v1.CustomPrecompiledContractMethodGas is not mutable"))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.base_gas":
+		panic(fmt.Errorf("field base_gas of message everlast.cpc.v1.CustomPrecompiledContractMethodGas is not mutable"))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_byte":
+		panic(fmt.Errorf("field gas_per_byte of message everlast.cpc.v1.CustomPrecompiledContractMethodGas is not mutable"))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_iteration":
+		panic(fmt.Errorf("field gas_per_iteration of message everlast.cpc.v1.CustomPrecompiledContractMethodGas is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethodGas"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.custom_precompiled_type":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.method_selector":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.base_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethodGas.gas_per_iteration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethodGas"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.CustomPrecompiledContractMethodGas", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CustomPrecompiledContractMethodGas) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CustomPrecompiledContractMethodGas)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CustomPrecompiledType != 0 {
+			n += 1 + runtime.Sov(uint64(x.CustomPrecompiledType))
+		}
+		l = len(x.MethodSelector)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BaseGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseGas))
+		}
+		if x.GasPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerByte))
+		}
+		if x.GasPerIteration != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerIteration))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CustomPrecompiledContractMethodGas)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasPerIteration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerIteration))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.GasPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerByte))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BaseGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseGas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MethodSelector) > 0 {
+			i -= len(x.MethodSelector)
+			copy(dAtA[i:], x.MethodSelector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MethodSelector)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CustomPrecompiledType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CustomPrecompiledType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CustomPrecompiledContractMethodGas)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomPrecompiledContractMethodGas: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomPrecompiledContractMethodGas: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CustomPrecompiledType", wireType)
+				}
+				x.CustomPrecompiledType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CustomPrecompiledType |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MethodSelector", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MethodSelector = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+				}
+				x.BaseGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerByte", wireType)
+				}
+				x.GasPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerIteration", wireType)
+				}
+				x.GasPerIteration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerIteration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: everlast/cpc/v1/genesis.proto
 
 const (
 	// Verify that this generated code is sufficiently up-to-date.
@@ -1110,6 +4078,12 @@ type GenesisState struct {
 	DeployErc20Native bool `protobuf:"varint,2,opt,name=deploy_erc20_native,json=deployErc20Native,proto3" json:"deploy_erc20_native,omitempty"`
 	// deploy_staking_contract defines if the module should deploy the staking contract.
 	DeployStakingContract bool `protobuf:"varint,3,opt,name=deploy_staking_contract,json=deployStakingContract,proto3" json:"deploy_staking_contract,omitempty"`
+	// deployed_contracts is the list of the deployed custom precompiled contracts, to be restored at the same addresses.
+	DeployedContracts []*CustomPrecompiledContractMeta `protobuf:"bytes,4,rep,name=deployed_contracts,json=deployedContracts,proto3" json:"deployed_contracts,omitempty"`
+	// erc20_allowances is the list of the allowances of the ERC20 custom precompiled contracts.
+	Erc20Allowances []*GenesisErc20Allowance `protobuf:"bytes,5,rep,name=erc20_allowances,json=erc20Allowances,proto3" json:"erc20_allowances,omitempty"`
+	// erc20_permit_nonces is the list of the EIP-2612 permit nonces of the ERC20 custom precompiled contracts.
+	Erc20PermitNonces []*GenesisErc20PermitNonce `protobuf:"bytes,6,rep,name=erc20_permit_nonces,json=erc20PermitNonces,proto3" json:"erc20_permit_nonces,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1153,6 +4127,146 @@ func (x *GenesisState) GetDeployStakingContract() bool {
 	return false
 }
 
+func (x *GenesisState) GetDeployedContracts() []*CustomPrecompiledContractMeta {
+	if x != nil {
+		return x.DeployedContracts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetErc20Allowances() []*GenesisErc20Allowance {
+	if x != nil {
+		return x.Erc20Allowances
+	}
+	return nil
+}
+
+func (x *GenesisState) GetErc20PermitNonces() []*GenesisErc20PermitNonce {
+	if x != nil {
+		return x.Erc20PermitNonces
+	}
+	return nil
+}
+
+// GenesisErc20Allowance defines an allowance of the spender over the owner's tokens, for an ERC20 custom precompiled contract.
+type GenesisErc20Allowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the hex address of the token owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the hex address of the spender
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// amount is the allowance amount
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// contract_address is the hex address of the ERC20 custom precompiled contract
+	ContractAddress string `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (x *GenesisErc20Allowance) Reset() {
+	*x = GenesisErc20Allowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisErc20Allowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisErc20Allowance) ProtoMessage() {}
+
+// Deprecated: Use GenesisErc20Allowance.ProtoReflect.Descriptor instead.
+func (*GenesisErc20Allowance) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenesisErc20Allowance) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GenesisErc20Allowance) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *GenesisErc20Allowance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *GenesisErc20Allowance) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+// GenesisErc20PermitNonce defines the EIP-2612 permit nonce of an owner, for an ERC20 custom precompiled contract.
+type GenesisErc20PermitNonce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract_address is the hex address of the ERC20 custom precompiled contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// owner is the hex address of the token owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// nonce is the current permit nonce of the owner
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *GenesisErc20PermitNonce) Reset() {
+	*x = GenesisErc20PermitNonce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisErc20PermitNonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisErc20PermitNonce) ProtoMessage() {}
+
+// Deprecated: Use GenesisErc20PermitNonce.ProtoReflect.Descriptor instead.
+func (*GenesisErc20PermitNonce) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenesisErc20PermitNonce) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GenesisErc20PermitNonce) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GenesisErc20PermitNonce) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
 // Params defines the cpc module params
 type Params struct {
 	state         protoimpl.MessageState
@@ -1163,12 +4277,21 @@ type Params struct {
 	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	// whitelisted_deployers is the address of the accounts permitted to deploy the Custom Precompiled Contracts
 	WhitelistedDeployers []string `protobuf:"bytes,2,rep,name=whitelisted_deployers,json=whitelistedDeployers,proto3" json:"whitelisted_deployers,omitempty"`
+	// auto_deploy_erc20_for_ibc_denoms defines if the module should automatically deploy the ERC20 contract
+	// for IBC voucher denoms, when the denom is received for the first time and has bank metadata.
+	AutoDeployErc20ForIbcDenoms bool `protobuf:"varint,3,opt,name=auto_deploy_erc20_for_ibc_denoms,json=autoDeployErc20ForIbcDenoms,proto3" json:"auto_deploy_erc20_for_ibc_denoms,omitempty"`
+	// gas_schedule overrides the gas cost of the Custom Precompiled Contract methods.
+	// Methods that are not listed here use the default gas cost.
+	GasSchedule []*CustomPrecompiledContractMethodGas `protobuf:"bytes,4,rep,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule,omitempty"`
+	// auto_deploy_erc20_ibc_denoms provides the decimals of the automatically deployed ERC20 contract of IBC voucher denoms.
+	// Denoms that are not listed here use the exponent of the display unit of the bank metadata, or zero if not found.
+	AutoDeployErc20IbcDenoms []*AutoDeployErc20IbcDenom `protobuf:"bytes,5,rep,name=auto_deploy_erc20_ibc_denoms,json=autoDeployErc20IbcDenoms,proto3" json:"auto_deploy_erc20_ibc_denoms,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[1]
+		mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +4305,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_everlast_cpc_v1_genesis_proto_rawDescGZIP(), []int{1}
+	return file_everlast_cpc_v1_genesis_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Params) GetProtocolVersion() uint32 {
@@ -1199,6 +4322,148 @@ func (x *Params) GetWhitelistedDeployers() []string {
 	return nil
 }
 
+func (x *Params) GetAutoDeployErc20ForIbcDenoms() bool {
+	if x != nil {
+		return x.AutoDeployErc20ForIbcDenoms
+	}
+	return false
+}
+
+func (x *Params) GetGasSchedule() []*CustomPrecompiledContractMethodGas {
+	if x != nil {
+		return x.GasSchedule
+	}
+	return nil
+}
+
+func (x *Params) GetAutoDeployErc20IbcDenoms() []*AutoDeployErc20IbcDenom {
+	if x != nil {
+		return x.AutoDeployErc20IbcDenoms
+	}
+	return nil
+}
+
+// AutoDeployErc20IbcDenom defines the decimals of the automatically deployed ERC20 contract of an IBC voucher denom.
+type AutoDeployErc20IbcDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// full_denom_path is the full path of the denom trace on this chain, e.g. transfer/channel-0/uatom
+	FullDenomPath string `protobuf:"bytes,1,opt,name=full_denom_path,json=fullDenomPath,proto3" json:"full_denom_path,omitempty"`
+	// decimals is the exponent of the display unit on the source chain, used as decimals of the ERC20 contract.
+	// The bank metadata of IBC vouchers does not carry it.
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *AutoDeployErc20IbcDenom) Reset() {
+	*x = AutoDeployErc20IbcDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoDeployErc20IbcDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoDeployErc20IbcDenom) ProtoMessage() {}
+
+// Deprecated: Use AutoDeployErc20IbcDenom.ProtoReflect.Descriptor instead.
+func (*AutoDeployErc20IbcDenom) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AutoDeployErc20IbcDenom) GetFullDenomPath() string {
+	if x != nil {
+		return x.FullDenomPath
+	}
+	return ""
+}
+
+func (x *AutoDeployErc20IbcDenom) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+// CustomPrecompiledContractMethodGas defines the gas cost of a method of a Custom Precompiled Contract type.
+type CustomPrecompiledContractMethodGas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// custom_precompiled_type is the type of the Custom Precompiled Contract
+	CustomPrecompiledType uint32 `protobuf:"varint,1,opt,name=custom_precompiled_type,json=customPrecompiledType,proto3" json:"custom_precompiled_type,omitempty"`
+	// method_selector is the hex-encoded 4-bytes selector of the method, e.g. 0x70a08231
+	MethodSelector string `protobuf:"bytes,2,opt,name=method_selector,json=methodSelector,proto3" json:"method_selector,omitempty"`
+	// base_gas is the fixed gas cost of the method, when zero, the default gas cost of the method will be used.
+	BaseGas uint64 `protobuf:"varint,3,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	// gas_per_byte is the gas cost charged per byte of the call input.
+	GasPerByte uint64 `protobuf:"varint,4,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty"`
+	// gas_per_iteration is the gas cost charged per iteration,
+	// for the methods that loop over a dynamic number of records like delegatedValidators, rewardsOf and withdrawRewards.
+	GasPerIteration uint64 `protobuf:"varint,5,opt,name=gas_per_iteration,json=gasPerIteration,proto3" json:"gas_per_iteration,omitempty"`
+}
+
+func (x *CustomPrecompiledContractMethodGas) Reset() {
+	*x = CustomPrecompiledContractMethodGas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomPrecompiledContractMethodGas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomPrecompiledContractMethodGas) ProtoMessage() {}
+
+// Deprecated: Use CustomPrecompiledContractMethodGas.ProtoReflect.Descriptor instead.
+func (*CustomPrecompiledContractMethodGas) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CustomPrecompiledContractMethodGas) GetCustomPrecompiledType() uint32 {
+	if x != nil {
+		return x.CustomPrecompiledType
+	}
+	return 0
+}
+
+func (x *CustomPrecompiledContractMethodGas) GetMethodSelector() string {
+	if x != nil {
+		return x.MethodSelector
+	}
+	return ""
+}
+
+func (x *CustomPrecompiledContractMethodGas) GetBaseGas() uint64 {
+	if x != nil {
+		return x.BaseGas
+	}
+	return 0
+}
+
+func (x *CustomPrecompiledContractMethodGas) GetGasPerByte() uint64 {
+	if x != nil {
+		return x.GasPerByte
+	}
+	return 0
+}
+
+func (x *CustomPrecompiledContractMethodGas) GetGasPerIteration() uint64 {
+	if x != nil {
+		return x.GasPerIteration
+	}
+	return 0
+}
+
 var File_everlast_cpc_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_everlast_cpc_v1_genesis_proto_rawDesc = []byte{
@@ -1206,36 +4471,112 @@ var file_everlast_cpc_v1_genesis_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61,
-	0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x6e,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x36,
-	0x0a, 0x17, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x15, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x68, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74,
+	0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x03, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x4e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x15, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x63, 0x0a, 0x12, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74,
+	0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x57,
+	0x0a, 0x10, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x45, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x13, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e,
+	0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x45, 0x72,
+	0x63, 0x32, 0x30, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x65, 0x72, 0x63, 0x32, 0x30, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x45, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x45, 0x72,
+	0x63, 0x32, 0x30, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xfd, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72,
 	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x77,
 	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f,
 	0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x77, 0x68, 0x69, 0x74,
 	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x73,
-	0x42, 0xa9, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
-	0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c,
-	0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x70, 0x63, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x45, 0x43, 0x58, 0xaa, 0x02, 0x0f, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
-	0x74, 0x2e, 0x43, 0x70, 0x63, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x45, 0x76, 0x65, 0x72, 0x6c,
-	0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x45, 0x76, 0x65,
-	0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x76, 0x65, 0x72, 0x6c,
-	0x61, 0x73, 0x74, 0x3a, 0x3a, 0x43, 0x70, 0x63, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x45, 0x0a, 0x20, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x69, 0x62, 0x63, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x61, 0x75, 0x74, 0x6f,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x46, 0x6f, 0x72, 0x49, 0x62,
+	0x63, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x0c, 0x67, 0x61, 0x73, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x47,
+	0x61, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x67, 0x61, 0x73, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x6e, 0x0a, 0x1c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x69, 0x62, 0x63, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x49, 0x62, 0x63,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x18, 0x61, 0x75, 0x74,
+	0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x49, 0x62, 0x63, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x49, 0x62, 0x63, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x26, 0x0a, 0x0f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x22, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x47, 0x61, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x62, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67,
+	0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x61, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x49, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xa9, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b,
+	0x63, 0x70, 0x63, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x43, 0x58, 0xaa, 0x02, 0x0f, 0x45, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x70, 0x63, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f,
+	0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1b, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11,
+	0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x3a, 0x3a, 0x43, 0x70, 0x63, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1250,18 +4591,28 @@ func file_everlast_cpc_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_everlast_cpc_v1_genesis_proto_rawDescData
 }
 
-var file_everlast_cpc_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_everlast_cpc_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_everlast_cpc_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: everlast.cpc.v1.GenesisState
-	(*Params)(nil),       // 1: everlast.cpc.v1.Params
+	(*GenesisState)(nil),                       // 0: everlast.cpc.v1.GenesisState
+	(*GenesisErc20Allowance)(nil),              // 1: everlast.cpc.v1.GenesisErc20Allowance
+	(*GenesisErc20PermitNonce)(nil),            // 2: everlast.cpc.v1.GenesisErc20PermitNonce
+	(*Params)(nil),                             // 3: everlast.cpc.v1.Params
+	(*AutoDeployErc20IbcDenom)(nil),            // 4: everlast.cpc.v1.AutoDeployErc20IbcDenom
+	(*CustomPrecompiledContractMethodGas)(nil), // 5: everlast.cpc.v1.CustomPrecompiledContractMethodGas
+	(*CustomPrecompiledContractMeta)(nil),      // 6: everlast.cpc.v1.CustomPrecompiledContractMeta
 }
 var file_everlast_cpc_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: everlast.cpc.v1.GenesisState.params:type_name -> everlast.cpc.v1.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: everlast.cpc.v1.GenesisState.params:type_name -> everlast.cpc.v1.Params
+	6, // 1: everlast.cpc.v1.GenesisState.deployed_contracts:type_name -> everlast.cpc.v1.CustomPrecompiledContractMeta
+	1, // 2: everlast.cpc.v1.GenesisState.erc20_allowances:type_name -> everlast.cpc.v1.GenesisErc20Allowance
+	2, // 3: everlast.cpc.v1.GenesisState.erc20_permit_nonces:type_name -> everlast.cpc.v1.GenesisErc20PermitNonce
+	5, // 4: everlast.cpc.v1.Params.gas_schedule:type_name -> everlast.cpc.v1.CustomPrecompiledContractMethodGas
+	4, // 5: everlast.cpc.v1.Params.auto_deploy_erc20_ibc_denoms:type_name -> everlast.cpc.v1.AutoDeployErc20IbcDenom
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_everlast_cpc_v1_genesis_proto_init() }
@@ -1269,6 +4620,7 @@ func file_everlast_cpc_v1_genesis_proto_init() {
 	if File_everlast_cpc_v1_genesis_proto != nil {
 		return
 	}
+	file_everlast_cpc_v1_precompiles_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_everlast_cpc_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1283,6 +4635,30 @@ func file_everlast_cpc_v1_genesis_proto_init() {
 			}
 		}
 		file_everlast_cpc_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisErc20Allowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisErc20PermitNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -1294,6 +4670,30 @@ func file_everlast_cpc_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_everlast_cpc_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoDeployErc20IbcDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomPrecompiledContractMethodGas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1301,7 +4701,7 @@ func file_everlast_cpc_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_everlast_cpc_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
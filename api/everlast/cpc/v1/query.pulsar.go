@@ -2441,6 +2441,1649 @@ func (x *fastReflection_QueryCustomPrecompiledContractResponse) ProtoMethods() *
 	}
 }
 
+var (
+	md_QueryCustomPrecompiledContractAbiRequest         protoreflect.MessageDescriptor
+	fd_QueryCustomPrecompiledContractAbiRequest_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_query_proto_init()
+	md_QueryCustomPrecompiledContractAbiRequest = File_everlast_cpc_v1_query_proto.Messages().ByName("QueryCustomPrecompiledContractAbiRequest")
+	fd_QueryCustomPrecompiledContractAbiRequest_address = md_QueryCustomPrecompiledContractAbiRequest.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCustomPrecompiledContractAbiRequest)(nil)
+
+type fastReflection_QueryCustomPrecompiledContractAbiRequest QueryCustomPrecompiledContractAbiRequest
+
+func (x *QueryCustomPrecompiledContractAbiRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCustomPrecompiledContractAbiRequest)(x)
+}
+
+func (x *QueryCustomPrecompiledContractAbiRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType{}
+
+type fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType struct{}
+
+func (x fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCustomPrecompiledContractAbiRequest)(nil)
+}
+func (x fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCustomPrecompiledContractAbiRequest)
+}
+func (x fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCustomPrecompiledContractAbiRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCustomPrecompiledContractAbiRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCustomPrecompiledContractAbiRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCustomPrecompiledContractAbiRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCustomPrecompiledContractAbiRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryCustomPrecompiledContractAbiRequest_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest.address":
+		panic(fmt.Errorf("field address of message everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCustomPrecompiledContractAbiRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCustomPrecompiledContractAbiRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCustomPrecompiledContractAbiRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCustomPrecompiledContractAbiRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCustomPrecompiledContractAbiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CustomPrecompiledContractMethod              protoreflect.MessageDescriptor
+	fd_CustomPrecompiledContractMethod_selector     protoreflect.FieldDescriptor
+	fd_CustomPrecompiledContractMethod_signature    protoreflect.FieldDescriptor
+	fd_CustomPrecompiledContractMethod_read_only    protoreflect.FieldDescriptor
+	fd_CustomPrecompiledContractMethod_required_gas protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_query_proto_init()
+	md_CustomPrecompiledContractMethod = File_everlast_cpc_v1_query_proto.Messages().ByName("CustomPrecompiledContractMethod")
+	fd_CustomPrecompiledContractMethod_selector = md_CustomPrecompiledContractMethod.Fields().ByName("selector")
+	fd_CustomPrecompiledContractMethod_signature = md_CustomPrecompiledContractMethod.Fields().ByName("signature")
+	fd_CustomPrecompiledContractMethod_read_only = md_CustomPrecompiledContractMethod.Fields().ByName("read_only")
+	fd_CustomPrecompiledContractMethod_required_gas = md_CustomPrecompiledContractMethod.Fields().ByName("required_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_CustomPrecompiledContractMethod)(nil)
+
+type fastReflection_CustomPrecompiledContractMethod CustomPrecompiledContractMethod
+
+func (x *CustomPrecompiledContractMethod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CustomPrecompiledContractMethod)(x)
+}
+
+func (x *CustomPrecompiledContractMethod) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CustomPrecompiledContractMethod_messageType fastReflection_CustomPrecompiledContractMethod_messageType
+var _ protoreflect.MessageType = fastReflection_CustomPrecompiledContractMethod_messageType{}
+
+type fastReflection_CustomPrecompiledContractMethod_messageType struct{}
+
+func (x fastReflection_CustomPrecompiledContractMethod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CustomPrecompiledContractMethod)(nil)
+}
+func (x fastReflection_CustomPrecompiledContractMethod_messageType) New() protoreflect.Message {
+	return new(fastReflection_CustomPrecompiledContractMethod)
+}
+func (x fastReflection_CustomPrecompiledContractMethod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CustomPrecompiledContractMethod
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CustomPrecompiledContractMethod) Descriptor() protoreflect.MessageDescriptor {
+	return md_CustomPrecompiledContractMethod
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CustomPrecompiledContractMethod) Type() protoreflect.MessageType {
+	return _fastReflection_CustomPrecompiledContractMethod_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CustomPrecompiledContractMethod) New() protoreflect.Message {
+	return new(fastReflection_CustomPrecompiledContractMethod)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CustomPrecompiledContractMethod) Interface() protoreflect.ProtoMessage {
+	return (*CustomPrecompiledContractMethod)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CustomPrecompiledContractMethod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Selector != "" {
+		value := protoreflect.ValueOfString(x.Selector)
+		if !f(fd_CustomPrecompiledContractMethod_selector, value) {
+			return
+		}
+	}
+	if x.Signature != "" {
+		value := protoreflect.ValueOfString(x.Signature)
+		if !f(fd_CustomPrecompiledContractMethod_signature, value) {
+			return
+		}
+	}
+	if x.ReadOnly != false {
+		value := protoreflect.ValueOfBool(x.ReadOnly)
+		if !f(fd_CustomPrecompiledContractMethod_read_only, value) {
+			return
+		}
+	}
+	if x.RequiredGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequiredGas)
+		if !f(fd_CustomPrecompiledContractMethod_required_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CustomPrecompiledContractMethod) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.selector":
+		return x.Selector != ""
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.signature":
+		return x.Signature != ""
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.read_only":
+		return x.ReadOnly != false
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.required_gas":
+		return x.RequiredGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethod"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethod) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.selector":
+		x.Selector = ""
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.signature":
+		x.Signature = ""
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.read_only":
+		x.ReadOnly = false
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.required_gas":
+		x.RequiredGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethod"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CustomPrecompiledContractMethod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.selector":
+		value := x.Selector
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.signature":
+		value := x.Signature
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.read_only":
+		value := x.ReadOnly
+		return protoreflect.ValueOfBool(value)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.required_gas":
+		value := x.RequiredGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethod"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethod does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.selector":
+		x.Selector = value.Interface().(string)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.signature":
+		x.Signature = value.Interface().(string)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.read_only":
+		x.ReadOnly = value.Bool()
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.required_gas":
+		x.RequiredGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethod"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.selector":
+		panic(fmt.Errorf("field selector of message everlast.cpc.v1.CustomPrecompiledContractMethod is not mutable"))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.signature":
+		panic(fmt.Errorf("field signature of message everlast.cpc.v1.CustomPrecompiledContractMethod is not mutable"))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.read_only":
+		panic(fmt.Errorf("field read_only of message everlast.cpc.v1.CustomPrecompiledContractMethod is not mutable"))
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.required_gas":
+		panic(fmt.Errorf("field required_gas of message everlast.cpc.v1.CustomPrecompiledContractMethod is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethod"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CustomPrecompiledContractMethod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.selector":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.signature":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.read_only":
+		return protoreflect.ValueOfBool(false)
+	case "everlast.cpc.v1.CustomPrecompiledContractMethod.required_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.CustomPrecompiledContractMethod"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.CustomPrecompiledContractMethod does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CustomPrecompiledContractMethod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.CustomPrecompiledContractMethod", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CustomPrecompiledContractMethod) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomPrecompiledContractMethod) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CustomPrecompiledContractMethod) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CustomPrecompiledContractMethod) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CustomPrecompiledContractMethod)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Selector)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReadOnly {
+			n += 2
+		}
+		if x.RequiredGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequiredGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CustomPrecompiledContractMethod)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequiredGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequiredGas))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ReadOnly {
+			i--
+			if x.ReadOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Selector) > 0 {
+			i -= len(x.Selector)
+			copy(dAtA[i:], x.Selector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Selector)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CustomPrecompiledContractMethod)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomPrecompiledContractMethod: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomPrecompiledContractMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Selector = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ReadOnly = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredGas", wireType)
+				}
+				x.RequiredGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequiredGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryCustomPrecompiledContractAbiResponse_3_list)(nil)
+
+type _QueryCustomPrecompiledContractAbiResponse_3_list struct {
+	list *[]*CustomPrecompiledContractMethod
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMethod)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMethod)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(CustomPrecompiledContractMethod)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) NewElement() protoreflect.Value {
+	v := new(CustomPrecompiledContractMethod)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCustomPrecompiledContractAbiResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryCustomPrecompiledContractAbiResponse          protoreflect.MessageDescriptor
+	fd_QueryCustomPrecompiledContractAbiResponse_contract protoreflect.FieldDescriptor
+	fd_QueryCustomPrecompiledContractAbiResponse_abi      protoreflect.FieldDescriptor
+	fd_QueryCustomPrecompiledContractAbiResponse_methods  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_query_proto_init()
+	md_QueryCustomPrecompiledContractAbiResponse = File_everlast_cpc_v1_query_proto.Messages().ByName("QueryCustomPrecompiledContractAbiResponse")
+	fd_QueryCustomPrecompiledContractAbiResponse_contract = md_QueryCustomPrecompiledContractAbiResponse.Fields().ByName("contract")
+	fd_QueryCustomPrecompiledContractAbiResponse_abi = md_QueryCustomPrecompiledContractAbiResponse.Fields().ByName("abi")
+	fd_QueryCustomPrecompiledContractAbiResponse_methods = md_QueryCustomPrecompiledContractAbiResponse.Fields().ByName("methods")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCustomPrecompiledContractAbiResponse)(nil)
+
+type fastReflection_QueryCustomPrecompiledContractAbiResponse QueryCustomPrecompiledContractAbiResponse
+
+func (x *QueryCustomPrecompiledContractAbiResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCustomPrecompiledContractAbiResponse)(x)
+}
+
+func (x *QueryCustomPrecompiledContractAbiResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType{}
+
+type fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType struct{}
+
+func (x fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCustomPrecompiledContractAbiResponse)(nil)
+}
+func (x fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCustomPrecompiledContractAbiResponse)
+}
+func (x fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCustomPrecompiledContractAbiResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCustomPrecompiledContractAbiResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCustomPrecompiledContractAbiResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCustomPrecompiledContractAbiResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCustomPrecompiledContractAbiResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != nil {
+		value := protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+		if !f(fd_QueryCustomPrecompiledContractAbiResponse_contract, value) {
+			return
+		}
+	}
+	if x.Abi != "" {
+		value := protoreflect.ValueOfString(x.Abi)
+		if !f(fd_QueryCustomPrecompiledContractAbiResponse_abi, value) {
+			return
+		}
+	}
+	if len(x.Methods) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCustomPrecompiledContractAbiResponse_3_list{list: &x.Methods})
+		if !f(fd_QueryCustomPrecompiledContractAbiResponse_methods, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.contract":
+		return x.Contract != nil
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.abi":
+		return x.Abi != ""
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.methods":
+		return len(x.Methods) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.contract":
+		x.Contract = nil
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.abi":
+		x.Abi = ""
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.methods":
+		x.Methods = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.contract":
+		value := x.Contract
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.abi":
+		value := x.Abi
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.methods":
+		if len(x.Methods) == 0 {
+			return protoreflect.ValueOfList(&_QueryCustomPrecompiledContractAbiResponse_3_list{})
+		}
+		listValue := &_QueryCustomPrecompiledContractAbiResponse_3_list{list: &x.Methods}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.contract":
+		x.Contract = value.Message().Interface().(*WrappedCustomPrecompiledContractMeta)
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.abi":
+		x.Abi = value.Interface().(string)
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.methods":
+		lv := value.List()
+		clv := lv.(*_QueryCustomPrecompiledContractAbiResponse_3_list)
+		x.Methods = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.contract":
+		if x.Contract == nil {
+			x.Contract = new(WrappedCustomPrecompiledContractMeta)
+		}
+		return protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.methods":
+		if x.Methods == nil {
+			x.Methods = []*CustomPrecompiledContractMethod{}
+		}
+		value := &_QueryCustomPrecompiledContractAbiResponse_3_list{list: &x.Methods}
+		return protoreflect.ValueOfList(value)
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.abi":
+		panic(fmt.Errorf("field abi of message everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.contract":
+		m := new(WrappedCustomPrecompiledContractMeta)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.abi":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.methods":
+		list := []*CustomPrecompiledContractMethod{}
+		return protoreflect.ValueOfList(&_QueryCustomPrecompiledContractAbiResponse_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCustomPrecompiledContractAbiResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCustomPrecompiledContractAbiResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Contract != nil {
+			l = options.Size(x.Contract)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Abi)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Methods) > 0 {
+			for _, e := range x.Methods {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCustomPrecompiledContractAbiResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Methods) > 0 {
+			for iNdEx := len(x.Methods) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Methods[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Abi) > 0 {
+			i -= len(x.Abi)
+			copy(dAtA[i:], x.Abi)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abi)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Contract != nil {
+			encoded, err := options.Marshal(x.Contract)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCustomPrecompiledContractAbiResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCustomPrecompiledContractAbiResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCustomPrecompiledContractAbiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Contract == nil {
+					x.Contract = &WrappedCustomPrecompiledContractMeta{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Abi = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Methods = append(x.Methods, &CustomPrecompiledContractMethod{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Methods[len(x.Methods)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_QueryErc20CustomPrecompiledContractByDenomRequest           protoreflect.MessageDescriptor
 	fd_QueryErc20CustomPrecompiledContractByDenomRequest_min_denom protoreflect.FieldDescriptor
@@ -2461,7 +4104,7 @@ func (x *QueryErc20CustomPrecompiledContractByDenomRequest) ProtoReflect() proto
 }
 
 func (x *QueryErc20CustomPrecompiledContractByDenomRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_everlast_cpc_v1_query_proto_msgTypes[5]
+	mi := &file_everlast_cpc_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2881,7 +4524,7 @@ func (x *QueryErc20CustomPrecompiledContractByDenomResponse) ProtoReflect() prot
 }
 
 func (x *QueryErc20CustomPrecompiledContractByDenomResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_everlast_cpc_v1_query_proto_msgTypes[6]
+	mi := &file_everlast_cpc_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3314,7 +4957,7 @@ func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_everlast_cpc_v1_query_proto_msgTypes[7]
+	mi := &file_everlast_cpc_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3672,7 +5315,7 @@ func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_everlast_cpc_v1_query_proto_msgTypes[8]
+	mi := &file_everlast_cpc_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4315,6 +5958,164 @@ func (x *QueryCustomPrecompiledContractResponse) GetContract() *WrappedCustomPre
 	return nil
 }
 
+// QueryCustomPrecompiledContractAbiRequest is the request type for the Query/CustomPrecompiledContractAbi RPC
+// method.
+type QueryCustomPrecompiledContractAbiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the ethereum hex address to query the custom precompiled contract ABI for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *QueryCustomPrecompiledContractAbiRequest) Reset() {
+	*x = QueryCustomPrecompiledContractAbiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCustomPrecompiledContractAbiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCustomPrecompiledContractAbiRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCustomPrecompiledContractAbiRequest.ProtoReflect.Descriptor instead.
+func (*QueryCustomPrecompiledContractAbiRequest) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryCustomPrecompiledContractAbiRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// CustomPrecompiledContractMethod describes a method supported by a custom precompiled contract.
+type CustomPrecompiledContractMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// selector is the 0x-prefixed hex of the 4-bytes method signature.
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	// signature is the method signature, eg: `transfer(address,uint256)`.
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// read_only is true if the method does not modify the state.
+	ReadOnly bool `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// required_gas is the static gas required by the method, including the override from the gas schedule in params.
+	RequiredGas uint64 `protobuf:"varint,4,opt,name=required_gas,json=requiredGas,proto3" json:"required_gas,omitempty"`
+}
+
+func (x *CustomPrecompiledContractMethod) Reset() {
+	*x = CustomPrecompiledContractMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomPrecompiledContractMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomPrecompiledContractMethod) ProtoMessage() {}
+
+// Deprecated: Use CustomPrecompiledContractMethod.ProtoReflect.Descriptor instead.
+func (*CustomPrecompiledContractMethod) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CustomPrecompiledContractMethod) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *CustomPrecompiledContractMethod) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *CustomPrecompiledContractMethod) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *CustomPrecompiledContractMethod) GetRequiredGas() uint64 {
+	if x != nil {
+		return x.RequiredGas
+	}
+	return 0
+}
+
+// QueryCustomPrecompiledContractAbiResponse is the response type for the Query/CustomPrecompiledContractAbi RPC
+// method.
+type QueryCustomPrecompiledContractAbiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract is the deployed custom precompiled contract
+	Contract *WrappedCustomPrecompiledContractMeta `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// abi is the JSON ABI of the custom precompiled contract.
+	Abi string `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+	// methods is the list of methods supported by the custom precompiled contract.
+	Methods []*CustomPrecompiledContractMethod `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *QueryCustomPrecompiledContractAbiResponse) Reset() {
+	*x = QueryCustomPrecompiledContractAbiResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCustomPrecompiledContractAbiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCustomPrecompiledContractAbiResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCustomPrecompiledContractAbiResponse.ProtoReflect.Descriptor instead.
+func (*QueryCustomPrecompiledContractAbiResponse) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryCustomPrecompiledContractAbiResponse) GetContract() *WrappedCustomPrecompiledContractMeta {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *QueryCustomPrecompiledContractAbiResponse) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+func (x *QueryCustomPrecompiledContractAbiResponse) GetMethods() []*CustomPrecompiledContractMethod {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
 // QueryErc20CustomPrecompiledContractByDenomRequest is the request type for the Query/Erc20CustomPrecompiledContractByDenom RPC
 // method.
 type QueryErc20CustomPrecompiledContractByDenomRequest struct {
@@ -4329,7 +6130,7 @@ type QueryErc20CustomPrecompiledContractByDenomRequest struct {
 func (x *QueryErc20CustomPrecompiledContractByDenomRequest) Reset() {
 	*x = QueryErc20CustomPrecompiledContractByDenomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_everlast_cpc_v1_query_proto_msgTypes[5]
+		mi := &file_everlast_cpc_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4343,7 +6144,7 @@ func (*QueryErc20CustomPrecompiledContractByDenomRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryErc20CustomPrecompiledContractByDenomRequest.ProtoReflect.Descriptor instead.
 func (*QueryErc20CustomPrecompiledContractByDenomRequest) Descriptor() ([]byte, []int) {
-	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryErc20CustomPrecompiledContractByDenomRequest) GetMinDenom() string {
@@ -4366,7 +6167,7 @@ type QueryErc20CustomPrecompiledContractByDenomResponse struct {
 func (x *QueryErc20CustomPrecompiledContractByDenomResponse) Reset() {
 	*x = QueryErc20CustomPrecompiledContractByDenomResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_everlast_cpc_v1_query_proto_msgTypes[6]
+		mi := &file_everlast_cpc_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4380,7 +6181,7 @@ func (*QueryErc20CustomPrecompiledContractByDenomResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryErc20CustomPrecompiledContractByDenomResponse.ProtoReflect.Descriptor instead.
 func (*QueryErc20CustomPrecompiledContractByDenomResponse) Descriptor() ([]byte, []int) {
-	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryErc20CustomPrecompiledContractByDenomResponse) GetContract() *WrappedCustomPrecompiledContractMeta {
@@ -4400,7 +6201,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_everlast_cpc_v1_query_proto_msgTypes[7]
+		mi := &file_everlast_cpc_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4414,7 +6215,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
 // QueryParamsResponse defines the response type for querying x/cpc module parameters.
@@ -4430,7 +6231,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_everlast_cpc_v1_query_proto_msgTypes[8]
+		mi := &file_everlast_cpc_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4444,7 +6245,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_everlast_cpc_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -4513,88 +6314,131 @@ var file_everlast_cpc_v1_query_proto_rawDesc = []byte{
 	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63,
 	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4d,
 	0x65, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x22, 0x50, 0x0a, 0x31, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x72, 0x63, 0x32,
-	0x30, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x8d, 0x01, 0x0a, 0x32, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x72, 0x63, 0x32, 0x30, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x08,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
-	0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0x99, 0x06, 0x0a, 0x05, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0xc6, 0x01, 0x0a, 0x1a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x73, 0x12, 0x37, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x65, 0x76,
-	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f,
-	0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0xcc, 0x01, 0x0a,
-	0x19, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x36, 0x2e, 0x65, 0x76, 0x65,
+	0x61, 0x63, 0x74, 0x22, 0x44, 0x0a, 0x28, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x62, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x1f, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x47, 0x61, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x29, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x62, 0x69,
+	0x12, 0x50, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x22, 0x50, 0x0a, 0x31, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x8d, 0x01, 0x0a, 0x32, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x72,
+	0x63, 0x32, 0x30, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x13, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0xf5, 0x07, 0x0a, 0x05, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0xc6, 0x01, 0x0a, 0x1a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x37, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x65, 0x76, 0x65,
 	0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
-	0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63,
-	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x81, 0x02, 0x0a, 0x25,
-	0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x42, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74,
-	0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x72, 0x63,
-	0x32, 0x30, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
-	0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x65, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42,
-	0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x12, 0x47, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
-	0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63,
+	0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x65,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x63,
 	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
-	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x2f, 0x7b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x7d, 0x12,
-	0x74, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x65, 0x72,
+	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0xcc, 0x01, 0x0a, 0x19,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x36, 0x2e, 0x65, 0x76, 0x65, 0x72,
 	0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x65,
-	0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa7, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
-	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76,
-	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x70,
-	0x63, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x43, 0x58, 0xaa, 0x02, 0x0f, 0x45, 0x76, 0x65, 0x72,
-	0x6c, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x70, 0x63, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x45, 0x76,
-	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b,
-	0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x76,
-	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x3a, 0x3a, 0x43, 0x70, 0x63, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x38, 0x12, 0x36, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70,
+	0x63, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xd9, 0x01, 0x0a, 0x1c, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x62, 0x69, 0x12, 0x39, 0x2e, 0x65, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x62, 0x69, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
+	0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x62, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x65, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x62, 0x69, 0x2f, 0x7b, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x81, 0x02, 0x0a, 0x25, 0x45, 0x72, 0x63, 0x32, 0x30,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x42, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e,
+	0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x72, 0x63, 0x32,
+	0x30, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x49, 0x12, 0x47, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2f, 0x7b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x7d, 0x12, 0x74, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e,
+	0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0xa7, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
+	0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
+	0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x70, 0x63, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x45, 0x43, 0x58, 0xaa, 0x02, 0x0f, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e,
+	0x43, 0x70, 0x63, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
+	0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x45, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73,
+	0x74, 0x3a, 0x3a, 0x43, 0x70, 0x63, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -4609,43 +6453,50 @@ func file_everlast_cpc_v1_query_proto_rawDescGZIP() []byte {
 	return file_everlast_cpc_v1_query_proto_rawDescData
 }
 
-var file_everlast_cpc_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_everlast_cpc_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_everlast_cpc_v1_query_proto_goTypes = []interface{}{
 	(*QueryCustomPrecompiledContractsRequest)(nil),             // 0: everlast.cpc.v1.QueryCustomPrecompiledContractsRequest
 	(*WrappedCustomPrecompiledContractMeta)(nil),               // 1: everlast.cpc.v1.WrappedCustomPrecompiledContractMeta
 	(*QueryCustomPrecompiledContractsResponse)(nil),            // 2: everlast.cpc.v1.QueryCustomPrecompiledContractsResponse
 	(*QueryCustomPrecompiledContractRequest)(nil),              // 3: everlast.cpc.v1.QueryCustomPrecompiledContractRequest
 	(*QueryCustomPrecompiledContractResponse)(nil),             // 4: everlast.cpc.v1.QueryCustomPrecompiledContractResponse
-	(*QueryErc20CustomPrecompiledContractByDenomRequest)(nil),  // 5: everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomRequest
-	(*QueryErc20CustomPrecompiledContractByDenomResponse)(nil), // 6: everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomResponse
-	(*QueryParamsRequest)(nil),                                 // 7: everlast.cpc.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),                                // 8: everlast.cpc.v1.QueryParamsResponse
-	(*v1beta1.PageRequest)(nil),                                // 9: cosmos.base.query.v1beta1.PageRequest
-	(*CustomPrecompiledContractMeta)(nil),                      // 10: everlast.cpc.v1.CustomPrecompiledContractMeta
-	(*v1beta1.PageResponse)(nil),                               // 11: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                                             // 12: everlast.cpc.v1.Params
+	(*QueryCustomPrecompiledContractAbiRequest)(nil),           // 5: everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest
+	(*CustomPrecompiledContractMethod)(nil),                    // 6: everlast.cpc.v1.CustomPrecompiledContractMethod
+	(*QueryCustomPrecompiledContractAbiResponse)(nil),          // 7: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse
+	(*QueryErc20CustomPrecompiledContractByDenomRequest)(nil),  // 8: everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomRequest
+	(*QueryErc20CustomPrecompiledContractByDenomResponse)(nil), // 9: everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomResponse
+	(*QueryParamsRequest)(nil),                                 // 10: everlast.cpc.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                                // 11: everlast.cpc.v1.QueryParamsResponse
+	(*v1beta1.PageRequest)(nil),                                // 12: cosmos.base.query.v1beta1.PageRequest
+	(*CustomPrecompiledContractMeta)(nil),                      // 13: everlast.cpc.v1.CustomPrecompiledContractMeta
+	(*v1beta1.PageResponse)(nil),                               // 14: cosmos.base.query.v1beta1.PageResponse
+	(*Params)(nil),                                             // 15: everlast.cpc.v1.Params
 }
 var file_everlast_cpc_v1_query_proto_depIdxs = []int32{
-	9,  // 0: everlast.cpc.v1.QueryCustomPrecompiledContractsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	10, // 1: everlast.cpc.v1.WrappedCustomPrecompiledContractMeta.meta:type_name -> everlast.cpc.v1.CustomPrecompiledContractMeta
+	12, // 0: everlast.cpc.v1.QueryCustomPrecompiledContractsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	13, // 1: everlast.cpc.v1.WrappedCustomPrecompiledContractMeta.meta:type_name -> everlast.cpc.v1.CustomPrecompiledContractMeta
 	1,  // 2: everlast.cpc.v1.QueryCustomPrecompiledContractsResponse.contracts:type_name -> everlast.cpc.v1.WrappedCustomPrecompiledContractMeta
-	11, // 3: everlast.cpc.v1.QueryCustomPrecompiledContractsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	14, // 3: everlast.cpc.v1.QueryCustomPrecompiledContractsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
 	1,  // 4: everlast.cpc.v1.QueryCustomPrecompiledContractResponse.contract:type_name -> everlast.cpc.v1.WrappedCustomPrecompiledContractMeta
-	1,  // 5: everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomResponse.contract:type_name -> everlast.cpc.v1.WrappedCustomPrecompiledContractMeta
-	12, // 6: everlast.cpc.v1.QueryParamsResponse.params:type_name -> everlast.cpc.v1.Params
-	0,  // 7: everlast.cpc.v1.Query.CustomPrecompiledContracts:input_type -> everlast.cpc.v1.QueryCustomPrecompiledContractsRequest
-	3,  // 8: everlast.cpc.v1.Query.CustomPrecompiledContract:input_type -> everlast.cpc.v1.QueryCustomPrecompiledContractRequest
-	5,  // 9: everlast.cpc.v1.Query.Erc20CustomPrecompiledContractByDenom:input_type -> everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomRequest
-	7,  // 10: everlast.cpc.v1.Query.Params:input_type -> everlast.cpc.v1.QueryParamsRequest
-	2,  // 11: everlast.cpc.v1.Query.CustomPrecompiledContracts:output_type -> everlast.cpc.v1.QueryCustomPrecompiledContractsResponse
-	4,  // 12: everlast.cpc.v1.Query.CustomPrecompiledContract:output_type -> everlast.cpc.v1.QueryCustomPrecompiledContractResponse
-	6,  // 13: everlast.cpc.v1.Query.Erc20CustomPrecompiledContractByDenom:output_type -> everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomResponse
-	8,  // 14: everlast.cpc.v1.Query.Params:output_type -> everlast.cpc.v1.QueryParamsResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	1,  // 5: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.contract:type_name -> everlast.cpc.v1.WrappedCustomPrecompiledContractMeta
+	6,  // 6: everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse.methods:type_name -> everlast.cpc.v1.CustomPrecompiledContractMethod
+	1,  // 7: everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomResponse.contract:type_name -> everlast.cpc.v1.WrappedCustomPrecompiledContractMeta
+	15, // 8: everlast.cpc.v1.QueryParamsResponse.params:type_name -> everlast.cpc.v1.Params
+	0,  // 9: everlast.cpc.v1.Query.CustomPrecompiledContracts:input_type -> everlast.cpc.v1.QueryCustomPrecompiledContractsRequest
+	3,  // 10: everlast.cpc.v1.Query.CustomPrecompiledContract:input_type -> everlast.cpc.v1.QueryCustomPrecompiledContractRequest
+	5,  // 11: everlast.cpc.v1.Query.CustomPrecompiledContractAbi:input_type -> everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest
+	8,  // 12: everlast.cpc.v1.Query.Erc20CustomPrecompiledContractByDenom:input_type -> everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomRequest
+	10, // 13: everlast.cpc.v1.Query.Params:input_type -> everlast.cpc.v1.QueryParamsRequest
+	2,  // 14: everlast.cpc.v1.Query.CustomPrecompiledContracts:output_type -> everlast.cpc.v1.QueryCustomPrecompiledContractsResponse
+	4,  // 15: everlast.cpc.v1.Query.CustomPrecompiledContract:output_type -> everlast.cpc.v1.QueryCustomPrecompiledContractResponse
+	7,  // 16: everlast.cpc.v1.Query.CustomPrecompiledContractAbi:output_type -> everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse
+	9,  // 17: everlast.cpc.v1.Query.Erc20CustomPrecompiledContractByDenom:output_type -> everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomResponse
+	11, // 18: everlast.cpc.v1.Query.Params:output_type -> everlast.cpc.v1.QueryParamsResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_everlast_cpc_v1_query_proto_init() }
@@ -4717,7 +6568,7 @@ func file_everlast_cpc_v1_query_proto_init() {
 			}
 		}
 		file_everlast_cpc_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryErc20CustomPrecompiledContractByDenomRequest); i {
+			switch v := v.(*QueryCustomPrecompiledContractAbiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4729,7 +6580,7 @@ func file_everlast_cpc_v1_query_proto_init() {
 			}
 		}
 		file_everlast_cpc_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryErc20CustomPrecompiledContractByDenomResponse); i {
+			switch v := v.(*CustomPrecompiledContractMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4741,7 +6592,7 @@ func file_everlast_cpc_v1_query_proto_init() {
 			}
 		}
 		file_everlast_cpc_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryCustomPrecompiledContractAbiResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4753,6 +6604,42 @@ func file_everlast_cpc_v1_query_proto_init() {
 			}
 		}
 		file_everlast_cpc_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryErc20CustomPrecompiledContractByDenomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryErc20CustomPrecompiledContractByDenomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
@@ -4771,7 +6658,7 @@ func file_everlast_cpc_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_everlast_cpc_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
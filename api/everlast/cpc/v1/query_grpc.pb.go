@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_CustomPrecompiledContracts_FullMethodName            = "/everlast.cpc.v1.Query/CustomPrecompiledContracts"
 	Query_CustomPrecompiledContract_FullMethodName             = "/everlast.cpc.v1.Query/CustomPrecompiledContract"
+	Query_CustomPrecompiledContractAbi_FullMethodName          = "/everlast.cpc.v1.Query/CustomPrecompiledContractAbi"
 	Query_Erc20CustomPrecompiledContractByDenom_FullMethodName = "/everlast.cpc.v1.Query/Erc20CustomPrecompiledContractByDenom"
 	Query_Params_FullMethodName                                = "/everlast.cpc.v1.Query/Params"
 )
@@ -33,6 +34,8 @@ type QueryClient interface {
 	CustomPrecompiledContracts(ctx context.Context, in *QueryCustomPrecompiledContractsRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractsResponse, error)
 	// CustomPrecompiledContract queries the list of deployed custom precompiled contract.
 	CustomPrecompiledContract(ctx context.Context, in *QueryCustomPrecompiledContractRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractResponse, error)
+	// CustomPrecompiledContractAbi queries the ABI and the supported methods of a deployed custom precompiled contract.
+	CustomPrecompiledContractAbi(ctx context.Context, in *QueryCustomPrecompiledContractAbiRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractAbiResponse, error)
 	// Erc20CustomPrecompiledContractByDenom queries the list of deployed custom precompiled contract by denom.
 	Erc20CustomPrecompiledContractByDenom(ctx context.Context, in *QueryErc20CustomPrecompiledContractByDenomRequest, opts ...grpc.CallOption) (*QueryErc20CustomPrecompiledContractByDenomResponse, error)
 	// Params queries the parameters of x/cpc module.
@@ -65,6 +68,15 @@ func (c *queryClient) CustomPrecompiledContract(ctx context.Context, in *QueryCu
 	return out, nil
 }
 
+func (c *queryClient) CustomPrecompiledContractAbi(ctx context.Context, in *QueryCustomPrecompiledContractAbiRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractAbiResponse, error) {
+	out := new(QueryCustomPrecompiledContractAbiResponse)
+	err := c.cc.Invoke(ctx, Query_CustomPrecompiledContractAbi_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Erc20CustomPrecompiledContractByDenom(ctx context.Context, in *QueryErc20CustomPrecompiledContractByDenomRequest, opts ...grpc.CallOption) (*QueryErc20CustomPrecompiledContractByDenomResponse, error) {
 	out := new(QueryErc20CustomPrecompiledContractByDenomResponse)
 	err := c.cc.Invoke(ctx, Query_Erc20CustomPrecompiledContractByDenom_FullMethodName, in, out, opts...)
@@ -91,6 +103,8 @@ type QueryServer interface {
 	CustomPrecompiledContracts(context.Context, *QueryCustomPrecompiledContractsRequest) (*QueryCustomPrecompiledContractsResponse, error)
 	// CustomPrecompiledContract queries the list of deployed custom precompiled contract.
 	CustomPrecompiledContract(context.Context, *QueryCustomPrecompiledContractRequest) (*QueryCustomPrecompiledContractResponse, error)
+	// CustomPrecompiledContractAbi queries the ABI and the supported methods of a deployed custom precompiled contract.
+	CustomPrecompiledContractAbi(context.Context, *QueryCustomPrecompiledContractAbiRequest) (*QueryCustomPrecompiledContractAbiResponse, error)
 	// Erc20CustomPrecompiledContractByDenom queries the list of deployed custom precompiled contract by denom.
 	Erc20CustomPrecompiledContractByDenom(context.Context, *QueryErc20CustomPrecompiledContractByDenomRequest) (*QueryErc20CustomPrecompiledContractByDenomResponse, error)
 	// Params queries the parameters of x/cpc module.
@@ -108,6 +122,9 @@ func (UnimplementedQueryServer) CustomPrecompiledContracts(context.Context, *Que
 func (UnimplementedQueryServer) CustomPrecompiledContract(context.Context, *QueryCustomPrecompiledContractRequest) (*QueryCustomPrecompiledContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CustomPrecompiledContract not implemented")
 }
+func (UnimplementedQueryServer) CustomPrecompiledContractAbi(context.Context, *QueryCustomPrecompiledContractAbiRequest) (*QueryCustomPrecompiledContractAbiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomPrecompiledContractAbi not implemented")
+}
 func (UnimplementedQueryServer) Erc20CustomPrecompiledContractByDenom(context.Context, *QueryErc20CustomPrecompiledContractByDenomRequest) (*QueryErc20CustomPrecompiledContractByDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Erc20CustomPrecompiledContractByDenom not implemented")
 }
@@ -163,6 +180,24 @@ func _Query_CustomPrecompiledContract_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CustomPrecompiledContractAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCustomPrecompiledContractAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CustomPrecompiledContractAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CustomPrecompiledContractAbi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CustomPrecompiledContractAbi(ctx, req.(*QueryCustomPrecompiledContractAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Erc20CustomPrecompiledContractByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryErc20CustomPrecompiledContractByDenomRequest)
 	if err := dec(in); err != nil {
@@ -214,6 +249,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CustomPrecompiledContract",
 			Handler:    _Query_CustomPrecompiledContract_Handler,
 		},
+		{
+			MethodName: "CustomPrecompiledContractAbi",
+			Handler:    _Query_CustomPrecompiledContractAbi_Handler,
+		},
 		{
 			MethodName: "Erc20CustomPrecompiledContractByDenom",
 			Handler:    _Query_Erc20CustomPrecompiledContractByDenom_Handler,
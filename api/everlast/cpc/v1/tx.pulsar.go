@@ -2902,6 +2902,2172 @@ func (x *fastReflection_MsgDeployStakingContractResponse) ProtoMethods() *protoi
 	}
 }
 
+var (
+	md_MsgCreateManagedErc20ContractRequest          protoreflect.MessageDescriptor
+	fd_MsgCreateManagedErc20ContractRequest_creator  protoreflect.FieldDescriptor
+	fd_MsgCreateManagedErc20ContractRequest_subdenom protoreflect.FieldDescriptor
+	fd_MsgCreateManagedErc20ContractRequest_name     protoreflect.FieldDescriptor
+	fd_MsgCreateManagedErc20ContractRequest_symbol   protoreflect.FieldDescriptor
+	fd_MsgCreateManagedErc20ContractRequest_decimals protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_tx_proto_init()
+	md_MsgCreateManagedErc20ContractRequest = File_everlast_cpc_v1_tx_proto.Messages().ByName("MsgCreateManagedErc20ContractRequest")
+	fd_MsgCreateManagedErc20ContractRequest_creator = md_MsgCreateManagedErc20ContractRequest.Fields().ByName("creator")
+	fd_MsgCreateManagedErc20ContractRequest_subdenom = md_MsgCreateManagedErc20ContractRequest.Fields().ByName("subdenom")
+	fd_MsgCreateManagedErc20ContractRequest_name = md_MsgCreateManagedErc20ContractRequest.Fields().ByName("name")
+	fd_MsgCreateManagedErc20ContractRequest_symbol = md_MsgCreateManagedErc20ContractRequest.Fields().ByName("symbol")
+	fd_MsgCreateManagedErc20ContractRequest_decimals = md_MsgCreateManagedErc20ContractRequest.Fields().ByName("decimals")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateManagedErc20ContractRequest)(nil)
+
+type fastReflection_MsgCreateManagedErc20ContractRequest MsgCreateManagedErc20ContractRequest
+
+func (x *MsgCreateManagedErc20ContractRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateManagedErc20ContractRequest)(x)
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateManagedErc20ContractRequest_messageType fastReflection_MsgCreateManagedErc20ContractRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateManagedErc20ContractRequest_messageType{}
+
+type fastReflection_MsgCreateManagedErc20ContractRequest_messageType struct{}
+
+func (x fastReflection_MsgCreateManagedErc20ContractRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateManagedErc20ContractRequest)(nil)
+}
+func (x fastReflection_MsgCreateManagedErc20ContractRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateManagedErc20ContractRequest)
+}
+func (x fastReflection_MsgCreateManagedErc20ContractRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateManagedErc20ContractRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateManagedErc20ContractRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateManagedErc20ContractRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateManagedErc20ContractRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateManagedErc20ContractRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgCreateManagedErc20ContractRequest_creator, value) {
+			return
+		}
+	}
+	if x.Subdenom != "" {
+		value := protoreflect.ValueOfString(x.Subdenom)
+		if !f(fd_MsgCreateManagedErc20ContractRequest_subdenom, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgCreateManagedErc20ContractRequest_name, value) {
+			return
+		}
+	}
+	if x.Symbol != "" {
+		value := protoreflect.ValueOfString(x.Symbol)
+		if !f(fd_MsgCreateManagedErc20ContractRequest_symbol, value) {
+			return
+		}
+	}
+	if x.Decimals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Decimals)
+		if !f(fd_MsgCreateManagedErc20ContractRequest_decimals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.creator":
+		return x.Creator != ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.subdenom":
+		return x.Subdenom != ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.name":
+		return x.Name != ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.symbol":
+		return x.Symbol != ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.decimals":
+		return x.Decimals != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.creator":
+		x.Creator = ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.subdenom":
+		x.Subdenom = ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.name":
+		x.Name = ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.symbol":
+		x.Symbol = ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.decimals":
+		x.Decimals = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.subdenom":
+		value := x.Subdenom
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.symbol":
+		value := x.Symbol
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.subdenom":
+		x.Subdenom = value.Interface().(string)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.name":
+		x.Name = value.Interface().(string)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.symbol":
+		x.Symbol = value.Interface().(string)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.decimals":
+		x.Decimals = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.creator":
+		panic(fmt.Errorf("field creator of message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest is not mutable"))
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.subdenom":
+		panic(fmt.Errorf("field subdenom of message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest is not mutable"))
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.name":
+		panic(fmt.Errorf("field name of message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest is not mutable"))
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.symbol":
+		panic(fmt.Errorf("field symbol of message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest is not mutable"))
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.decimals":
+		panic(fmt.Errorf("field decimals of message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.subdenom":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.name":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.symbol":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest.decimals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractRequest"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.MsgCreateManagedErc20ContractRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateManagedErc20ContractRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateManagedErc20ContractRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Subdenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Symbol)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateManagedErc20ContractRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Symbol) > 0 {
+			i -= len(x.Symbol)
+			copy(dAtA[i:], x.Symbol)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Symbol)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Subdenom) > 0 {
+			i -= len(x.Subdenom)
+			copy(dAtA[i:], x.Subdenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Subdenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateManagedErc20ContractRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateManagedErc20ContractRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateManagedErc20ContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subdenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Symbol = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateManagedErc20ContractResponse                  protoreflect.MessageDescriptor
+	fd_MsgCreateManagedErc20ContractResponse_denom            protoreflect.FieldDescriptor
+	fd_MsgCreateManagedErc20ContractResponse_contract_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_tx_proto_init()
+	md_MsgCreateManagedErc20ContractResponse = File_everlast_cpc_v1_tx_proto.Messages().ByName("MsgCreateManagedErc20ContractResponse")
+	fd_MsgCreateManagedErc20ContractResponse_denom = md_MsgCreateManagedErc20ContractResponse.Fields().ByName("denom")
+	fd_MsgCreateManagedErc20ContractResponse_contract_address = md_MsgCreateManagedErc20ContractResponse.Fields().ByName("contract_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateManagedErc20ContractResponse)(nil)
+
+type fastReflection_MsgCreateManagedErc20ContractResponse MsgCreateManagedErc20ContractResponse
+
+func (x *MsgCreateManagedErc20ContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateManagedErc20ContractResponse)(x)
+}
+
+func (x *MsgCreateManagedErc20ContractResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateManagedErc20ContractResponse_messageType fastReflection_MsgCreateManagedErc20ContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateManagedErc20ContractResponse_messageType{}
+
+type fastReflection_MsgCreateManagedErc20ContractResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateManagedErc20ContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateManagedErc20ContractResponse)(nil)
+}
+func (x fastReflection_MsgCreateManagedErc20ContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateManagedErc20ContractResponse)
+}
+func (x fastReflection_MsgCreateManagedErc20ContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateManagedErc20ContractResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateManagedErc20ContractResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateManagedErc20ContractResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateManagedErc20ContractResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateManagedErc20ContractResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgCreateManagedErc20ContractResponse_denom, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_MsgCreateManagedErc20ContractResponse_contract_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.denom":
+		return x.Denom != ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.contract_address":
+		return x.ContractAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.denom":
+		x.Denom = ""
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.contract_address":
+		x.ContractAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.denom":
+		x.Denom = value.Interface().(string)
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.denom":
+		panic(fmt.Errorf("field denom of message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse is not mutable"))
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.contract_address":
+		panic(fmt.Errorf("field contract_address of message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.denom":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse.contract_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgCreateManagedErc20ContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgCreateManagedErc20ContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.MsgCreateManagedErc20ContractResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateManagedErc20ContractResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateManagedErc20ContractResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateManagedErc20ContractResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateManagedErc20ContractResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateManagedErc20ContractResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateManagedErc20ContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateCustomPrecompiledContract                  protoreflect.MessageDescriptor
+	fd_MsgUpdateCustomPrecompiledContract_authority        protoreflect.FieldDescriptor
+	fd_MsgUpdateCustomPrecompiledContract_contract_address protoreflect.FieldDescriptor
+	fd_MsgUpdateCustomPrecompiledContract_name             protoreflect.FieldDescriptor
+	fd_MsgUpdateCustomPrecompiledContract_typed_meta       protoreflect.FieldDescriptor
+	fd_MsgUpdateCustomPrecompiledContract_disabled         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_tx_proto_init()
+	md_MsgUpdateCustomPrecompiledContract = File_everlast_cpc_v1_tx_proto.Messages().ByName("MsgUpdateCustomPrecompiledContract")
+	fd_MsgUpdateCustomPrecompiledContract_authority = md_MsgUpdateCustomPrecompiledContract.Fields().ByName("authority")
+	fd_MsgUpdateCustomPrecompiledContract_contract_address = md_MsgUpdateCustomPrecompiledContract.Fields().ByName("contract_address")
+	fd_MsgUpdateCustomPrecompiledContract_name = md_MsgUpdateCustomPrecompiledContract.Fields().ByName("name")
+	fd_MsgUpdateCustomPrecompiledContract_typed_meta = md_MsgUpdateCustomPrecompiledContract.Fields().ByName("typed_meta")
+	fd_MsgUpdateCustomPrecompiledContract_disabled = md_MsgUpdateCustomPrecompiledContract.Fields().ByName("disabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCustomPrecompiledContract)(nil)
+
+type fastReflection_MsgUpdateCustomPrecompiledContract MsgUpdateCustomPrecompiledContract
+
+func (x *MsgUpdateCustomPrecompiledContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCustomPrecompiledContract)(x)
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateCustomPrecompiledContract_messageType fastReflection_MsgUpdateCustomPrecompiledContract_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCustomPrecompiledContract_messageType{}
+
+type fastReflection_MsgUpdateCustomPrecompiledContract_messageType struct{}
+
+func (x fastReflection_MsgUpdateCustomPrecompiledContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCustomPrecompiledContract)(nil)
+}
+func (x fastReflection_MsgUpdateCustomPrecompiledContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCustomPrecompiledContract)
+}
+func (x fastReflection_MsgUpdateCustomPrecompiledContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCustomPrecompiledContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCustomPrecompiledContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCustomPrecompiledContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCustomPrecompiledContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCustomPrecompiledContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateCustomPrecompiledContract_authority, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_MsgUpdateCustomPrecompiledContract_contract_address, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgUpdateCustomPrecompiledContract_name, value) {
+			return
+		}
+	}
+	if x.TypedMeta != "" {
+		value := protoreflect.ValueOfString(x.TypedMeta)
+		if !f(fd_MsgUpdateCustomPrecompiledContract_typed_meta, value) {
+			return
+		}
+	}
+	if x.Disabled != false {
+		value := protoreflect.ValueOfBool(x.Disabled)
+		if !f(fd_MsgUpdateCustomPrecompiledContract_disabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.authority":
+		return x.Authority != ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.contract_address":
+		return x.ContractAddress != ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.name":
+		return x.Name != ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.typed_meta":
+		return x.TypedMeta != ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.disabled":
+		return x.Disabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContract"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.authority":
+		x.Authority = ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.contract_address":
+		x.ContractAddress = ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.name":
+		x.Name = ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.typed_meta":
+		x.TypedMeta = ""
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.disabled":
+		x.Disabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContract"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.typed_meta":
+		value := x.TypedMeta
+		return protoreflect.ValueOfString(value)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.disabled":
+		value := x.Disabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContract"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.authority":
+		x.Authority = value.Interface().(string)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.name":
+		x.Name = value.Interface().(string)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.typed_meta":
+		x.TypedMeta = value.Interface().(string)
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.disabled":
+		x.Disabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContract"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.authority":
+		panic(fmt.Errorf("field authority of message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract is not mutable"))
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.contract_address":
+		panic(fmt.Errorf("field contract_address of message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract is not mutable"))
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.name":
+		panic(fmt.Errorf("field name of message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract is not mutable"))
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.typed_meta":
+		panic(fmt.Errorf("field typed_meta of message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract is not mutable"))
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.disabled":
+		panic(fmt.Errorf("field disabled of message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContract"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.authority":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.contract_address":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.name":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.typed_meta":
+		return protoreflect.ValueOfString("")
+	case "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract.disabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContract"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.MsgUpdateCustomPrecompiledContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateCustomPrecompiledContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TypedMeta)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Disabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCustomPrecompiledContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Disabled {
+			i--
+			if x.Disabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.TypedMeta) > 0 {
+			i -= len(x.TypedMeta)
+			copy(dAtA[i:], x.TypedMeta)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TypedMeta)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCustomPrecompiledContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCustomPrecompiledContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCustomPrecompiledContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypedMeta", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TypedMeta = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Disabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateCustomPrecompiledContractResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_everlast_cpc_v1_tx_proto_init()
+	md_MsgUpdateCustomPrecompiledContractResponse = File_everlast_cpc_v1_tx_proto.Messages().ByName("MsgUpdateCustomPrecompiledContractResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCustomPrecompiledContractResponse)(nil)
+
+type fastReflection_MsgUpdateCustomPrecompiledContractResponse MsgUpdateCustomPrecompiledContractResponse
+
+func (x *MsgUpdateCustomPrecompiledContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCustomPrecompiledContractResponse)(x)
+}
+
+func (x *MsgUpdateCustomPrecompiledContractResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_everlast_cpc_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType{}
+
+type fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCustomPrecompiledContractResponse)(nil)
+}
+func (x fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCustomPrecompiledContractResponse)
+}
+func (x fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCustomPrecompiledContractResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCustomPrecompiledContractResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCustomPrecompiledContractResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCustomPrecompiledContractResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCustomPrecompiledContractResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse"))
+		}
+		panic(fmt.Errorf("message everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateCustomPrecompiledContractResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateCustomPrecompiledContractResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCustomPrecompiledContractResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCustomPrecompiledContractResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCustomPrecompiledContractResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCustomPrecompiledContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -3191,6 +5357,227 @@ func (x *MsgDeployStakingContractResponse) GetContractAddress() string {
 	return ""
 }
 
+// MsgCreateManagedErc20ContractRequest defines a Msg for creating a new factory denom
+// and deploying the managed ERC20 contract for it.
+type MsgCreateManagedErc20ContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator is the address of the creator account, will be the owner of the managed ERC20 contract.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// subdenom is the sub-denom of the new denom, the full denom is in format: factory/{creator}/{subdenom}.
+	Subdenom string `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty"`
+	// name is the name of the ERC20 token.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the symbol of the ERC20 token.
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// decimals is the number of decimals of the ERC20 token.
+	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) Reset() {
+	*x = MsgCreateManagedErc20ContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateManagedErc20ContractRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateManagedErc20ContractRequest.ProtoReflect.Descriptor instead.
+func (*MsgCreateManagedErc20ContractRequest) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) GetSubdenom() string {
+	if x != nil {
+		return x.Subdenom
+	}
+	return ""
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MsgCreateManagedErc20ContractRequest) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+// MsgCreateManagedErc20ContractResponse defines the Msg/CreateManagedErc20Contract response type.
+type MsgCreateManagedErc20ContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the created factory denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// contract_address is the address of the deployed managed ERC20 contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (x *MsgCreateManagedErc20ContractResponse) Reset() {
+	*x = MsgCreateManagedErc20ContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateManagedErc20ContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateManagedErc20ContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateManagedErc20ContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateManagedErc20ContractResponse) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgCreateManagedErc20ContractResponse) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgCreateManagedErc20ContractResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+// MsgUpdateCustomPrecompiledContract defines a Msg for updating a deployed custom precompiled contract.
+type MsgUpdateCustomPrecompiledContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the hex address of the custom precompiled contract to be updated.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// name is the new name of the contract, keep the current name if empty.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// typed_meta is the new json-encoded type-based metadata of the contract, keep the current metadata if empty.
+	TypedMeta string `protobuf:"bytes,4,opt,name=typed_meta,json=typedMeta,proto3" json:"typed_meta,omitempty"`
+	// disabled is the new value of the disabled flag of the contract.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) Reset() {
+	*x = MsgUpdateCustomPrecompiledContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateCustomPrecompiledContract) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateCustomPrecompiledContract.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCustomPrecompiledContract) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) GetTypedMeta() string {
+	if x != nil {
+		return x.TypedMeta
+	}
+	return ""
+}
+
+func (x *MsgUpdateCustomPrecompiledContract) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// MsgUpdateCustomPrecompiledContractResponse defines the response structure for executing a
+// MsgUpdateCustomPrecompiledContract message.
+type MsgUpdateCustomPrecompiledContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateCustomPrecompiledContractResponse) Reset() {
+	*x = MsgUpdateCustomPrecompiledContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_everlast_cpc_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateCustomPrecompiledContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateCustomPrecompiledContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateCustomPrecompiledContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCustomPrecompiledContractResponse) Descriptor() ([]byte, []int) {
+	return file_everlast_cpc_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
 var File_everlast_cpc_v1_tx_proto protoreflect.FileDescriptor
 
 var file_everlast_cpc_v1_tx_proto_rawDesc = []byte{
@@ -3244,7 +5631,44 @@ var file_everlast_cpc_v1_tx_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
 	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x32, 0xd7, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5a, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x22, 0xcc, 0x01, 0x0a, 0x24, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x64, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x75, 0x62, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x75, 0x62, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x68, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x22, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70,
+	0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x22, 0x2c, 0x0a, 0x2a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xfb, 0x04, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5a, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c,
 	0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x28, 0x2e, 0x65, 0x76, 0x65,
@@ -3265,18 +5689,36 @@ var file_everlast_cpc_v1_tx_proto_rawDesc = []byte{
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61,
 	0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70,
 	0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa4, 0x01, 0x0a, 0x13, 0x63,
-	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e,
-	0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b,
-	0x63, 0x70, 0x63, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x43, 0x58, 0xaa, 0x02, 0x0f, 0x45, 0x76,
-	0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x70, 0x63, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f,
-	0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1b, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11,
-	0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x3a, 0x3a, 0x43, 0x70, 0x63, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x45, 0x72, 0x63, 0x32,
+	0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x35, 0x2e, 0x65, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x45, 0x72, 0x63, 0x32,
+	0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x33, 0x2e, 0x65,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x1a, 0x3b, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa4,
+	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e,
+	0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x70, 0x63,
+	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x70, 0x63, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x43, 0x58, 0xaa,
+	0x02, 0x0f, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x70, 0x63, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0f, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43, 0x70, 0x63,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x5c, 0x43,
+	0x70, 0x63, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x11, 0x45, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x73, 0x74, 0x3a, 0x3a, 0x43, 0x70,
+	0x63, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3291,29 +5733,37 @@ func file_everlast_cpc_v1_tx_proto_rawDescGZIP() []byte {
 	return file_everlast_cpc_v1_tx_proto_rawDescData
 }
 
-var file_everlast_cpc_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_everlast_cpc_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_everlast_cpc_v1_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),                  // 0: everlast.cpc.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),          // 1: everlast.cpc.v1.MsgUpdateParamsResponse
-	(*MsgDeployErc20ContractRequest)(nil),    // 2: everlast.cpc.v1.MsgDeployErc20ContractRequest
-	(*MsgDeployErc20ContractResponse)(nil),   // 3: everlast.cpc.v1.MsgDeployErc20ContractResponse
-	(*MsgDeployStakingContractRequest)(nil),  // 4: everlast.cpc.v1.MsgDeployStakingContractRequest
-	(*MsgDeployStakingContractResponse)(nil), // 5: everlast.cpc.v1.MsgDeployStakingContractResponse
-	(*Params)(nil),                           // 6: everlast.cpc.v1.Params
+	(*MsgUpdateParams)(nil),                            // 0: everlast.cpc.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),                    // 1: everlast.cpc.v1.MsgUpdateParamsResponse
+	(*MsgDeployErc20ContractRequest)(nil),              // 2: everlast.cpc.v1.MsgDeployErc20ContractRequest
+	(*MsgDeployErc20ContractResponse)(nil),             // 3: everlast.cpc.v1.MsgDeployErc20ContractResponse
+	(*MsgDeployStakingContractRequest)(nil),            // 4: everlast.cpc.v1.MsgDeployStakingContractRequest
+	(*MsgDeployStakingContractResponse)(nil),           // 5: everlast.cpc.v1.MsgDeployStakingContractResponse
+	(*MsgCreateManagedErc20ContractRequest)(nil),       // 6: everlast.cpc.v1.MsgCreateManagedErc20ContractRequest
+	(*MsgCreateManagedErc20ContractResponse)(nil),      // 7: everlast.cpc.v1.MsgCreateManagedErc20ContractResponse
+	(*MsgUpdateCustomPrecompiledContract)(nil),         // 8: everlast.cpc.v1.MsgUpdateCustomPrecompiledContract
+	(*MsgUpdateCustomPrecompiledContractResponse)(nil), // 9: everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse
+	(*Params)(nil), // 10: everlast.cpc.v1.Params
 }
 var file_everlast_cpc_v1_tx_proto_depIdxs = []int32{
-	6, // 0: everlast.cpc.v1.MsgUpdateParams.new_params:type_name -> everlast.cpc.v1.Params
-	0, // 1: everlast.cpc.v1.Msg.UpdateParams:input_type -> everlast.cpc.v1.MsgUpdateParams
-	2, // 2: everlast.cpc.v1.Msg.DeployErc20Contract:input_type -> everlast.cpc.v1.MsgDeployErc20ContractRequest
-	4, // 3: everlast.cpc.v1.Msg.DeployStakingContract:input_type -> everlast.cpc.v1.MsgDeployStakingContractRequest
-	1, // 4: everlast.cpc.v1.Msg.UpdateParams:output_type -> everlast.cpc.v1.MsgUpdateParamsResponse
-	3, // 5: everlast.cpc.v1.Msg.DeployErc20Contract:output_type -> everlast.cpc.v1.MsgDeployErc20ContractResponse
-	5, // 6: everlast.cpc.v1.Msg.DeployStakingContract:output_type -> everlast.cpc.v1.MsgDeployStakingContractResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: everlast.cpc.v1.MsgUpdateParams.new_params:type_name -> everlast.cpc.v1.Params
+	0,  // 1: everlast.cpc.v1.Msg.UpdateParams:input_type -> everlast.cpc.v1.MsgUpdateParams
+	2,  // 2: everlast.cpc.v1.Msg.DeployErc20Contract:input_type -> everlast.cpc.v1.MsgDeployErc20ContractRequest
+	4,  // 3: everlast.cpc.v1.Msg.DeployStakingContract:input_type -> everlast.cpc.v1.MsgDeployStakingContractRequest
+	6,  // 4: everlast.cpc.v1.Msg.CreateManagedErc20Contract:input_type -> everlast.cpc.v1.MsgCreateManagedErc20ContractRequest
+	8,  // 5: everlast.cpc.v1.Msg.UpdateCustomPrecompiledContract:input_type -> everlast.cpc.v1.MsgUpdateCustomPrecompiledContract
+	1,  // 6: everlast.cpc.v1.Msg.UpdateParams:output_type -> everlast.cpc.v1.MsgUpdateParamsResponse
+	3,  // 7: everlast.cpc.v1.Msg.DeployErc20Contract:output_type -> everlast.cpc.v1.MsgDeployErc20ContractResponse
+	5,  // 8: everlast.cpc.v1.Msg.DeployStakingContract:output_type -> everlast.cpc.v1.MsgDeployStakingContractResponse
+	7,  // 9: everlast.cpc.v1.Msg.CreateManagedErc20Contract:output_type -> everlast.cpc.v1.MsgCreateManagedErc20ContractResponse
+	9,  // 10: everlast.cpc.v1.Msg.UpdateCustomPrecompiledContract:output_type -> everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse
+	6,  // [6:11] is the sub-list for method output_type
+	1,  // [1:6] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_everlast_cpc_v1_tx_proto_init() }
@@ -3395,6 +5845,54 @@ func file_everlast_cpc_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_everlast_cpc_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateManagedErc20ContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateManagedErc20ContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateCustomPrecompiledContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_everlast_cpc_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateCustomPrecompiledContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3402,7 +5900,7 @@ func file_everlast_cpc_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_everlast_cpc_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
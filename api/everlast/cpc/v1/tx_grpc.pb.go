@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName          = "/everlast.cpc.v1.Msg/UpdateParams"
-	Msg_DeployErc20Contract_FullMethodName   = "/everlast.cpc.v1.Msg/DeployErc20Contract"
-	Msg_DeployStakingContract_FullMethodName = "/everlast.cpc.v1.Msg/DeployStakingContract"
+	Msg_UpdateParams_FullMethodName                    = "/everlast.cpc.v1.Msg/UpdateParams"
+	Msg_DeployErc20Contract_FullMethodName             = "/everlast.cpc.v1.Msg/DeployErc20Contract"
+	Msg_DeployStakingContract_FullMethodName           = "/everlast.cpc.v1.Msg/DeployStakingContract"
+	Msg_CreateManagedErc20Contract_FullMethodName      = "/everlast.cpc.v1.Msg/CreateManagedErc20Contract"
+	Msg_UpdateCustomPrecompiledContract_FullMethodName = "/everlast.cpc.v1.Msg/UpdateCustomPrecompiledContract"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +37,13 @@ type MsgClient interface {
 	DeployErc20Contract(ctx context.Context, in *MsgDeployErc20ContractRequest, opts ...grpc.CallOption) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(ctx context.Context, in *MsgDeployStakingContractRequest, opts ...grpc.CallOption) (*MsgDeployStakingContractResponse, error)
+	// CreateManagedErc20Contract defines a method creating a new factory denom
+	// and deploying the managed ERC20 contract for it, owned by the creator.
+	CreateManagedErc20Contract(ctx context.Context, in *MsgCreateManagedErc20ContractRequest, opts ...grpc.CallOption) (*MsgCreateManagedErc20ContractResponse, error)
+	// UpdateCustomPrecompiledContract defined a governance operation for updating
+	// the name, typed metadata and the disabled flag of a deployed custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateCustomPrecompiledContract(ctx context.Context, in *MsgUpdateCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgUpdateCustomPrecompiledContractResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +81,24 @@ func (c *msgClient) DeployStakingContract(ctx context.Context, in *MsgDeployStak
 	return out, nil
 }
 
+func (c *msgClient) CreateManagedErc20Contract(ctx context.Context, in *MsgCreateManagedErc20ContractRequest, opts ...grpc.CallOption) (*MsgCreateManagedErc20ContractResponse, error) {
+	out := new(MsgCreateManagedErc20ContractResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateManagedErc20Contract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCustomPrecompiledContract(ctx context.Context, in *MsgUpdateCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgUpdateCustomPrecompiledContractResponse, error) {
+	out := new(MsgUpdateCustomPrecompiledContractResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCustomPrecompiledContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -83,6 +110,13 @@ type MsgServer interface {
 	DeployErc20Contract(context.Context, *MsgDeployErc20ContractRequest) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(context.Context, *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error)
+	// CreateManagedErc20Contract defines a method creating a new factory denom
+	// and deploying the managed ERC20 contract for it, owned by the creator.
+	CreateManagedErc20Contract(context.Context, *MsgCreateManagedErc20ContractRequest) (*MsgCreateManagedErc20ContractResponse, error)
+	// UpdateCustomPrecompiledContract defined a governance operation for updating
+	// the name, typed metadata and the disabled flag of a deployed custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateCustomPrecompiledContract(context.Context, *MsgUpdateCustomPrecompiledContract) (*MsgUpdateCustomPrecompiledContractResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -99,6 +133,12 @@ func (UnimplementedMsgServer) DeployErc20Contract(context.Context, *MsgDeployErc
 func (UnimplementedMsgServer) DeployStakingContract(context.Context, *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployStakingContract not implemented")
 }
+func (UnimplementedMsgServer) CreateManagedErc20Contract(context.Context, *MsgCreateManagedErc20ContractRequest) (*MsgCreateManagedErc20ContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateManagedErc20Contract not implemented")
+}
+func (UnimplementedMsgServer) UpdateCustomPrecompiledContract(context.Context, *MsgUpdateCustomPrecompiledContract) (*MsgUpdateCustomPrecompiledContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomPrecompiledContract not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +206,42 @@ func _Msg_DeployStakingContract_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateManagedErc20Contract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateManagedErc20ContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateManagedErc20Contract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateManagedErc20Contract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateManagedErc20Contract(ctx, req.(*MsgCreateManagedErc20ContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCustomPrecompiledContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCustomPrecompiledContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCustomPrecompiledContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCustomPrecompiledContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCustomPrecompiledContract(ctx, req.(*MsgUpdateCustomPrecompiledContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +261,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeployStakingContract",
 			Handler:    _Msg_DeployStakingContract_Handler,
 		},
+		{
+			MethodName: "CreateManagedErc20Contract",
+			Handler:    _Msg_CreateManagedErc20Contract_Handler,
+		},
+		{
+			MethodName: "UpdateCustomPrecompiledContract",
+			Handler:    _Msg_UpdateCustomPrecompiledContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "everlast/cpc/v1/tx.proto",
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_ProofExternalOwnedAccount_FullMethodName = "/everlast.vauth.v1.Query/ProofExternalOwnedAccount"
+	Query_ProofExternalOwnedAccount_FullMethodName  = "/everlast.vauth.v1.Query/ProofExternalOwnedAccount"
+	Query_ProofsExternalOwnedAccount_FullMethodName = "/everlast.vauth.v1.Query/ProofsExternalOwnedAccount"
+	Query_Params_FullMethodName                     = "/everlast.vauth.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +30,10 @@ const (
 type QueryClient interface {
 	// ProofExternalOwnedAccount returns proof of external owned account (EOA)
 	ProofExternalOwnedAccount(ctx context.Context, in *QueryProofExternalOwnedAccountRequest, opts ...grpc.CallOption) (*QueryProofExternalOwnedAccountResponse, error)
+	// ProofsExternalOwnedAccount returns the list of proofs of external owned account (EOA)
+	ProofsExternalOwnedAccount(ctx context.Context, in *QueryProofsExternalOwnedAccountRequest, opts ...grpc.CallOption) (*QueryProofsExternalOwnedAccountResponse, error)
+	// Params queries the parameters of x/vauth module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +53,34 @@ func (c *queryClient) ProofExternalOwnedAccount(ctx context.Context, in *QueryPr
 	return out, nil
 }
 
+func (c *queryClient) ProofsExternalOwnedAccount(ctx context.Context, in *QueryProofsExternalOwnedAccountRequest, opts ...grpc.CallOption) (*QueryProofsExternalOwnedAccountResponse, error) {
+	out := new(QueryProofsExternalOwnedAccountResponse)
+	err := c.cc.Invoke(ctx, Query_ProofsExternalOwnedAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// ProofExternalOwnedAccount returns proof of external owned account (EOA)
 	ProofExternalOwnedAccount(context.Context, *QueryProofExternalOwnedAccountRequest) (*QueryProofExternalOwnedAccountResponse, error)
+	// ProofsExternalOwnedAccount returns the list of proofs of external owned account (EOA)
+	ProofsExternalOwnedAccount(context.Context, *QueryProofsExternalOwnedAccountRequest) (*QueryProofsExternalOwnedAccountResponse, error)
+	// Params queries the parameters of x/vauth module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +91,12 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) ProofExternalOwnedAccount(context.Context, *QueryProofExternalOwnedAccountRequest) (*QueryProofExternalOwnedAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProofExternalOwnedAccount not implemented")
 }
+func (UnimplementedQueryServer) ProofsExternalOwnedAccount(context.Context, *QueryProofsExternalOwnedAccountRequest) (*QueryProofsExternalOwnedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProofsExternalOwnedAccount not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +128,42 @@ func _Query_ProofExternalOwnedAccount_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProofsExternalOwnedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProofsExternalOwnedAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProofsExternalOwnedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProofsExternalOwnedAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProofsExternalOwnedAccount(ctx, req.(*QueryProofsExternalOwnedAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +175,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProofExternalOwnedAccount",
 			Handler:    _Query_ProofExternalOwnedAccount_Handler,
 		},
+		{
+			MethodName: "ProofsExternalOwnedAccount",
+			Handler:    _Query_ProofsExternalOwnedAccount_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "everlast/vauth/v1/query.proto",
@@ -46,14 +46,19 @@ type HandlerOptions struct {
 }
 
 func (options HandlerOptions) WithDefaultDisabledNestedMsgs() HandlerOptions {
-	options.DisabledNestedMsgs = []string{
+	options.DisabledNestedMsgs = DefaultDisabledNestedMsgs()
+
+	return options
+}
+
+// DefaultDisabledNestedMsgs returns the default list of messages that are not allowed to be executed by `x/authz` module.
+func DefaultDisabledNestedMsgs() []string {
+	return []string{
 		sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
 		sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
 		sdk.MsgTypeURL(&sdkvesting.MsgCreatePeriodicVestingAccount{}),
 		sdk.MsgTypeURL(&sdkvesting.MsgCreatePermanentLockedAccount{}),
 	}
-
-	return options
 }
 
 // Validate checks if the keepers are defined
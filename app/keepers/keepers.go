@@ -3,6 +3,8 @@ package keepers
 import (
 	"os"
 
+	"github.com/EscanBE/everlast/app/antedl"
+	"github.com/EscanBE/everlast/x/cpc"
 	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 
@@ -390,7 +392,9 @@ func NewAppKeeper(
 		appKeepers.VAuthKeeper = vauthkeeper.NewKeeper(
 			appCodec,
 			keys[vauthtypes.StoreKey],
+			authtypes.NewModuleAddress(govtypes.ModuleName),
 			appKeepers.BankKeeper,
+			appKeepers.DistrKeeper,
 			*appKeepers.EvmKeeper,
 		)
 
@@ -402,6 +406,12 @@ func NewAppKeeper(
 			appKeepers.BankKeeper,
 			*appKeepers.StakingKeeper,
 			appKeepers.DistrKeeper,
+			appKeepers.SlashingKeeper,
+			appKeepers.AuthzKeeper,
+			appKeepers.GovKeeper,
+			appKeepers.TransferKeeper,
+			appKeepers.VAuthKeeper,
+			antedl.DefaultDisabledNestedMsgs(), // same as the ante handler
 		)
 
 		appKeepers.EvmKeeper.WithCpcKeeper(appKeepers.CPCKeeper)
@@ -411,7 +421,7 @@ func NewAppKeeper(
 		ibcRouter := porttypes.NewRouter()
 		ibcRouter.
 			AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(appKeepers.ICAHostKeeper)).
-			AddRoute(ibctransfertypes.ModuleName, ibctransfer.NewIBCModule(appKeepers.TransferKeeper))
+			AddRoute(ibctransfertypes.ModuleName, cpc.NewIBCMiddleware(appKeepers.CPCKeeper, ibctransfer.NewIBCModule(appKeepers.TransferKeeper)))
 
 		appKeepers.IBCKeeper.SetRouter(ibcRouter)
 	}
@@ -69,7 +69,7 @@ var maccPerms = map[string][]string{
 	icatypes.ModuleName:            nil,
 	evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance
 	vauthtypes.ModuleName:          {authtypes.Burner},
-	cpctypes.ModuleName:            {authtypes.Burner},
+	cpctypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
 }
 
 // ModuleBasics defines the module BasicManager is in charge of setting up basic,
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
 )
@@ -200,6 +201,29 @@ func (b *Backend) CometBFTBlockByHash(blockHash common.Hash) (*cmtrpctypes.Resul
 	return resBlock, nil
 }
 
+// CometBFTBlockByNumberOrHash returns a CometBFT-formatted block by block number or hash.
+// It returns nil if the block could not be found.
+func (b *Backend) CometBFTBlockByNumberOrHash(blockNrOrHash rpctypes.BlockNumberOrHash) (*cmtrpctypes.ResultBlock, error) {
+	if blockNrOrHash.BlockHash != nil {
+		return b.CometBFTBlockByHash(*blockNrOrHash.BlockHash)
+	}
+
+	blockNum, err := b.BlockNumberFromCometBFT(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.CometBFTBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	return resBlock, nil
+}
+
 // BlockNumberFromCometBFT returns the BlockNumber from BlockNumberOrHash
 func (b *Backend) BlockNumberFromCometBFT(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error) {
 	switch {
@@ -482,6 +506,29 @@ func (b *Backend) EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Blo
 	return b.EthBlockFromCometBFTBlock(resBlock, blockRes)
 }
 
+// GetRawBlock returns the RLP encoding of the Ethereum block identified by number or hash.
+func (b *Backend) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	resBlock, err := b.CometBFTBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, errors.New("block not found")
+	}
+
+	blockRes, err := b.CometBFTBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	ethBlock, err := b.EthBlockFromCometBFTBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(ethBlock)
+}
+
 // EthBlockFromCometBFTBlock returns an Ethereum Block type from CometBFT block
 // EthBlockFromCometBFTBlock
 func (b *Backend) EthBlockFromCometBFTBlock(
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -1714,6 +1715,75 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetRawBlock() {
+	emptyBlock := cmttypes.MakeBlock(1, []cmttypes.Tx{}, nil, nil)
+	blockNumber := ethrpc.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expHeader    *ethtypes.Header
+		expPass      bool
+	}{
+		{
+			name: "fail - CometBFT client failed to get block",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - block result not found for height",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			expPass: false,
+		},
+		{
+			name: "pass - block without tx",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1))
+			},
+			expHeader: ethrpc.EthHeaderFromCometBFT(
+				emptyBlock.Header,
+				ethtypes.Bloom{},
+				sdkmath.NewInt(1).BigInt(),
+			),
+			expPass: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			rawBlock, err := suite.backend.GetRawBlock(ethrpc.BlockNumberOrHash{BlockNumber: &blockNumber})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				var ethBlock ethtypes.Block
+				suite.Require().NoError(rlp.DecodeBytes(rawBlock, &ethBlock))
+				suite.Require().Equal(tc.expHeader.Hash(), ethBlock.Hash())
+				suite.Require().Empty(ethBlock.Transactions())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(rawBlock)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestEthBlockFromCometBFTBlock() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 	emptyBlock := cmttypes.MakeBlock(1, []cmttypes.Tx{}, nil, nil)
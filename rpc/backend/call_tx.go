@@ -283,7 +283,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -299,7 +299,9 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -310,9 +312,15 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		return 0, err
 	}
 
+	bzOverrides, err := marshalStateOverrides(overrides)
+	if err != nil {
+		return 0, err
+	}
+
 	req := evmtypes.EthCallRequest{
-		Args:   bz,
-		GasCap: b.RPCGasCap(),
+		Args:      bz,
+		GasCap:    b.RPCGasCap(),
+		Overrides: bzOverrides,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -325,19 +333,85 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	return hexutil.Uint64(res.Gas), nil
 }
 
+// CreateAccessList returns the access list the given transaction would need when executed on top of the given block,
+// along with the gas used and the execution error, if any, when the access list is applied.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	blockNr := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNr, err = b.BlockNumberFromCometBFT(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:   bz,
+		GasCap: b.RPCGasCap(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	// Make sure the context is canceled when the call has completed
+	// this makes sure resources are cleaned up.
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessList ethtypes.AccessList
+	if err := json.Unmarshal(res.AccessList, &accessList); err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: &accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
 
+	bzOverrides, err := marshalStateOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
 	req := evmtypes.EthCallRequest{
-		Args:   bz,
-		GasCap: b.RPCGasCap(),
+		Args:      bz,
+		GasCap:    b.RPCGasCap(),
+		Overrides: bzOverrides,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -374,6 +448,15 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// marshalStateOverrides returns the JSON-encoded state overrides, or nil if no override was provided.
+func marshalStateOverrides(overrides *rpctypes.StateOverride) ([]byte, error) {
+	if overrides == nil || len(*overrides) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(overrides)
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
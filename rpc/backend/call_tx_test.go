@@ -220,7 +220,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -251,7 +251,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterUnconfirmedTxsEmpty(client)
 
 				indexer := suite.backend.indexer.(*mocks.EVMTxIndexer)
 				RegisterIndexerGetLastRequestIndexedBlock(indexer, 1)
@@ -462,7 +462,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -473,6 +473,65 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To: &toAddr,
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	accessList := ethtypes.AccessList{
+		{Address: utiltx.GenerateAddress(), StorageKeys: []common.Hash{{}}},
+	}
+	blockNum := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			name: "fail - Invalid request",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCreateAccessListError(queryClient, &evmtypes.EthCallRequest{Args: argsBz})
+			},
+			expResult: nil,
+			expPass:   false,
+		},
+		{
+			name: "pass - Returned access list",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCreateAccessList(queryClient, &evmtypes.EthCallRequest{Args: argsBz}, accessList)
+			},
+			expResult: &rpctypes.AccessListResult{
+				AccessList: &accessList,
+				GasUsed:    21000,
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(callArgs, &rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
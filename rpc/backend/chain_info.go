@@ -82,7 +82,13 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	if !ok {
 		return nil, errors.New("invalid rpc client")
 	}
-	res, err := mc.UnconfirmedTxs(b.ctx, nil)
+	// without limit, only the default page of the pool is returned, the node still caps the limit to its max page size
+	num, err := mc.NumUnconfirmedTxs(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := num.Total
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
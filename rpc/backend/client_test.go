@@ -63,20 +63,20 @@ func RegisterBroadcastTxError(client *mocks.Client, tx cmttypes.Tx) {
 }
 
 // Unconfirmed Transactions
-func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []cmttypes.Tx) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
-		Return(&cmtrpctypes.ResultUnconfirmedTxs{Txs: txs}, nil)
+func RegisterUnconfirmedTxs(client *mocks.Client, txs []cmttypes.Tx) {
+	limit := len(txs)
+	client.On("NumUnconfirmedTxs", rpc.ContextWithHeight(1)).
+		Return(&cmtrpctypes.ResultUnconfirmedTxs{Count: limit, Total: limit}, nil)
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), &limit).
+		Return(&cmtrpctypes.ResultUnconfirmedTxs{Count: limit, Total: limit, Txs: txs}, nil)
 }
 
-func RegisterUnconfirmedTxsEmpty(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
-		Return(&cmtrpctypes.ResultUnconfirmedTxs{
-			Txs: make([]cmttypes.Tx, 2),
-		}, nil)
+func RegisterUnconfirmedTxsEmpty(client *mocks.Client) {
+	RegisterUnconfirmedTxs(client, make([]cmttypes.Tx, 2))
 }
 
-func RegisterUnconfirmedTxsError(client *mocks.Client, limit *int) {
-	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+func RegisterUnconfirmedTxsError(client *mocks.Client) {
+	client.On("NumUnconfirmedTxs", rpc.ContextWithHeight(1)).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
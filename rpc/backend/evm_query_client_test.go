@@ -17,6 +17,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -64,6 +65,18 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, height int64, matcher func(*evmtypes.QueryTraceCallRequest) bool) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(height), mock.MatchedBy(matcher)).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(height), mock.Anything).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -151,6 +164,20 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Create Access List
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, accessList ethtypes.AccessList) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	bz, _ := json.Marshal(accessList)
+	queryClient.On("CreateAccessList", ctx, request).
+		Return(&evmtypes.CreateAccessListResponse{AccessList: bz, GasUsed: 21000}, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("CreateAccessList", ctx, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -258,6 +285,22 @@ func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, hei
 		)
 }
 
+func RegisterAccountWithNonce(queryClient *mocks.EVMQueryClient, addr common.Address, height int64, nonce uint64) {
+	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
+		Return(&evmtypes.QueryAccountResponse{
+			Balance:  "0",
+			CodeHash: "",
+			Nonce:    nonce,
+		},
+			nil,
+		)
+}
+
+func RegisterAccountError(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
+	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Balance
 func RegisterBalance(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Balance", rpc.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
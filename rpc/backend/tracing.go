@@ -169,3 +169,75 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs created during the execution of EVM
+// if the given transaction was added on top of the provided block and returns them as a JSON object.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	blockNum, err := b.BlockNumberFromCometBFT(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.CometBFTBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "number", blockNum)
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, fmt.Errorf("block not found for number %d", blockNum.Int64())
+	}
+
+	bzArgs, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bzArgs,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+	}
+
+	if config != nil {
+		traceConfig := config.TraceConfig
+		if len(config.TracerConfig) > 0 {
+			traceConfig.TracerJsonConfig = string(config.TracerConfig)
+		}
+		traceCallRequest.TraceConfig = &traceConfig
+
+		traceCallRequest.StateOverrides, err = marshalStateOverrides(config.StateOverrides)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.BlockOverrides != nil {
+			traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the call is executed on top of the provided block, so the state after the block is used
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
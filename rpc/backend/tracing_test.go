@@ -1,12 +1,17 @@
 package backend
 
 import (
+	"encoding/json"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"cosmossdk.io/log"
 	"github.com/EscanBE/everlast/crypto/ethsecp256k1"
 	"github.com/EscanBE/everlast/indexer"
 	"github.com/EscanBE/everlast/rpc/backend/mocks"
+	rpctypes "github.com/EscanBE/everlast/rpc/types"
+	utiltx "github.com/EscanBE/everlast/testutil/tx"
 	evmtypes "github.com/EscanBE/everlast/x/evm/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -291,3 +296,130 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+	args := evmtypes.TransactionArgs{From: &from, To: &to}
+	bzArgs, err := json.Marshal(&args)
+	suite.Require().NoError(err)
+
+	blockNum := rpctypes.BlockNumber(1)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       func() *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			name: "pass - without config",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, 1, func(req *evmtypes.QueryTraceCallRequest) bool {
+					return string(req.Args) == string(bzArgs) &&
+						req.GasCap == suite.backend.RPCGasCap() &&
+						req.BlockNumber == 1 &&
+						req.TraceConfig == nil &&
+						req.StateOverrides == nil &&
+						req.BlockOverrides == nil
+				})
+			},
+			config: func() *rpctypes.TraceCallConfig {
+				return nil
+			},
+			expResult: map[string]interface{}{"test": "hello"},
+			expPass:   true,
+		},
+		{
+			name: "pass - with tracer config, state overrides and block overrides",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, 1, func(req *evmtypes.QueryTraceCallRequest) bool {
+					if req.TraceConfig == nil || req.TraceConfig.Tracer != "callTracer" || req.TraceConfig.Timeout != "10s" {
+						return false
+					}
+					if req.TraceConfig.TracerJsonConfig != `{"onlyTopCall":true}` {
+						return false
+					}
+
+					var stateOverrides evmtypes.StateOverride
+					if err := json.Unmarshal(req.StateOverrides, &stateOverrides); err != nil {
+						return false
+					}
+					if account, found := stateOverrides[to]; !found || account.Nonce == nil || uint64(*account.Nonce) != 2 {
+						return false
+					}
+
+					var blockOverrides evmtypes.BlockOverrides
+					if err := json.Unmarshal(req.BlockOverrides, &blockOverrides); err != nil {
+						return false
+					}
+					return blockOverrides.Number != nil && blockOverrides.Number.ToInt().Int64() == 1000
+				})
+			},
+			config: func() *rpctypes.TraceCallConfig {
+				var config rpctypes.TraceCallConfig
+				suite.Require().NoError(json.Unmarshal([]byte(fmt.Sprintf(`{
+					"tracer": "callTracer",
+					"timeout": "10s",
+					"tracerConfig": {"onlyTopCall":true},
+					"stateOverrides": {"%s": {"nonce": "0x2"}},
+					"blockOverrides": {"number": "0x3e8"}
+				}`, to.Hex())), &config))
+				return &config
+			},
+			expResult: map[string]interface{}{"test": "hello"},
+			expPass:   true,
+		},
+		{
+			name: "fail - block error",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			config: func() *rpctypes.TraceCallConfig {
+				return nil
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - trace call error",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, 1)
+			},
+			config: func() *rpctypes.TraceCallConfig {
+				return nil
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(args, blockNrOrHash, tc.config())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -183,7 +183,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt,
 
 	var receipt *ethtypes.Receipt
 	var effectiveGasPrice *big.Int
-	var baseFee *big.Int
 
 	if icReceipt != nil {
 		icReceipt.Fill(blockHash)
@@ -192,11 +191,8 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt,
 	} else {
 		// tx failed, possible out of block gas
 
-		// in this case, we craft the receipt manually
-		ethTx := ethMsg.AsTransaction()
-
 		// compute cumulative gas used
-		cumulativeGasUsed := ethTx.Gas()
+		var cumulativeGasUsed uint64
 		if res.EthTxIndex > 0 {
 			// get gas used of previous txs
 			for txIdx, prevTx := range resBlock.Block.Txs[:res.TxIndex] {
@@ -226,35 +222,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt,
 			}
 		}
 
-		receipt = &ethtypes.Receipt{
-			Type:              ethTx.Type(),
-			PostState:         nil,
-			Status:            ethtypes.ReceiptStatusFailed,
-			CumulativeGasUsed: cumulativeGasUsed,
-			Bloom:             ethtypes.Bloom{}, // compute bellow
-			Logs:              []*ethtypes.Log{},
-			TxHash:            ethTx.Hash(),
-			ContractAddress:   common.Address{},
-			GasUsed:           ethTx.Gas(),
-			BlockHash:         blockHash,
-			BlockNumber:       big.NewInt(blockRes.Height),
-			TransactionIndex:  uint(res.EthTxIndex),
-		}
-
-		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
-
-		if ethTx.Type() == ethtypes.DynamicFeeTxType {
-			if baseFee == nil {
-				baseFee, err = b.BaseFee(blockRes)
-				if err != nil {
-					return nil, errors.Wrapf(err, "failed to fetch base fee. Pruned block %d?", blockRes.Height)
-				}
-				if baseFee == nil {
-					return nil, fmt.Errorf("base fee nil but dynamic fee tx?, block %d, tx: %s", blockRes.Height, ethTx.Hash())
-				}
-			}
+		receipt, effectiveGasPrice, err = b.craftFailedReceipt(ethMsg.AsTransaction(), blockHash, blockRes, uint(res.EthTxIndex), cumulativeGasUsed)
+		if err != nil {
+			return nil, err
 		}
-		effectiveGasPrice = evmutils.EthTxEffectiveGasPrice(ethTx, sdkmath.NewIntFromBigInt(baseFee))
 	}
 
 	return rpctypes.NewRPCReceiptFromReceipt(
@@ -264,6 +235,223 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (*rpctypes.RPCReceipt,
 	)
 }
 
+// GetBlockReceipts returns the receipts of all the Ethereum transactions in the block identified by number or hash.
+// It returns nil if the block could not be found.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.RPCReceipt, error) {
+	resBlock, err := b.CometBFTBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.CometBFTBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve block results for height %d", resBlock.Block.Height)
+	}
+
+	ethMsgs, receipts, effectiveGasPrices, err := b.EthReceiptsFromCometBFTBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rpctypes.RPCReceipt, len(receipts))
+	for i, receipt := range receipts {
+		result[i], err = rpctypes.NewRPCReceiptFromReceipt(ethMsgs[i], receipt, effectiveGasPrices[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// GetRawReceipts returns the consensus-encoding of the receipts of all the Ethereum transactions
+// in the block identified by number or hash.
+func (b *Backend) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	resBlock, err := b.CometBFTBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, errors.New("block not found")
+	}
+
+	blockRes, err := b.CometBFTBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve block results for height %d", resBlock.Block.Height)
+	}
+
+	_, receipts, _, err := b.EthReceiptsFromCometBFTBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]hexutil.Bytes, len(receipts))
+	for i, receipt := range receipts {
+		result[i], err = receipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// GetRawTransaction returns the bytes of the Ethereum transaction identified by hash,
+// the transaction can be either included in a block or pending in the mempool.
+func (b *Backend) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		// try to find tx in mempool
+		txs, err := b.PendingTransactions()
+		if err != nil {
+			b.logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+			return nil, nil
+		}
+
+		for _, tx := range txs {
+			msg, err := evmtypes.UnwrapEthereumMsg(tx, hash)
+			if err != nil {
+				// not the requested ethereum tx
+				continue
+			}
+
+			return msg.AsTransaction().MarshalBinary()
+		}
+
+		b.logger.Debug("tx not found", "hash", hash.Hex())
+		return nil, nil
+	}
+
+	resBlock, err := b.CometBFTBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil || resBlock == nil {
+		b.logger.Debug("block not found", "height", res.Height)
+		return nil, nil
+	}
+
+	cosmosTx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
+	if err != nil {
+		b.logger.Debug("decoding failed", "error", err.Error())
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	ethMsg, ok := cosmosTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid transaction type %T", cosmosTx.GetMsgs()[0])
+	}
+
+	return ethMsg.AsTransaction().MarshalBinary()
+}
+
+// EthReceiptsFromCometBFTBlock returns the receipts of all the Ethereum transactions in the CometBFT block,
+// along with the messages and the effective gas prices, in the same order.
+// All the receipts are built from the given block results, transactions dropped due to block gas excess are ignored,
+// so are transactions whose receipt can not be parsed from the events.
+func (b *Backend) EthReceiptsFromCometBFTBlock(
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+) (ethMsgs []*evmtypes.MsgEthereumTx, receipts []*ethtypes.Receipt, effectiveGasPrices []*big.Int, err error) {
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash.Bytes())
+
+	var cumulativeGasUsed uint64
+	for i, txBz := range resBlock.Block.Txs {
+		cosmosTx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("decoding failed", "error", err.Error())
+			continue
+		}
+		msgs := cosmosTx.GetMsgs()
+		if len(msgs) != 1 {
+			continue
+		}
+		ethMsg, isEthTx := msgs[0].(*evmtypes.MsgEthereumTx)
+		if !isEthTx {
+			continue
+		}
+		ethTx := ethMsg.AsTransaction()
+
+		txResult := blockRes.TxsResults[i]
+		icReceipt, err := TxReceiptFromEvent(txResult.Events)
+		if err != nil {
+			b.logger.Debug("failed to parse receipt from events", "tx-hash", ethMsg.HashStr(), "error", err.Error())
+			continue
+		}
+
+		var receipt *ethtypes.Receipt
+		var effectiveGasPrice *big.Int
+
+		if icReceipt != nil {
+			icReceipt.Fill(blockHash)
+			receipt = icReceipt.Receipt
+			effectiveGasPrice = icReceipt.EffectiveGasPrice
+			cumulativeGasUsed += receipt.GasUsed
+		} else {
+			cumulativeGasUsed += ethTx.Gas()
+
+			// ignore the dropped tx
+			if evmtypes.TxWasDroppedPreAnteHandleDueToBlockGasExcess(txResult) {
+				continue
+			}
+
+			// tx failed, possible out of block gas
+			receipt, effectiveGasPrice, err = b.craftFailedReceipt(ethTx, blockHash, blockRes, uint(len(receipts)), cumulativeGasUsed-ethTx.Gas())
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+
+		ethMsgs = append(ethMsgs, ethMsg)
+		receipts = append(receipts, receipt)
+		effectiveGasPrices = append(effectiveGasPrices, effectiveGasPrice)
+	}
+
+	return ethMsgs, receipts, effectiveGasPrices, nil
+}
+
+// craftFailedReceipt crafts the receipt for the Ethereum tx which was failed without emitting receipt,
+// possible out of block gas. The cumulative gas used is the gas used by the previous txs in the same block.
+func (b *Backend) craftFailedReceipt(
+	ethTx *ethtypes.Transaction,
+	blockHash common.Hash,
+	blockRes *cmtrpctypes.ResultBlockResults,
+	ethTxIndex uint,
+	cumulativeGasUsed uint64,
+) (*ethtypes.Receipt, *big.Int, error) {
+	receipt := &ethtypes.Receipt{
+		Type:              ethTx.Type(),
+		PostState:         nil,
+		Status:            ethtypes.ReceiptStatusFailed,
+		CumulativeGasUsed: cumulativeGasUsed + ethTx.Gas(),
+		Bloom:             ethtypes.Bloom{}, // compute bellow
+		Logs:              []*ethtypes.Log{},
+		TxHash:            ethTx.Hash(),
+		ContractAddress:   common.Address{},
+		GasUsed:           ethTx.Gas(),
+		BlockHash:         blockHash,
+		BlockNumber:       big.NewInt(blockRes.Height),
+		TransactionIndex:  ethTxIndex,
+	}
+
+	receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+	var baseFee *big.Int
+	if ethTx.Type() == ethtypes.DynamicFeeTxType {
+		var err error
+		baseFee, err = b.BaseFee(blockRes)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to fetch base fee. Pruned block %d?", blockRes.Height)
+		}
+		if baseFee == nil {
+			return nil, nil, fmt.Errorf("base fee nil but dynamic fee tx?, block %d, tx: %s", blockRes.Height, ethTx.Hash())
+		}
+	}
+	effectiveGasPrice := evmutils.EthTxEffectiveGasPrice(ethTx, sdkmath.NewIntFromBigInt(baseFee))
+
+	return receipt, effectiveGasPrice, nil
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
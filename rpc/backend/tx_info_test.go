@@ -19,6 +19,7 @@ import (
 	sdkdb "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
 )
 
 func (suite *BackendTestSuite) TestGetTransactionByHash() {
@@ -151,7 +152,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			name: "pass - Pending transactions returns error",
 			registerMock: func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client)
 			},
 			tx:       msgEthereumTx,
 			expRPCTx: nil,
@@ -161,7 +162,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			name: "pass - Tx not found return nil",
 			registerMock: func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, nil)
+				RegisterUnconfirmedTxs(client, nil)
 			},
 			tx:       msgEthereumTx,
 			expRPCTx: nil,
@@ -171,7 +172,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			name: "pass - Tx found and returned",
 			registerMock: func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, cmttypes.Txs{bz})
+				RegisterUnconfirmedTxs(client, cmttypes.Txs{bz})
 			},
 			tx:       msgEthereumTx,
 			expRPCTx: rpcTransaction,
@@ -647,3 +648,383 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+
+	receipt := ethtypes.Receipt{
+		Type:        ethtypes.LegacyTxType,
+		Status:      ethtypes.ReceiptStatusSuccessful,
+		TxHash:      msgEthereumTx.AsTransaction().Hash(),
+		BlockNumber: common.Big1,
+	}
+
+	blockNumber := rpctypes.BlockNumber(1)
+	blockHash := common.Hash{}
+
+	testCases := []struct {
+		name          string
+		blockNrOrHash rpctypes.BlockNumberOrHash
+		registerMock  func()
+		expReceipts   func() []*rpctypes.RPCReceipt
+		expErr        bool
+	}{
+		{
+			name:          "pass - block not found",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockNotFound(client, 1)
+				suite.Require().NoError(err)
+			},
+			expReceipts: func() []*rpctypes.RPCReceipt {
+				return nil
+			},
+		},
+		{
+			name:          "fail - block lookup error",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			expErr: true,
+		},
+		{
+			name:          "fail - block results not found",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			expErr: true,
+		},
+		{
+			name:          "pass - tx with receipt which can not be parsed is skipped",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&cmtrpctypes.ResultBlockResults{
+						Height: 1,
+						TxsResults: []*abci.ExecTxResult{
+							{
+								Code: 0,
+								Events: []abci.Event{
+									{
+										Type: evmtypes.EventTypeTxReceipt, // missing attributes
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			expReceipts: func() []*rpctypes.RPCReceipt {
+				return []*rpctypes.RPCReceipt{}
+			},
+		},
+		{
+			name:          "pass - by block number",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResultsWithEventReceipt(client, 1, &receipt)
+				suite.Require().NoError(err)
+			},
+			expReceipts: func() []*rpctypes.RPCReceipt {
+				rpcReceipt, err := rpctypes.NewRPCReceiptFromReceipt(msgEthereumTx, &receipt, common.Big0)
+				suite.Require().NoError(err)
+				return []*rpctypes.RPCReceipt{rpcReceipt}
+			},
+		},
+		{
+			name:          "pass - by block hash",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockHash: &blockHash},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, blockHash, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResultsWithEventReceipt(client, 1, &receipt)
+				suite.Require().NoError(err)
+			},
+			expReceipts: func() []*rpctypes.RPCReceipt {
+				rpcReceipt, err := rpctypes.NewRPCReceiptFromReceipt(msgEthereumTx, &receipt, common.Big0)
+				suite.Require().NoError(err)
+				return []*rpctypes.RPCReceipt{rpcReceipt}
+			},
+		},
+		{
+			name:          "pass - failed tx without receipt",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&cmtrpctypes.ResultBlockResults{
+						Height: 1,
+						TxsResults: []*abci.ExecTxResult{
+							{
+								Code: 11,
+								Events: []abci.Event{
+									{
+										Type: evmtypes.EventTypeEthereumTx,
+										Attributes: []abci.EventAttribute{
+											{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msgEthereumTx.HashStr()},
+										},
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			expReceipts: func() []*rpctypes.RPCReceipt {
+				ethTx := msgEthereumTx.AsTransaction()
+				failedReceipt := &ethtypes.Receipt{
+					Type:              ethTx.Type(),
+					Status:            ethtypes.ReceiptStatusFailed,
+					CumulativeGasUsed: ethTx.Gas(),
+					Logs:              []*ethtypes.Log{},
+					TxHash:            ethTx.Hash(),
+					GasUsed:           ethTx.Gas(),
+					BlockNumber:       common.Big1,
+				}
+				failedReceipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{failedReceipt})
+				rpcReceipt, err := rpctypes.NewRPCReceiptFromReceipt(msgEthereumTx, failedReceipt, ethTx.GasPrice())
+				suite.Require().NoError(err)
+				return []*rpctypes.RPCReceipt{rpcReceipt}
+			},
+		},
+		{
+			name:          "pass - dropped tx is ignored",
+			blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber},
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&cmtrpctypes.ResultBlockResults{
+						Height: 1,
+						TxsResults: []*abci.ExecTxResult{
+							{
+								Code: 11,
+							},
+						},
+					}, nil)
+			},
+			expReceipts: func() []*rpctypes.RPCReceipt {
+				return []*rpctypes.RPCReceipt{}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetBlockReceipts(tc.blockNrOrHash)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Nil(receipts)
+				return
+			}
+			suite.Require().NoError(err)
+			expReceipts := tc.expReceipts()
+			if expReceipts == nil {
+				suite.Nil(receipts)
+				return
+			}
+			suite.Require().Len(receipts, len(expReceipts))
+			for i := range expReceipts {
+				equals, diff := expReceipts[i].Compare(receipts[i])
+				suite.Require().Truef(equals, "diff: %s", diff)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+
+	receipt := ethtypes.Receipt{
+		Type:              ethtypes.LegacyTxType,
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		TxHash:            msgEthereumTx.AsTransaction().Hash(),
+		GasUsed:           21000,
+		BlockNumber:       common.Big1,
+	}
+
+	blockNumber := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  func() []hexutil.Bytes
+		expPass      bool
+	}{
+		{
+			name: "fail - block not found",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - block results not found",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			expPass: false,
+		},
+		{
+			name: "pass - block without tx",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			expReceipts: func() []hexutil.Bytes {
+				return []hexutil.Bytes{}
+			},
+			expPass: true,
+		},
+		{
+			name: "pass - block with tx",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResultsWithEventReceipt(client, 1, &receipt)
+				suite.Require().NoError(err)
+			},
+			expReceipts: func() []hexutil.Bytes {
+				bz, err := receipt.MarshalBinary()
+				suite.Require().NoError(err)
+				return []hexutil.Bytes{bz}
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetRawReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Equal(tc.expReceipts(), receipts)
+			} else {
+				suite.Require().Error(err)
+				suite.Nil(receipts)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawTransaction() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1, ChainID: "test"}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{
+					Type: evmtypes.EventTypeEthereumTx,
+					Attributes: []abci.EventAttribute{
+						{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+						{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+					},
+				},
+				{
+					Type: evmtypes.EventTypeTxReceipt,
+					Attributes: []abci.EventAttribute{
+						{Key: evmtypes.AttributeKeyReceiptEvmTxHash, Value: txHash.Hex()},
+						{Key: evmtypes.AttributeKeyReceiptTxIndex, Value: "0"},
+						{Key: evmtypes.AttributeKeyReceiptCometBFTTxHash, Value: ""},
+					},
+				},
+			},
+		},
+	}
+
+	expRawTx, err := msgEthereumTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		indexBlock   bool
+		expRawTx     hexutil.Bytes
+	}{
+		{
+			name: "pass - included in block",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+			},
+			indexBlock: true,
+			expRawTx:   expRawTx,
+		},
+		{
+			name: "pass - pending in mempool",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, []cmttypes.Tx{txBz})
+			},
+			indexBlock: false,
+			expRawTx:   expRawTx,
+		},
+		{
+			name: "pass - not found",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil)
+			},
+			indexBlock: false,
+			expRawTx:   nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := sdkdb.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), suite.backend.clientCtx)
+			if tc.indexBlock {
+				err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+				suite.Require().NoError(err)
+			}
+			suite.backend.indexer.Ready()
+
+			rawTx, err := suite.backend.GetRawTransaction(txHash)
+			suite.Require().NoError(err)
+			suite.Equal(tc.expRawTx, rawTx)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"sort"
+
+	rpctypes "github.com/EscanBE/everlast/rpc/types"
+	evmtypes "github.com/EscanBE/everlast/x/evm/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TxPoolContent returns the Ethereum transactions in the mempool, grouped by sender and nonce.
+// Based on the account nonce from x/evm, transactions which can be executed in sequence are pending,
+// transactions after a nonce gap are queued and transactions with a stale nonce are omitted.
+// Senders whose account can not be queried are omitted.
+func (b *Backend) TxPoolContent() (pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction, err error) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chainID := b.ChainConfig().ChainID
+
+	txsBySender := make(map[common.Address][]*rpctypes.RPCTransaction)
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				continue
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, 0, 0, nil, chainID)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			txsBySender[rpcTx.From] = append(txsBySender[rpcTx.From], rpcTx)
+		}
+	}
+
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+
+	for sender, txs := range txsBySender {
+		res, err := b.queryClient.Account(b.ctx, &evmtypes.QueryAccountRequest{
+			Address: sender.Hex(),
+		})
+		if err != nil {
+			b.logger.Debug("failed to query account of tx pool sender", "sender", sender.Hex(), "error", err.Error())
+			continue
+		}
+
+		sort.SliceStable(txs, func(i, j int) bool {
+			return txs[i].Nonce < txs[j].Nonce
+		})
+
+		nextNonce := res.Nonce
+		for _, tx := range txs {
+			nonce := uint64(tx.Nonce)
+
+			var group map[common.Address]map[uint64]*rpctypes.RPCTransaction
+			switch {
+			case nonce < nextNonce:
+				// already executed or replaced
+				continue
+			case nonce == nextNonce:
+				group = pending
+				nextNonce++
+			default:
+				group = queued
+			}
+
+			if group[sender] == nil {
+				group[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			if _, found := group[sender][nonce]; !found {
+				group[sender][nonce] = tx
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
@@ -0,0 +1,187 @@
+package backend
+
+import (
+	"math/big"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/EscanBE/everlast/constants"
+	"github.com/EscanBE/everlast/rpc/backend/mocks"
+	rpctypes "github.com/EscanBE/everlast/rpc/types"
+	utiltx "github.com/EscanBE/everlast/testutil/tx"
+	evmtypes "github.com/EscanBE/everlast/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	sender1, priv1 := utiltx.NewAddrKey()
+	sender2, priv2 := utiltx.NewAddrKey()
+
+	buildTx := func(from common.Address, signer keyring.Signer, nonce uint64) cmttypes.Tx {
+		msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.backend.chainID,
+			Nonce:    nonce,
+			To:       &common.Address{},
+			Amount:   big.NewInt(1),
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+		})
+		msgEthereumTx.From = sdk.AccAddress(from.Bytes()).String()
+		suite.Require().NoError(msgEthereumTx.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), signer))
+
+		tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), constants.BaseDenom)
+		suite.Require().NoError(err)
+
+		txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		return txBz
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   map[common.Address][]uint64
+		expQueued    map[common.Address][]uint64
+		expPass      bool
+	}{
+		{
+			name: "pass - empty mempool",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterUnconfirmedTxs(client, nil)
+			},
+			expPending: map[common.Address][]uint64{},
+			expQueued:  map[common.Address][]uint64{},
+			expPass:    true,
+		},
+		{
+			name: "pass - group by sender and nonce",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+
+				signer1 := utiltx.NewSigner(priv1)
+				signer2 := utiltx.NewSigner(priv2)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterUnconfirmedTxs(client, []cmttypes.Tx{
+					buildTx(sender1, signer1, 4),
+					buildTx(sender1, signer1, 2),
+					buildTx(sender1, signer1, 0),
+					buildTx(sender1, signer1, 1),
+					buildTx(sender2, signer2, 7),
+				})
+				RegisterAccountWithNonce(queryClient, sender1, 1, 1)
+				RegisterAccountWithNonce(queryClient, sender2, 1, 7)
+			},
+			expPending: map[common.Address][]uint64{
+				sender1: {1, 2},
+				sender2: {7},
+			},
+			expQueued: map[common.Address][]uint64{
+				sender1: {4},
+			},
+			expPass: true,
+		},
+		{
+			name: "pass - more transactions than the default page of the pool",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+
+				RegisterParamsWithoutHeader(queryClient, 1)
+				signer1 := utiltx.NewSigner(priv1)
+				txs := make([]cmttypes.Tx, 40)
+				for i := range txs {
+					txs[i] = buildTx(sender1, signer1, uint64(i))
+				}
+				RegisterUnconfirmedTxs(client, txs)
+				RegisterAccountWithNonce(queryClient, sender1, 1, 0)
+			},
+			expPending: map[common.Address][]uint64{
+				sender1: func() []uint64 {
+					nonces := make([]uint64, 40)
+					for i := range nonces {
+						nonces[i] = uint64(i)
+					}
+					return nonces
+				}(),
+			},
+			expQueued: map[common.Address][]uint64{},
+			expPass:   true,
+		},
+		{
+			name: "fail - unconfirmed txs error",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client)
+			},
+			expPass: false,
+		},
+		{
+			name: "pass - sender with account query error is omitted",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterUnconfirmedTxs(client, []cmttypes.Tx{
+					buildTx(sender1, utiltx.NewSigner(priv1), 0),
+					buildTx(sender2, utiltx.NewSigner(priv2), 3),
+				})
+				RegisterAccountError(queryClient, sender1, 1)
+				RegisterAccountWithNonce(queryClient, sender2, 1, 3)
+			},
+			expPending: map[common.Address][]uint64{
+				sender2: {3},
+			},
+			expQueued: map[common.Address][]uint64{},
+			expPass:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			nonces := func(group map[common.Address]map[uint64]*rpctypes.RPCTransaction) map[common.Address][]uint64 {
+				result := make(map[common.Address][]uint64)
+				for sender, txs := range group {
+					for nonce, tx := range txs {
+						suite.Equal(sender, tx.From)
+						suite.Equal(nonce, uint64(tx.Nonce))
+						result[sender] = append(result[sender], nonce)
+					}
+				}
+				return result
+			}
+
+			gotPending := nonces(pending)
+			suite.Require().Len(gotPending, len(tc.expPending))
+			for sender, expNonces := range tc.expPending {
+				suite.ElementsMatch(expNonces, gotPending[sender])
+			}
+
+			gotQueued := nonces(queued)
+			suite.Require().Len(gotQueued, len(tc.expQueued))
+			for sender, expNonces := range tc.expQueued {
+				suite.ElementsMatch(expNonces, gotQueued[sender])
+			}
+		})
+	}
+}
@@ -102,6 +102,17 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs created during the execution of EVM
+// if the given transaction was added on top of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+	return a.backend.TraceCall(args, blockNrOrHash, config)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -306,6 +317,24 @@ func (a *API) GetBlockRlp(number uint64) (hexutil.Bytes, error) {
 	return rlp.EncodeToBytes(block)
 }
 
+// GetRawBlock retrieves the RLP encoded form of a single block, identified by number, hash or tag.
+func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawBlock", "block number or hash", blockNrOrHash)
+	return a.backend.GetRawBlock(blockNrOrHash)
+}
+
+// GetRawReceipts retrieves the binary-encoded receipts of a single block, identified by number, hash or tag.
+func (a *API) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+	return a.backend.GetRawReceipts(blockNrOrHash)
+}
+
+// GetRawTransaction returns the bytes of the transaction for the given hash.
+func (a *API) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawTransaction", "hash", hash)
+	return a.backend.GetRawTransaction(hash)
+}
+
 // PrintBlock retrieves a block and returns its pretty printed form.
 func (a *API) PrintBlock(number uint64) (string, error) {
 	block, err := a.backend.EthBlockByNumber(rpctypes.BlockNumber(number))
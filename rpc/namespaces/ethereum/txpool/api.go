@@ -1,51 +1,134 @@
 package txpool
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/EscanBE/everlast/rpc/backend"
 	"github.com/EscanBE/everlast/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// Transactions are read from the CometBFT mempool, they are pending if they can be executed in sequence
+// based on the account nonce, otherwise they are queued.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = formatTxsByNonce(txs, noFormat)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender.Hex()] = formatTxsByNonce(txs, noFormat)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool, sent by the given address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatTxsByNonce(pending[address], noFormat),
+		"queued":  formatTxsByNonce(queued[address], noFormat),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = formatTxsByNonce(txs, summarizeTx)
+	}
+	for sender, txs := range queued {
+		content["queued"][sender.Hex()] = formatTxsByNonce(txs, summarizeTx)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCount, queuedCount int
+	for _, txs := range pending {
+		pendingCount += len(txs)
+	}
+	for _, txs := range queued {
+		queuedCount += len(txs)
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pendingCount),
+		"queued":  hexutil.Uint(queuedCount),
+	}, nil
+}
+
+// formatTxsByNonce converts the transactions, keyed by nonce, into the output format, keyed by the decimal nonce.
+func formatTxsByNonce[T any](txs map[uint64]*types.RPCTransaction, format func(*types.RPCTransaction) T) map[string]T {
+	result := make(map[string]T, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = format(tx)
+	}
+	return result
+}
+
+// noFormat returns the transaction as-is.
+func noFormat(tx *types.RPCTransaction) *types.RPCTransaction {
+	return tx
+}
+
+// summarizeTx returns the summary of the transaction, in the same format as go-ethereum's txpool_inspect.
+func summarizeTx(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
 	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
 }
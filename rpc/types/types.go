@@ -1,11 +1,14 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/EscanBE/everlast/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -82,20 +85,30 @@ type RPCReceipt struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of block context fields to override during the execution of a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config for debug_traceCall, it extends the TraceConfig with state and block overrides.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	// TracerConfig shadows the TracerJsonConfig of TraceConfig, to accept the tracer config as a JSON object.
+	TracerConfig   json.RawMessage `json:"tracerConfig,omitempty"`
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+}
+
+// AccessListResult returns an optional access list,
+// it's the result of the `eth_createAccessList` RPC call.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
 }
 
 type FeeHistoryResult struct {
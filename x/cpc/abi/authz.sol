@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+interface IAuthzCPC {
+    /**
+     * @dev Emitted when the granter granted an authorization to the grantee.
+     */
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /**
+     * @dev Emitted when the granter revoked an authorization from the grantee.
+     */
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /**
+     * @dev Emitted when the grantee executed a message on behalf of the granter.
+     */
+    event Exec(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns whether the granter has granted an authorization of the message type to the grantee,
+     * and the expiration of the grant as unix timestamp (in seconds), zero means no expiration.
+     */
+    function grantOf(address granter, address grantee, string memory msgTypeUrl) external view returns (bool, uint64);
+
+    /**
+     * @dev Returns the remaining amount of `denom` that the grantee is allowed to send on behalf of the granter.
+     * Returns zero if there is no send authorization.
+     */
+    function sendSpendLimit(address granter, address grantee, string memory denom) external view returns (uint256);
+
+    /**
+     * @dev Grant the grantee a generic authorization to execute messages of type `msgTypeUrl` on behalf of the caller.
+     * `expiration` is the unix timestamp (in seconds) when the grant expires, zero means no expiration.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Grant} event.
+     */
+    function grantGeneric(address grantee, string memory msgTypeUrl, uint64 expiration) external returns (bool);
+
+    /**
+     * @dev Grant the grantee a send authorization to send up to `spendLimit` amount of `denom` on behalf of the caller.
+     * `expiration` is the unix timestamp (in seconds) when the grant expires, zero means no expiration.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Grant} event.
+     */
+    function grantSend(address grantee, string memory denom, uint256 spendLimit, uint64 expiration) external returns (bool);
+
+    /**
+     * @dev Revoke the authorization of message type `msgTypeUrl` granted by the caller to the grantee.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Revoke} event.
+     */
+    function revoke(address grantee, string memory msgTypeUrl) external returns (bool);
+
+    /**
+     * @dev Send `value` amount of `denom` from the granter account to the `to` account,
+     * using the authorization granted by the granter to the caller.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits an {Exec} event.
+     */
+    function execSend(address granter, address to, string memory denom, uint256 value) external returns (bool);
+
+    /**
+     * @dev Delegate `value` amount of staking coin from the granter account to the validator,
+     * using the authorization granted by the granter to the caller.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits an {Exec} event.
+     */
+    function execDelegate(address granter, address validator, uint256 value) external returns (bool);
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct Coin {
+    string denom;
+    uint256 amount;
+}
+
+struct DenomMetadata {
+    string base;
+    string display;
+    string name;
+    string symbol;
+    string description;
+    uint8 decimals;
+}
+
+interface IBankCPC {
+    /**
+     * @dev Emitted when `value` amount of `denom` are moved from one account (`from`) to another (`to`).
+     */
+    event Transfer(address indexed from, address indexed to, string denom, uint256 value);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the balance of the `denom` owned by `account`.
+     */
+    function balanceOf(address account, string memory denom) external view returns (uint256);
+
+    /**
+     * @dev Returns all the balances owned by `account`.
+     */
+    function allBalances(address account) external view returns (Coin[] memory);
+
+    /**
+     * @dev Returns the metadata of the `denom`.
+     * `decimals` is the exponent of the display denom unit, zero if not available.
+     * Reverts if the metadata of the denom is not registered.
+     */
+    function denomMetadata(string memory denom) external view returns (DenomMetadata memory);
+
+    /**
+     * @dev Returns the total supply of the `denom`.
+     */
+    function supplyOf(string memory denom) external view returns (uint256);
+
+    /**
+     * @dev Moves `amounts[i]` of `denoms[i]` from the caller's account to `recipients[i]`.
+     * The three arrays must have the same length.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Transfer} event for each transfer.
+     */
+    function multiSend(address[] memory recipients, string[] memory denoms, uint256[] memory amounts) external returns (bool);
+}
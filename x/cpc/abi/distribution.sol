@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+interface IDistributionCPC {
+    /**
+     * @dev Emitted when the delegator changed the address to receive staking rewards.
+     */
+    event SetWithdrawAddress(address indexed delegator, address indexed withdrawAddress);
+
+    /**
+     * @dev Emitted when the validator operator withdrew the validator commission.
+     * `value` is the withdrawn amount.
+     */
+    event WithdrawCommission(address indexed validator, uint256 value);
+
+    /**
+     * @dev Emitted when the depositor funded the community pool.
+     * `value` is the funded amount.
+     */
+    event FundCommunityPool(address indexed depositor, uint256 value);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the address that receives the staking rewards of the delegator.
+     */
+    function withdrawAddressOf(address delegator) external view returns (address);
+
+    /**
+     * @dev Returns the outstanding (un-withdrawn) rewards of the validator, including the commission.
+     */
+    function validatorOutstandingRewards(address validator) external view returns (uint256);
+
+    /**
+     * @dev Returns the accumulated commission of the validator.
+     */
+    function validatorCommission(address validator) external view returns (uint256);
+
+    /**
+     * @dev Returns the amount of staking coin in the community pool.
+     */
+    function communityPool() external view returns (uint256);
+
+    /**
+     * @dev Change the address to receive the staking rewards of the caller.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {SetWithdrawAddress} event.
+     */
+    function setWithdrawAddress(address withdrawAddress) external returns (bool);
+
+    /**
+     * @dev Withdraw the commission of the validator operated by the caller.
+     * Commission will be sent to the withdraw address of the operator.
+     *
+     * Returns the withdrawn amount.
+     *
+     * Emits a {WithdrawCommission} event.
+     */
+    function withdrawValidatorCommission() external returns (uint256);
+
+    /**
+     * @dev Fund the community pool with a `value` amount of staking coin from the caller's account.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {FundCommunityPool} event.
+     */
+    function fundCommunityPool(uint256 value) external returns (bool);
+}
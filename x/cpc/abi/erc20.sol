@@ -4,7 +4,7 @@ pragma solidity >=0.7.0 <0.9.0;
 
 /**
  * @dev Interface of the ERC-20 Custom-Precompiled-Contracts, follows standard as defined in the ERC,
- * plus Burnable.
+ * plus Burnable, Permit (EIP-2612) and Managed.
  */
 interface IERC20CPC {
     // Standard
@@ -115,4 +115,104 @@ interface IERC20CPC {
      * `value`.
      */
     function burnFrom(address account, uint256 value) external;
+
+    // Permit (EIP-2612)
+
+    /**
+     * @dev Sets `value` as the allowance of `spender` over ``owner``'s tokens,
+     * given ``owner``'s signed approval.
+     *
+     * The signature is an EIP-712 signature of the `Permit` typed message:
+     * `Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)`
+     * with the domain as returned by {DOMAIN_SEPARATOR}.
+     *
+     * Requirements:
+     *
+     * - `spender` cannot be the zero address.
+     * - `deadline` must be a timestamp in the future.
+     * - `v`, `r` and `s` must be a valid `secp256k1` signature from `owner`
+     * over the EIP712-formatted function arguments.
+     * - the signature must use ``owner``'s current nonce (see {nonces}).
+     *
+     * Emits an {Approval} event.
+     */
+    function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) external;
+
+    /**
+     * @dev Returns the current nonce for `owner`. This value must be
+     * included whenever a signature is generated for {permit}.
+     *
+     * Every successful call to {permit} increases ``owner``'s nonce by one. This
+     * prevents a signature from being used multiple times.
+     */
+    function nonces(address owner) external view returns (uint256);
+
+    /**
+     * @dev Returns the domain separator used in the encoding of the signature for {permit}, as defined by {EIP712}.
+     *
+     * The domain name is the current name of the token, so renaming the token changes the domain separator
+     * and invalidates the permit signatures made before.
+     */
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() external view returns (bytes32);
+
+    // Managed
+
+    /**
+     * @dev Emitted when the ownership of a managed token is transferred from `previousOwner` to `newOwner`.
+     */
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+
+    /**
+     * @dev Returns the address of the current owner.
+     *
+     * Only managed tokens have an owner, zero address is returned for non-managed tokens.
+     */
+    function owner() external view returns (address);
+
+    /**
+     * @dev Creates a `value` amount of tokens and assigns them to `to`, by minting the corresponding bank coins.
+     *
+     * Requirements:
+     *
+     * - the caller must be the owner.
+     * - `to` cannot be the zero address.
+     *
+     * Emits a {Transfer} event with `from` set to the zero address.
+     */
+    function mint(address to, uint256 value) external;
+
+    /**
+     * @dev Transfers ownership of the token to a new account (`newOwner`).
+     *
+     * Requirements:
+     *
+     * - the caller must be the owner.
+     * - `newOwner` cannot be the zero address, use {renounceOwnership} instead.
+     *
+     * Emits an {OwnershipTransferred} event.
+     */
+    function transferOwnership(address newOwner) external;
+
+    /**
+     * @dev Leaves the token without owner. It will not be possible to call `onlyOwner` functions anymore.
+     *
+     * Requirements:
+     *
+     * - the caller must be the owner.
+     *
+     * Emits an {OwnershipTransferred} event with `newOwner` set to the zero address.
+     */
+    function renounceOwnership() external;
+
+    /**
+     * @dev Updates the name and symbol of the token, also updates the corresponding bank denom metadata.
+     *
+     * Changing the name also changes the {DOMAIN_SEPARATOR}, the permit signatures made before become invalid.
+     *
+     * Requirements:
+     *
+     * - the caller must be the owner.
+     */
+    function setMetadata(string memory newName, string memory newSymbol) external;
 }
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct WeightedVoteOption {
+    uint8 option;
+    string weight;
+}
+
+struct ProposalInfo {
+    uint64 id;
+    uint8 status;
+    address proposer;
+    string title;
+    string summary;
+    string metadata;
+    bool expedited;
+    uint256 totalDeposit;
+    uint64 submitTime;
+    uint64 depositEndTime;
+    uint64 votingStartTime;
+    uint64 votingEndTime;
+}
+
+struct TallyResult {
+    uint256 yes;
+    uint256 abstain;
+    uint256 no;
+    uint256 noWithVeto;
+}
+
+interface IGovCPC {
+    /**
+     * @dev Emitted when a new proposal was submitted.
+     */
+    event SubmitProposal(address indexed proposer, uint64 indexed proposalId);
+
+    /**
+     * @dev Emitted when the depositor deposited into a proposal.
+     * `value` is deposit amount.
+     */
+    event Deposit(address indexed depositor, uint64 indexed proposalId, uint256 value);
+
+    /**
+     * @dev Emitted when the voter voted on a proposal, once per vote option.
+     * `weight` is the weight of the option, with 18 decimals places.
+     */
+    event Vote(address indexed voter, uint64 indexed proposalId, uint8 option, uint256 weight);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the information of a proposal.
+     * `status` is the proposal status as defined by `x/gov`:
+     * 0 = unspecified, 1 = deposit period, 2 = voting period, 3 = passed, 4 = rejected, 5 = failed.
+     * Timestamps are unix seconds, zero if not yet happened.
+     */
+    function proposal(uint64 proposalId) external view returns (ProposalInfo memory);
+
+    /**
+     * @dev Returns the tally result of a proposal.
+     * For proposals in voting period, it is the current tally.
+     */
+    function tallyResult(uint64 proposalId) external view returns (TallyResult memory);
+
+    /**
+     * @dev Returns the vote options of the voter on a proposal, empty if not voted.
+     * `option`: 1 = yes, 2 = abstain, 3 = no, 4 = no with veto.
+     */
+    function voteOf(uint64 proposalId, address voter) external view returns (WeightedVoteOption[] memory);
+
+    /**
+     * @dev Returns the amount deposited by the depositor into a proposal.
+     */
+    function depositOf(uint64 proposalId, address depositor) external view returns (uint256);
+
+    /**
+     * @dev Submit a new proposal, with the caller's account as proposer.
+     * `messages` is the JSON array of the proposal messages, same format as the `messages` of `evld tx gov submit-proposal`.
+     * `initialDeposit` is the amount of staking coin to be deposited from the caller's account.
+     *
+     * Returns the ID of the new proposal.
+     *
+     * Emits {SubmitProposal} + {?Deposit} events.
+     */
+    function submitProposal(string memory messages, string memory metadata, string memory title, string memory summary, uint256 initialDeposit, bool expedited) external returns (uint64);
+
+    /**
+     * @dev Deposit a `value` amount of staking coin from the caller's account into a proposal.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Deposit} event.
+     */
+    function deposit(uint64 proposalId, uint256 value) external returns (bool);
+
+    /**
+     * @dev Vote on a proposal, with the caller's account as voter.
+     * `option`: 1 = yes, 2 = abstain, 3 = no, 4 = no with veto.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Vote} event.
+     */
+    function vote(uint64 proposalId, uint8 option, string memory metadata) external returns (bool);
+
+    /**
+     * @dev Weighted-vote on a proposal, with the caller's account as voter.
+     * `weight` of each option is a decimal string, eg: "0.7", sum of all weights must be 1.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits multiple {Vote} events, one per option.
+     */
+    function voteWeighted(uint64 proposalId, WeightedVoteOption[] memory options, string memory metadata) external returns (bool);
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct Height {
+    uint64 revisionNumber;
+    uint64 revisionHeight;
+}
+
+struct DenomTrace {
+    string path;
+    string baseDenom;
+}
+
+interface IIbcTransferCPC {
+    /**
+     * @dev Emitted when the sender transferred tokens to another chain via ICS-20.
+     * `sequence` is the sequence of the sent packet.
+     */
+    event IBCTransfer(address indexed sender, string receiver, string sourceChannel, string denom, uint256 amount, uint64 sequence, string memo);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the denom trace of an IBC denom.
+     * `denomHash` can be either the hash of the denom trace or the full IBC denom, eg: `ibc/{hash}`.
+     */
+    function denomTrace(string memory denomHash) external view returns (DenomTrace memory);
+
+    /**
+     * @dev Returns the hash of a denom trace, eg: `transfer/channel-0/uatom`.
+     */
+    function denomHash(string memory trace) external view returns (string memory);
+
+    /**
+     * @dev Transfer a `amount` of `denom` from the caller's account to the `receiver` on the counterparty chain,
+     * via the `transfer` port and the `sourceChannel`.
+     * At least one of `timeoutHeight` and `timeoutTimestamp` (unix nanoseconds) must be non-zero.
+     *
+     * Returns the sequence of the sent packet.
+     *
+     * Emits an {IBCTransfer} event.
+     */
+    function transfer(string memory sourceChannel, string memory denom, uint256 amount, string memory receiver, Height memory timeoutHeight, uint64 timeoutTimestamp, string memory memo) external returns (uint64);
+}
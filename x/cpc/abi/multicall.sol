@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct Call {
+    address target;
+    bytes callData;
+    bool allowFailure;
+}
+
+struct Result {
+    bool success;
+    bytes returnData;
+}
+
+interface IMulticallCPC {
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Executes the `calls` in order, under the identity of the caller,
+     * so the `msg.sender` of each call is the caller of this method.
+     * Only the other custom precompiled contracts can be the target of the calls.
+     *
+     * A failed call with `allowFailure` is rolled back alone and the execution continues,
+     * otherwise the whole batch is reverted.
+     *
+     * Returns the result of each call, in the same order.
+     */
+    function multicall(Call[] memory calls) external returns (Result[] memory);
+}
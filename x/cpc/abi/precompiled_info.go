@@ -75,6 +75,46 @@ var (
 	bech32Json []byte
 
 	Bech32CpcInfo CustomPrecompiledContractInfo
+
+	//go:embed gov.abi.json
+	govJson []byte
+
+	GovCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed distribution.abi.json
+	distributionJson []byte
+
+	DistributionCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed ibc_transfer.abi.json
+	ibcTransferJson []byte
+
+	IbcTransferCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed multicall.abi.json
+	multicallJson []byte
+
+	MulticallCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed slashing.abi.json
+	slashingJson []byte
+
+	SlashingCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed authz.abi.json
+	authzJson []byte
+
+	AuthzCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed vesting.abi.json
+	vestingJson []byte
+
+	VestingCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed bank.abi.json
+	bankJson []byte
+
+	BankCpcInfo CustomPrecompiledContractInfo
 )
 
 func init() {
@@ -97,6 +137,84 @@ func init() {
 		panic(err)
 	}
 	Bech32CpcInfo.Name = "Bech32"
+
+	err = json.Unmarshal(govJson, &GovCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	GovCpcInfo.Name = "Gov"
+
+	err = json.Unmarshal(distributionJson, &DistributionCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	DistributionCpcInfo.Name = "Distribution"
+
+	err = json.Unmarshal(ibcTransferJson, &IbcTransferCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	IbcTransferCpcInfo.Name = "IbcTransfer"
+
+	err = json.Unmarshal(multicallJson, &MulticallCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	MulticallCpcInfo.Name = "Multicall"
+
+	err = json.Unmarshal(slashingJson, &SlashingCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	SlashingCpcInfo.Name = "Slashing"
+
+	err = json.Unmarshal(authzJson, &AuthzCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	AuthzCpcInfo.Name = "Authz"
+
+	err = json.Unmarshal(vestingJson, &VestingCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	VestingCpcInfo.Name = "Vesting"
+
+	err = json.Unmarshal(bankJson, &BankCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	BankCpcInfo.Name = "Bank"
+}
+
+// GetCustomPrecompiledContractInfoByType returns the contract info and the JSON ABI of the given custom precompiled type.
+func GetCustomPrecompiledContractInfoByType(cpcType uint32) (info CustomPrecompiledContractInfo, abiJson []byte, found bool) {
+	switch cpcType {
+	case cpctypes.CpcTypeErc20:
+		return Erc20CpcInfo, erc20JSON, true
+	case cpctypes.CpcTypeStaking:
+		return StakingCpcInfo, stakingJson, true
+	case cpctypes.CpcTypeBech32:
+		return Bech32CpcInfo, bech32Json, true
+	case cpctypes.CpcTypeGov:
+		return GovCpcInfo, govJson, true
+	case cpctypes.CpcTypeDistribution:
+		return DistributionCpcInfo, distributionJson, true
+	case cpctypes.CpcTypeIbcTransfer:
+		return IbcTransferCpcInfo, ibcTransferJson, true
+	case cpctypes.CpcTypeMulticall:
+		return MulticallCpcInfo, multicallJson, true
+	case cpctypes.CpcTypeSlashing:
+		return SlashingCpcInfo, slashingJson, true
+	case cpctypes.CpcTypeAuthz:
+		return AuthzCpcInfo, authzJson, true
+	case cpctypes.CpcTypeVesting:
+		return VestingCpcInfo, vestingJson, true
+	case cpctypes.CpcTypeBank:
+		return BankCpcInfo, bankJson, true
+	default:
+		return CustomPrecompiledContractInfo{}, nil, false
+	}
 }
 
 // EIP-712 typed messages
@@ -197,6 +315,73 @@ func (m StakingMessage) ToTypedData(chainId *big.Int) apitypes.TypedData {
 	}
 }
 
+var _ eip712.TypedMessage = (*CancelUnbondingMessage)(nil)
+
+type CancelUnbondingMessage struct {
+	Delegator      common.Address `json:"delegator"`
+	Validator      string         `json:"validator"`
+	Amount         *big.Int       `json:"amount"`
+	Denom          string         `json:"denom"`
+	CreationHeight int64          `json:"creationHeight"`
+}
+
+func (m *CancelUnbondingMessage) FromUnpackedStruct(v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, m)
+}
+
+func (m CancelUnbondingMessage) Validate(valAddrCodec addresscodec.Codec, bondDenom string) error {
+	if m.Delegator == (common.Address{}) {
+		return fmt.Errorf("delegator cannot be empty")
+	}
+
+	if _, err := valAddrCodec.StringToBytes(m.Validator); err != nil {
+		return errorsmod.Wrapf(err, "invalid validator: %s", m.Validator)
+	}
+
+	if m.Amount == nil || m.Amount.Sign() != 1 {
+		return fmt.Errorf("amount must be positive")
+	}
+
+	if m.Denom != bondDenom {
+		return fmt.Errorf("denom must be: %s", bondDenom)
+	}
+
+	if m.CreationHeight < 1 {
+		return fmt.Errorf("creation height must be positive")
+	}
+
+	return nil
+}
+
+func (m CancelUnbondingMessage) ToTypedData(chainId *big.Int) apitypes.TypedData {
+	const primaryTypeName = "CancelUnbondingMessage"
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			eip712.PrimaryTypeNameEIP712Domain: eip712.GetDomainTypes(),
+			primaryTypeName: []apitypes.Type{
+				{"delegator", "address"},
+				{"validator", "string"},
+				{"amount", "uint256"},
+				{"denom", "string"},
+				{"creationHeight", "int64"},
+			},
+		},
+		PrimaryType: primaryTypeName,
+		Domain:      eip712.GetDomain(cpctypes.CpcStakingFixedAddress, chainId),
+		Message: apitypes.TypedDataMessage{
+			"delegator":      m.Delegator.String(),
+			"validator":      m.Validator,
+			"amount":         (*cmath.HexOrDecimal256)(m.Amount),
+			"denom":          m.Denom,
+			"creationHeight": (*cmath.HexOrDecimal256)(big.NewInt(m.CreationHeight)),
+		},
+	}
+}
+
 var _ eip712.TypedMessage = (*WithdrawRewardMessage)(nil)
 
 const WithdrawRewardMessageActionWithdrawFromAllValidators = "all"
@@ -250,3 +435,250 @@ func (m WithdrawRewardMessage) ToTypedData(chainId *big.Int) apitypes.TypedData
 		},
 	}
 }
+
+// StakingUnbondingDelegationEntry is the Go representation of the `UnbondingDelegationEntry` struct of the Staking contract.
+type StakingUnbondingDelegationEntry struct {
+	Validator      common.Address
+	CreationHeight int64
+	CompletionTime uint64
+	InitialBalance *big.Int
+	Balance        *big.Int
+}
+
+// StakingRedelegationEntry is the Go representation of the `RedelegationEntry` struct of the Staking contract.
+type StakingRedelegationEntry struct {
+	SrcValidator   common.Address
+	DstValidator   common.Address
+	CreationHeight int64
+	CompletionTime uint64
+	InitialBalance *big.Int
+	SharesDst      *big.Int
+}
+
+// StakingValidatorInfo is the Go representation of the `ValidatorInfo` struct of the Staking contract.
+type StakingValidatorInfo struct {
+	Validator               common.Address
+	Moniker                 string
+	Status                  uint8
+	Jailed                  bool
+	Tokens                  *big.Int
+	DelegatorShares         *big.Int
+	CommissionRate          *big.Int
+	CommissionMaxRate       *big.Int
+	CommissionMaxChangeRate *big.Int
+	MinSelfDelegation       *big.Int
+}
+
+var _ eip712.TypedMessage = (*Erc20PermitMessage)(nil)
+
+// Erc20PermitMessage is the EIP-2612 `Permit` typed message of the ERC20 contract.
+type Erc20PermitMessage struct {
+	// Name is the name of the ERC20 token, used as the name of the domain.
+	Name string `json:"-"`
+	// Contract is the address of the ERC20 contract, used as the verifying contract of the domain.
+	Contract common.Address `json:"-"`
+
+	Owner    common.Address `json:"owner"`
+	Spender  common.Address `json:"spender"`
+	Value    *big.Int       `json:"value"`
+	Nonce    *big.Int       `json:"nonce"`
+	Deadline *big.Int       `json:"deadline"`
+}
+
+func (m Erc20PermitMessage) ToTypedData(chainId *big.Int) apitypes.TypedData {
+	const primaryTypeName = "Permit"
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			eip712.PrimaryTypeNameEIP712Domain: eip712.GetTokenDomainTypes(),
+			primaryTypeName: []apitypes.Type{
+				{"owner", "address"},
+				{"spender", "address"},
+				{"value", "uint256"},
+				{"nonce", "uint256"},
+				{"deadline", "uint256"},
+			},
+		},
+		PrimaryType: primaryTypeName,
+		Domain:      eip712.GetTokenDomain(m.Name, m.Contract, chainId),
+		Message: apitypes.TypedDataMessage{
+			"owner":    m.Owner.String(),
+			"spender":  m.Spender.String(),
+			"value":    (*cmath.HexOrDecimal256)(m.Value),
+			"nonce":    (*cmath.HexOrDecimal256)(m.Nonce),
+			"deadline": (*cmath.HexOrDecimal256)(m.Deadline),
+		},
+	}
+}
+
+// Gov tuples
+
+// GovWeightedVoteOption is the Go representation of the `WeightedVoteOption` struct of the Gov contract.
+type GovWeightedVoteOption struct {
+	Option uint8  `json:"option"`
+	Weight string `json:"weight"`
+}
+
+// GovWeightedVoteOptionsFromUnpacked converts the unpacked `WeightedVoteOption[]` input into Go representation.
+func GovWeightedVoteOptionsFromUnpacked(v any) ([]GovWeightedVoteOption, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var options []GovWeightedVoteOption
+	if err := json.Unmarshal(bz, &options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// GovProposalInfo is the Go representation of the `ProposalInfo` struct of the Gov contract.
+type GovProposalInfo struct {
+	Id              uint64
+	Status          uint8
+	Proposer        common.Address
+	Title           string
+	Summary         string
+	Metadata        string
+	Expedited       bool
+	TotalDeposit    *big.Int
+	SubmitTime      uint64
+	DepositEndTime  uint64
+	VotingStartTime uint64
+	VotingEndTime   uint64
+}
+
+// GovTallyResult is the Go representation of the `TallyResult` struct of the Gov contract.
+type GovTallyResult struct {
+	Yes        *big.Int
+	Abstain    *big.Int
+	No         *big.Int
+	NoWithVeto *big.Int
+}
+
+// IBC Transfer tuples
+
+// IbcTransferHeight is the Go representation of the `Height` struct of the IBC Transfer contract.
+type IbcTransferHeight struct {
+	RevisionNumber uint64 `json:"revisionNumber"`
+	RevisionHeight uint64 `json:"revisionHeight"`
+}
+
+// IbcTransferHeightFromUnpacked converts the unpacked `Height` input into Go representation.
+func IbcTransferHeightFromUnpacked(v any) (IbcTransferHeight, error) {
+	var height IbcTransferHeight
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return height, err
+	}
+	if err := json.Unmarshal(bz, &height); err != nil {
+		return height, err
+	}
+	return height, nil
+}
+
+// IbcTransferDenomTrace is the Go representation of the `DenomTrace` struct of the IBC Transfer contract.
+type IbcTransferDenomTrace struct {
+	Path      string
+	BaseDenom string
+}
+
+// Multicall tuples
+
+// MulticallCall is the Go representation of the `Call` struct of the Multicall contract.
+type MulticallCall struct {
+	Target       common.Address `json:"target"`
+	CallData     []byte         `json:"callData"`
+	AllowFailure bool           `json:"allowFailure"`
+}
+
+// MulticallCallsFromUnpacked converts the unpacked `Call[]` input into Go representation.
+func MulticallCallsFromUnpacked(v any) ([]MulticallCall, error) {
+	var calls []MulticallCall
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &calls); err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
+
+// MulticallResult is the Go representation of the `Result` struct of the Multicall contract.
+type MulticallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// Slashing tuples
+
+// SlashingValidatorDescription is the Go representation of the `ValidatorDescription` struct of the Slashing contract.
+type SlashingValidatorDescription struct {
+	Moniker         string `json:"moniker"`
+	Identity        string `json:"identity"`
+	Website         string `json:"website"`
+	SecurityContact string `json:"securityContact"`
+	Details         string `json:"details"`
+}
+
+// SlashingValidatorDescriptionFromUnpacked converts the unpacked `ValidatorDescription` input into Go representation.
+func SlashingValidatorDescriptionFromUnpacked(v any) (SlashingValidatorDescription, error) {
+	var description SlashingValidatorDescription
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return description, err
+	}
+	if err := json.Unmarshal(bz, &description); err != nil {
+		return description, err
+	}
+	return description, nil
+}
+
+// SlashingSigningInfo is the Go representation of the `SigningInfo` struct of the Slashing contract.
+type SlashingSigningInfo struct {
+	ConsensusAddress    common.Address
+	StartHeight         int64
+	IndexOffset         int64
+	JailedUntil         uint64
+	Tombstoned          bool
+	MissedBlocksCounter int64
+}
+
+// Vesting tuples
+
+// VestingPeriod is the Go representation of the `VestingPeriod` struct of the Vesting contract.
+type VestingPeriod struct {
+	Length uint64   `json:"length"`
+	Amount *big.Int `json:"amount"`
+}
+
+// VestingPeriodsFromUnpacked converts the unpacked `VestingPeriod[]` input into Go representation.
+func VestingPeriodsFromUnpacked(v any) ([]VestingPeriod, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var periods []VestingPeriod
+	if err := json.Unmarshal(bz, &periods); err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+// Bank tuples
+
+// BankCoin is the Go representation of the `Coin` struct of the Bank contract.
+type BankCoin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// BankDenomMetadata is the Go representation of the `DenomMetadata` struct of the Bank contract.
+type BankDenomMetadata struct {
+	Base        string
+	Display     string
+	Name        string
+	Symbol      string
+	Description string
+	Decimals    uint8
+}
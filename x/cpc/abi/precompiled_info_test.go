@@ -10,6 +10,7 @@ import (
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 
 	"github.com/EscanBE/everlast/constants"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -139,6 +140,33 @@ func TestCustomPrecompiledContractInfo_PackMethodOutput(t *testing.T) {
 	})
 }
 
+func TestGetCustomPrecompiledContractInfoByType(t *testing.T) {
+	for _, cpcType := range []uint32{
+		cpctypes.CpcTypeErc20,
+		cpctypes.CpcTypeStaking,
+		cpctypes.CpcTypeBech32,
+		cpctypes.CpcTypeGov,
+		cpctypes.CpcTypeDistribution,
+		cpctypes.CpcTypeIbcTransfer,
+		cpctypes.CpcTypeMulticall,
+		cpctypes.CpcTypeSlashing,
+		cpctypes.CpcTypeAuthz,
+		cpctypes.CpcTypeVesting,
+		cpctypes.CpcTypeBank,
+	} {
+		t.Run(fmt.Sprintf("type %d", cpcType), func(t *testing.T) {
+			info, abiJson, found := GetCustomPrecompiledContractInfoByType(cpcType)
+			require.True(t, found)
+			require.NotEmpty(t, info.Name)
+			require.NotEmpty(t, info.ABI.Methods)
+			require.NotEmpty(t, abiJson)
+		})
+	}
+
+	_, _, found := GetCustomPrecompiledContractInfoByType(0)
+	require.False(t, found)
+}
+
 func Test_Erc20(t *testing.T) {
 	cpcInfo := Erc20CpcInfo
 	t.Run("totalSupply()", func(t *testing.T) {
@@ -264,6 +292,119 @@ func Test_Erc20(t *testing.T) {
 		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
 		require.Equal(t, bigIntMaxUint64, ret[1].(*big.Int))
 	})
+	t.Run("permit(address,address,uint256,uint256,uint8,bytes32,bytes32)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["permit"].Inputs.Pack(
+			common.BytesToAddress([]byte("owner")), common.BytesToAddress([]byte("spender")),
+			bigIntMaxUint64, big.NewInt(1),
+			uint8(27), toByte32([]byte("r")), toByte32([]byte("s")),
+		)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"permit",
+			append([]byte{0xd5, 0x05, 0xac, 0xcf}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 7)
+		require.Equal(t, common.BytesToAddress([]byte("owner")), ret[0].(common.Address))
+		require.Equal(t, common.BytesToAddress([]byte("spender")), ret[1].(common.Address))
+		require.Equal(t, bigIntMaxUint64, ret[2].(*big.Int))
+		require.Equal(t, big.NewInt(1), ret[3].(*big.Int))
+		require.Equal(t, uint8(27), ret[4].(uint8))
+		require.Equal(t, toByte32([]byte("r")), ret[5].([32]byte))
+		require.Equal(t, toByte32([]byte("s")), ret[6].([32]byte))
+
+		bz, err = cpcInfo.PackMethodOutput("permit")
+		require.NoError(t, err)
+		require.Empty(t, bz)
+	})
+	t.Run("nonces(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"nonces",
+			simpleBuildMethodInput(
+				[]byte{0x7e, 0xce, 0xbe, 0x00}, common.BytesToAddress([]byte("owner")),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("owner")), ret[0].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("nonces", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+	t.Run("DOMAIN_SEPARATOR()", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"DOMAIN_SEPARATOR",
+			simpleBuildMethodInput([]byte{0x36, 0x44, 0xe5, 0x15}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, ret)
+
+		bz, err := cpcInfo.PackMethodOutput("DOMAIN_SEPARATOR", toByte32(bigIntMaxUint64Bz))
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+	t.Run("owner()", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"owner",
+			simpleBuildMethodInput([]byte{0x8d, 0xa5, 0xcb, 0x5b}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, ret)
+
+		bz, err := cpcInfo.PackMethodOutput("owner", common.BytesToAddress([]byte("owner")))
+		require.NoError(t, err)
+		require.Equal(t, common.BytesToAddress([]byte("owner")).Bytes(), bz[12:])
+	})
+	t.Run("mint(address,uint256)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"mint",
+			simpleBuildMethodInput(
+				[]byte{0x40, 0xc1, 0x0f, 0x19}, common.BytesToAddress([]byte("account")), bigIntMaxUint64,
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
+		require.Equal(t, bigIntMaxUint64, ret[1].(*big.Int))
+
+		bz, err := cpcInfo.PackMethodOutput("mint")
+		require.NoError(t, err)
+		require.Empty(t, bz)
+	})
+	t.Run("transferOwnership(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"transferOwnership",
+			simpleBuildMethodInput(
+				[]byte{0xf2, 0xfd, 0xe3, 0x8b}, common.BytesToAddress([]byte("owner")),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("owner")), ret[0].(common.Address))
+	})
+	t.Run("renounceOwnership()", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"renounceOwnership",
+			simpleBuildMethodInput([]byte{0x71, 0x50, 0x18, 0xa6}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, ret)
+	})
+	t.Run("setMetadata(string,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["setMetadata"].Inputs.Pack("name", "symbol")
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"setMetadata",
+			append([]byte{0x51, 0x33, 0x5b, 0x50}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, "name", ret[0].(string))
+		require.Equal(t, "symbol", ret[1].(string))
+	})
 }
 
 func Test_Staking(t *testing.T) {
@@ -421,6 +562,68 @@ func Test_Staking(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, bigIntOneBz, bz)
 	})
+	t.Run("cancelUnbondingDelegationByMessage(CancelUnbondingMessage,bytes32,bytes32,uint8)", func(t *testing.T) {
+		message := CancelUnbondingMessage{
+			Delegator:      common.BytesToAddress([]byte("delegator")),
+			Validator:      "evlvaloper1cqetlv987ntelz7s6ntvv95ltrns9qt6f63s47",
+			Amount:         big.NewInt(1),
+			Denom:          constants.BaseDenom,
+			CreationHeight: math.MaxInt64,
+		}
+		require.Nil(t, message.Validate(addresscodec.NewBech32Codec(constants.Bech32PrefixValAddr), constants.BaseDenom))
+		bz, err := cpcInfo.ABI.Methods["cancelUnbondingDelegationByMessage"].Inputs.Pack(message, toByte32(bigIntMaxInt64Bz), toByte32(bigIntMaxUint64Bz), uint8(math.MaxUint8))
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"cancelUnbondingDelegationByMessage",
+			append([]byte{0x30, 0xb8, 0xd7, 0xdc}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 4)
+		decodedMessage := &CancelUnbondingMessage{}
+		require.NoError(t, decodedMessage.FromUnpackedStruct(ret[0]))
+		require.Equal(t, message, *decodedMessage)
+		require.Equal(t, toByte32(bigIntMaxInt64Bz), ret[1].([32]byte))
+		require.Equal(t, toByte32(bigIntMaxUint64Bz), ret[2].([32]byte))
+		require.Equal(t, uint8(math.MaxUint8), ret[3].(uint8))
+
+		bz, err = cpcInfo.PackMethodOutput("cancelUnbondingDelegationByMessage", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("CancelUnbondingMessage validation", func(t *testing.T) {
+		valid := CancelUnbondingMessage{
+			Delegator:      common.BytesToAddress([]byte("delegator")),
+			Validator:      "evlvaloper1cqetlv987ntelz7s6ntvv95ltrns9qt6f63s47",
+			Amount:         big.NewInt(1),
+			Denom:          constants.BaseDenom,
+			CreationHeight: 1,
+		}
+		valAddrCodec := addresscodec.NewBech32Codec(constants.Bech32PrefixValAddr)
+		require.NoError(t, valid.Validate(valAddrCodec, constants.BaseDenom))
+
+		for _, creationHeight := range []int64{0, -1} {
+			message := valid
+			message.CreationHeight = creationHeight
+			require.ErrorContains(t, message.Validate(valAddrCodec, constants.BaseDenom), "creation height must be positive")
+		}
+
+		message := valid
+		message.Amount = big.NewInt(0)
+		require.ErrorContains(t, message.Validate(valAddrCodec, constants.BaseDenom), "amount must be positive")
+
+		message = valid
+		message.Denom = "other"
+		require.ErrorContains(t, message.Validate(valAddrCodec, constants.BaseDenom), "denom must be")
+
+		message = valid
+		message.Validator = "100"
+		require.ErrorContains(t, message.Validate(valAddrCodec, constants.BaseDenom), "invalid validator")
+
+		message = valid
+		message.Delegator = common.Address{}
+		require.ErrorContains(t, message.Validate(valAddrCodec, constants.BaseDenom), "delegator cannot be empty")
+	})
 	t.Run("delegateByActionMessage(StakingMessage,bytes32,bytes32,uint8)", func(t *testing.T) {
 		messages := []StakingMessage{
 			{
@@ -471,6 +674,24 @@ func Test_Staking(t *testing.T) {
 			require.Equal(t, bigIntOneBz, bz)
 		}
 	})
+	t.Run("cancelUnbondingDelegation(address,uint256,int64)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["cancelUnbondingDelegation"].Inputs.Pack(common.BytesToAddress([]byte("validator")), bigIntMaxUint64, int64(math.MaxInt64))
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"cancelUnbondingDelegation",
+			append([]byte{0x69, 0xa2, 0xf5, 0x36}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, common.BytesToAddress([]byte("validator")), ret[0].(common.Address))
+		require.Equal(t, bigIntMaxUint64, ret[1].(*big.Int))
+		require.Equal(t, int64(math.MaxInt64), ret[2].(int64))
+
+		bz, err = cpcInfo.PackMethodOutput("cancelUnbondingDelegation", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
 	t.Run("withdrawReward(address)", func(t *testing.T) {
 		ret, err := cpcInfo.UnpackMethodInput(
 			"withdrawReward",
@@ -486,6 +707,115 @@ func Test_Staking(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, bigIntOneBz, bz)
 	})
+	t.Run("unbondingDelegationsOf(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"unbondingDelegationsOf",
+			simpleBuildMethodInput(
+				[]byte{0x6b, 0xc4, 0x5c, 0xf2}, common.BytesToAddress([]byte("account")),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
+
+		entries := []StakingUnbondingDelegationEntry{
+			{
+				Validator:      common.BytesToAddress([]byte("validator")),
+				CreationHeight: math.MaxInt64,
+				CompletionTime: math.MaxUint64,
+				InitialBalance: bigIntMaxUint64,
+				Balance:        big.NewInt(1),
+			},
+		}
+		bz, err := cpcInfo.PackMethodOutput("unbondingDelegationsOf", entries)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["unbondingDelegationsOf"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", entries), fmt.Sprintf("%v", ops[0]))
+	})
+	t.Run("redelegationsOf(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"redelegationsOf",
+			simpleBuildMethodInput(
+				[]byte{0x61, 0x79, 0xb2, 0x0f}, common.BytesToAddress([]byte("account")),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
+
+		entries := []StakingRedelegationEntry{
+			{
+				SrcValidator:   common.BytesToAddress([]byte("src")),
+				DstValidator:   common.BytesToAddress([]byte("dst")),
+				CreationHeight: 1,
+				CompletionTime: 2,
+				InitialBalance: bigIntMaxUint64,
+				SharesDst:      bigIntMaxUint64,
+			},
+		}
+		bz, err := cpcInfo.PackMethodOutput("redelegationsOf", entries)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["redelegationsOf"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", entries), fmt.Sprintf("%v", ops[0]))
+	})
+	t.Run("validatorInfo(address) and validators(uint256,uint256)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"validatorInfo",
+			simpleBuildMethodInput(
+				[]byte{0x4f, 0x18, 0x11, 0xdd}, common.BytesToAddress([]byte("validator")),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("validator")), ret[0].(common.Address))
+
+		ret, err = cpcInfo.UnpackMethodInput(
+			"validators",
+			simpleBuildMethodInput(
+				[]byte{0xdc, 0xf2, 0x79, 0x3a}, big.NewInt(1), bigIntMaxUint64,
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, big.NewInt(1), ret[0].(*big.Int))
+		require.Equal(t, bigIntMaxUint64, ret[1].(*big.Int))
+
+		info := StakingValidatorInfo{
+			Validator:               common.BytesToAddress([]byte("validator")),
+			Moniker:                 text,
+			Status:                  3,
+			Jailed:                  true,
+			Tokens:                  bigIntMaxUint64,
+			DelegatorShares:         bigIntMaxUint64,
+			CommissionRate:          big.NewInt(1),
+			CommissionMaxRate:       big.NewInt(2),
+			CommissionMaxChangeRate: big.NewInt(3),
+			MinSelfDelegation:       big.NewInt(4),
+		}
+		bz, err := cpcInfo.PackMethodOutput("validatorInfo", info)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["validatorInfo"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", info), fmt.Sprintf("%v", ops[0]))
+
+		bz, err = cpcInfo.PackMethodOutput("validators", []StakingValidatorInfo{info}, big.NewInt(10))
+		require.NoError(t, err)
+		ops, err = cpcInfo.ABI.Methods["validators"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 2)
+		require.Equal(t, fmt.Sprintf("%v", []StakingValidatorInfo{info}), fmt.Sprintf("%v", ops[0]))
+		require.Equal(t, big.NewInt(10), ops[1].(*big.Int))
+	})
+	t.Run("bondedRatio()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("bondedRatio", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
 	t.Run("withdrawRewards()", func(t *testing.T) {
 		bz, err := cpcInfo.PackMethodOutput("withdrawRewards", true)
 		require.NoError(t, err)
@@ -670,6 +1000,841 @@ func Test_Bech32(t *testing.T) {
 	})
 }
 
+func Test_Gov(t *testing.T) {
+	cpcInfo := GovCpcInfo
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("proposal(uint64)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"proposal",
+			simpleBuildMethodInput(
+				[]byte{0x7a, 0xfa, 0x0a, 0xa3}, bigIntMaxUint64,
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, uint64(math.MaxUint64), ret[0].(uint64))
+
+		proposal := GovProposalInfo{
+			Id:              1,
+			Status:          2,
+			Proposer:        common.BytesToAddress([]byte("proposer")),
+			Title:           "title",
+			Summary:         "summary",
+			Metadata:        "metadata",
+			Expedited:       true,
+			TotalDeposit:    bigIntMaxUint64,
+			SubmitTime:      3,
+			DepositEndTime:  4,
+			VotingStartTime: 5,
+			VotingEndTime:   6,
+		}
+		bz, err := cpcInfo.PackMethodOutput("proposal", proposal)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["proposal"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", proposal), fmt.Sprintf("%v", ops[0]))
+	})
+	t.Run("tallyResult(uint64)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"tallyResult",
+			simpleBuildMethodInput(
+				[]byte{0x10, 0x11, 0x46, 0xbe}, big.NewInt(1),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, uint64(1), ret[0].(uint64))
+
+		bz, err := cpcInfo.PackMethodOutput("tallyResult", GovTallyResult{
+			Yes:        big.NewInt(1),
+			Abstain:    big.NewInt(2),
+			No:         big.NewInt(3),
+			NoWithVeto: bigIntMaxUint64,
+		})
+		require.NoError(t, err)
+		require.Len(t, bz, 32*4)
+		require.Equal(t, bigIntOneBz, bz[:32])
+		require.Equal(t, bigIntMaxUint64Bz, bz[96:])
+	})
+	t.Run("voteOf(uint64,address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"voteOf",
+			simpleBuildMethodInput(
+				[]byte{0xf9, 0xb8, 0x49, 0x69}, big.NewInt(1), common.BytesToAddress([]byte("voter")),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, uint64(1), ret[0].(uint64))
+		require.Equal(t, common.BytesToAddress([]byte("voter")), ret[1].(common.Address))
+
+		options := []GovWeightedVoteOption{
+			{Option: 1, Weight: "0.7"},
+			{Option: 3, Weight: "0.3"},
+		}
+		bz, err := cpcInfo.PackMethodOutput("voteOf", options)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["voteOf"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		decodedOptions, err := GovWeightedVoteOptionsFromUnpacked(ops[0])
+		require.NoError(t, err)
+		require.Equal(t, options, decodedOptions)
+	})
+	t.Run("depositOf(uint64,address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"depositOf",
+			simpleBuildMethodInput(
+				[]byte{0x44, 0x13, 0xa5, 0xd3}, big.NewInt(1), common.BytesToAddress([]byte("depositor")),
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, uint64(1), ret[0].(uint64))
+		require.Equal(t, common.BytesToAddress([]byte("depositor")), ret[1].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("depositOf", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+	t.Run("submitProposal(string,string,string,string,uint256,bool)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["submitProposal"].Inputs.Pack("[]", "metadata", "title", "summary", bigIntMaxUint64, true)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"submitProposal",
+			append([]byte{0x1e, 0xe7, 0x05, 0x6b}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 6)
+		require.Equal(t, "[]", ret[0].(string))
+		require.Equal(t, "metadata", ret[1].(string))
+		require.Equal(t, "title", ret[2].(string))
+		require.Equal(t, "summary", ret[3].(string))
+		require.Equal(t, bigIntMaxUint64, ret[4].(*big.Int))
+		require.Equal(t, true, ret[5].(bool))
+
+		bz, err = cpcInfo.PackMethodOutput("submitProposal", uint64(1))
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("deposit(uint64,uint256)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"deposit",
+			simpleBuildMethodInput(
+				[]byte{0x61, 0x70, 0xc4, 0xb1}, big.NewInt(1), bigIntMaxUint64,
+			),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, uint64(1), ret[0].(uint64))
+		require.Equal(t, bigIntMaxUint64, ret[1].(*big.Int))
+
+		bz, err := cpcInfo.PackMethodOutput("deposit", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("vote(uint64,uint8,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["vote"].Inputs.Pack(uint64(1), uint8(1), text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"vote",
+			append([]byte{0x52, 0x87, 0x83, 0xd5}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, uint64(1), ret[0].(uint64))
+		require.Equal(t, uint8(1), ret[1].(uint8))
+		require.Equal(t, text, ret[2].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("vote", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("voteWeighted(uint64,(uint8,string)[],string)", func(t *testing.T) {
+		options := []GovWeightedVoteOption{
+			{Option: 1, Weight: "0.7"},
+			{Option: 3, Weight: "0.3"},
+		}
+		bz, err := cpcInfo.ABI.Methods["voteWeighted"].Inputs.Pack(uint64(1), options, text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"voteWeighted",
+			append([]byte{0xc1, 0xcf, 0xfe, 0xf3}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, uint64(1), ret[0].(uint64))
+		decodedOptions, err := GovWeightedVoteOptionsFromUnpacked(ret[1])
+		require.NoError(t, err)
+		require.Equal(t, options, decodedOptions)
+		require.Equal(t, text, ret[2].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("voteWeighted", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+}
+
+func Test_Distribution(t *testing.T) {
+	cpcInfo := DistributionCpcInfo
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	for _, tt := range []struct {
+		method string
+		sig    []byte
+	}{
+		{method: "validatorOutstandingRewards", sig: []byte{0xf2, 0x8e, 0x9b, 0x39}},
+		{method: "validatorCommission", sig: []byte{0x83, 0xa2, 0x50, 0x78}},
+	} {
+		t.Run(tt.method+"(address)", func(t *testing.T) {
+			ret, err := cpcInfo.UnpackMethodInput(
+				tt.method,
+				simpleBuildMethodInput(tt.sig, common.BytesToAddress([]byte("validator"))),
+			)
+			require.NoError(t, err)
+			require.Len(t, ret, 1)
+			require.Equal(t, common.BytesToAddress([]byte("validator")), ret[0].(common.Address))
+
+			bz, err := cpcInfo.PackMethodOutput(tt.method, bigIntMaxUint64)
+			require.NoError(t, err)
+			require.Equal(t, bigIntMaxUint64Bz, bz)
+		})
+	}
+	t.Run("withdrawAddressOf(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"withdrawAddressOf",
+			simpleBuildMethodInput([]byte{0x7f, 0x69, 0xa5, 0x34}, common.BytesToAddress([]byte("delegator"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("delegator")), ret[0].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("withdrawAddressOf", common.BytesToAddress([]byte("withdraw")))
+		require.NoError(t, err)
+		require.Equal(t, common.BytesToHash([]byte("withdraw")).Bytes(), bz)
+	})
+	t.Run("communityPool()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("communityPool", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+	t.Run("setWithdrawAddress(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"setWithdrawAddress",
+			simpleBuildMethodInput([]byte{0x3a, 0xb1, 0xa4, 0x94}, common.BytesToAddress([]byte("withdraw"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("withdraw")), ret[0].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("setWithdrawAddress", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("withdrawValidatorCommission()", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"withdrawValidatorCommission",
+			simpleBuildMethodInput([]byte{0x0b, 0xde, 0x07, 0x6d}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, ret)
+
+		bz, err := cpcInfo.PackMethodOutput("withdrawValidatorCommission", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+	t.Run("fundCommunityPool(uint256)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"fundCommunityPool",
+			simpleBuildMethodInput([]byte{0xde, 0xe6, 0x86, 0x23}, bigIntMaxUint64),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, bigIntMaxUint64, ret[0].(*big.Int))
+
+		bz, err := cpcInfo.PackMethodOutput("fundCommunityPool", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+}
+
+func Test_IbcTransfer(t *testing.T) {
+	cpcInfo := IbcTransferCpcInfo
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("denomTrace(string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["denomTrace"].Inputs.Pack(text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"denomTrace",
+			append([]byte{0xa8, 0x15, 0xcd, 0xd9}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, text, ret[0].(string))
+
+		denomTrace := IbcTransferDenomTrace{
+			Path:      "transfer/channel-0",
+			BaseDenom: "uatom",
+		}
+		bz, err = cpcInfo.PackMethodOutput("denomTrace", denomTrace)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["denomTrace"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", denomTrace), fmt.Sprintf("%v", ops[0]))
+	})
+	t.Run("denomHash(string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["denomHash"].Inputs.Pack(text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"denomHash",
+			append([]byte{0xb5, 0xcb, 0x6e, 0x7d}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, text, ret[0].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("denomHash", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("transfer(string,string,uint256,string,(uint64,uint64),uint64,string)", func(t *testing.T) {
+		timeoutHeight := IbcTransferHeight{
+			RevisionNumber: 1,
+			RevisionHeight: math.MaxUint64,
+		}
+		bz, err := cpcInfo.ABI.Methods["transfer"].Inputs.Pack("channel-0", "wei", bigIntMaxUint64, "cosmos1receiver", timeoutHeight, uint64(2), text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"transfer",
+			append([]byte{0x29, 0x14, 0xcc, 0x14}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 7)
+		require.Equal(t, "channel-0", ret[0].(string))
+		require.Equal(t, "wei", ret[1].(string))
+		require.Equal(t, bigIntMaxUint64, ret[2].(*big.Int))
+		require.Equal(t, "cosmos1receiver", ret[3].(string))
+		decodedTimeoutHeight, err := IbcTransferHeightFromUnpacked(ret[4])
+		require.NoError(t, err)
+		require.Equal(t, timeoutHeight, decodedTimeoutHeight)
+		require.Equal(t, uint64(2), ret[5].(uint64))
+		require.Equal(t, text, ret[6].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("transfer", uint64(1))
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+}
+
+func Test_Multicall(t *testing.T) {
+	cpcInfo := MulticallCpcInfo
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("multicall((address,bytes,bool)[])", func(t *testing.T) {
+		calls := []MulticallCall{
+			{
+				Target:       common.HexToAddress("0xcc01000000000000000000000000000000000001"),
+				CallData:     []byte{0xc7, 0xb8, 0x98, 0x1c},
+				AllowFailure: false,
+			},
+			{
+				Target:       common.HexToAddress("0xcc04000000000000000000000000000000000004"),
+				CallData:     []byte{},
+				AllowFailure: true,
+			},
+		}
+		bz, err := cpcInfo.ABI.Methods["multicall"].Inputs.Pack(calls)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"multicall",
+			append([]byte{0x19, 0xff, 0x3a, 0x43}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		decodedCalls, err := MulticallCallsFromUnpacked(ret[0])
+		require.NoError(t, err)
+		require.Equal(t, calls, decodedCalls)
+
+		results := []MulticallResult{
+			{
+				Success:    true,
+				ReturnData: bigIntOneBz,
+			},
+			{
+				Success:    false,
+				ReturnData: []byte{},
+			},
+		}
+		bz, err = cpcInfo.PackMethodOutput("multicall", results)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["multicall"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", results), fmt.Sprintf("%v", ops[0]))
+	})
+}
+
+func Test_Slashing(t *testing.T) {
+	cpcInfo := SlashingCpcInfo
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("signingInfo(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"signingInfo",
+			simpleBuildMethodInput([]byte{0x0f, 0xc4, 0x98, 0xb4}, common.BytesToAddress([]byte("validator"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("validator")), ret[0].(common.Address))
+
+		info := SlashingSigningInfo{
+			ConsensusAddress:    common.BytesToAddress([]byte("consensus")),
+			StartHeight:         1,
+			IndexOffset:         2,
+			JailedUntil:         3,
+			Tombstoned:          true,
+			MissedBlocksCounter: 4,
+		}
+		bz, err := cpcInfo.PackMethodOutput("signingInfo", info)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["signingInfo"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", info), fmt.Sprintf("%v", ops[0]))
+	})
+	t.Run("missedBlocksCounter(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"missedBlocksCounter",
+			simpleBuildMethodInput([]byte{0x95, 0xdd, 0x5e, 0xe2}, common.BytesToAddress([]byte("validator"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("validator")), ret[0].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("missedBlocksCounter", int64(1), int64(100))
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["missedBlocksCounter"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 2)
+		require.Equal(t, int64(1), ops[0].(int64))
+		require.Equal(t, int64(100), ops[1].(int64))
+	})
+	t.Run("unjail()", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"unjail",
+			simpleBuildMethodInput([]byte{0xf6, 0x79, 0xd3, 0x05}),
+		)
+		require.NoError(t, err)
+		require.Empty(t, ret)
+
+		bz, err := cpcInfo.PackMethodOutput("unjail", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("editValidatorDescription((string,string,string,string,string))", func(t *testing.T) {
+		description := SlashingValidatorDescription{
+			Moniker:         "moniker",
+			Identity:        "identity",
+			Website:         "website",
+			SecurityContact: "security",
+			Details:         "details",
+		}
+		bz, err := cpcInfo.ABI.Methods["editValidatorDescription"].Inputs.Pack(description)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"editValidatorDescription",
+			append([]byte{0x6b, 0xec, 0x4e, 0x8f}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		decodedDescription, err := SlashingValidatorDescriptionFromUnpacked(ret[0])
+		require.NoError(t, err)
+		require.Equal(t, description, decodedDescription)
+
+		bz, err = cpcInfo.PackMethodOutput("editValidatorDescription", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("editValidatorCommissionRate(uint256)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"editValidatorCommissionRate",
+			simpleBuildMethodInput([]byte{0x03, 0x86, 0xf5, 0x99}, bigIntMaxUint64),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, bigIntMaxUint64, ret[0].(*big.Int))
+
+		bz, err := cpcInfo.PackMethodOutput("editValidatorCommissionRate", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+}
+
+func Test_Authz(t *testing.T) {
+	cpcInfo := AuthzCpcInfo
+
+	granter := common.BytesToAddress([]byte("granter"))
+	grantee := common.BytesToAddress([]byte("grantee"))
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("grantOf(address,address,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["grantOf"].Inputs.Pack(granter, grantee, text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"grantOf",
+			append([]byte{0x45, 0x2a, 0x58, 0x97}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, granter, ret[0].(common.Address))
+		require.Equal(t, grantee, ret[1].(common.Address))
+		require.Equal(t, text, ret[2].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("grantOf", true, uint64(math.MaxUint64))
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["grantOf"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 2)
+		require.True(t, ops[0].(bool))
+		require.Equal(t, uint64(math.MaxUint64), ops[1].(uint64))
+	})
+	t.Run("sendSpendLimit(address,address,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["sendSpendLimit"].Inputs.Pack(granter, grantee, text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"sendSpendLimit",
+			append([]byte{0x6e, 0x1a, 0xaf, 0x12}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, granter, ret[0].(common.Address))
+		require.Equal(t, grantee, ret[1].(common.Address))
+		require.Equal(t, text, ret[2].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("sendSpendLimit", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64, new(big.Int).SetBytes(bz))
+	})
+	t.Run("grantGeneric(address,string,uint64)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["grantGeneric"].Inputs.Pack(grantee, text, uint64(math.MaxUint64))
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"grantGeneric",
+			append([]byte{0x4f, 0xc2, 0x44, 0x24}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, grantee, ret[0].(common.Address))
+		require.Equal(t, text, ret[1].(string))
+		require.Equal(t, uint64(math.MaxUint64), ret[2].(uint64))
+
+		bz, err = cpcInfo.PackMethodOutput("grantGeneric", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("grantSend(address,string,uint256,uint64)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["grantSend"].Inputs.Pack(grantee, text, bigIntMaxUint64, uint64(math.MaxUint64))
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"grantSend",
+			append([]byte{0xe4, 0x33, 0x46, 0xec}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 4)
+		require.Equal(t, grantee, ret[0].(common.Address))
+		require.Equal(t, text, ret[1].(string))
+		require.Equal(t, bigIntMaxUint64, ret[2].(*big.Int))
+		require.Equal(t, uint64(math.MaxUint64), ret[3].(uint64))
+
+		bz, err = cpcInfo.PackMethodOutput("grantSend", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("revoke(address,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["revoke"].Inputs.Pack(grantee, text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"revoke",
+			append([]byte{0xaf, 0xd0, 0x22, 0x4b}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, grantee, ret[0].(common.Address))
+		require.Equal(t, text, ret[1].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("revoke", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("execSend(address,address,string,uint256)", func(t *testing.T) {
+		to := common.BytesToAddress([]byte("to"))
+
+		bz, err := cpcInfo.ABI.Methods["execSend"].Inputs.Pack(granter, to, text, bigIntMaxUint64)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"execSend",
+			append([]byte{0x68, 0x96, 0xc2, 0x03}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 4)
+		require.Equal(t, granter, ret[0].(common.Address))
+		require.Equal(t, to, ret[1].(common.Address))
+		require.Equal(t, text, ret[2].(string))
+		require.Equal(t, bigIntMaxUint64, ret[3].(*big.Int))
+
+		bz, err = cpcInfo.PackMethodOutput("execSend", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("execDelegate(address,address,uint256)", func(t *testing.T) {
+		validator := common.BytesToAddress([]byte("validator"))
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"execDelegate",
+			simpleBuildMethodInput([]byte{0x75, 0x51, 0x65, 0x6e}, granter, validator, bigIntMaxUint64),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, granter, ret[0].(common.Address))
+		require.Equal(t, validator, ret[1].(common.Address))
+		require.Equal(t, bigIntMaxUint64, ret[2].(*big.Int))
+
+		bz, err := cpcInfo.PackMethodOutput("execDelegate", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+}
+
+func Test_Vesting(t *testing.T) {
+	cpcInfo := VestingCpcInfo
+
+	account := common.BytesToAddress([]byte("account"))
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("vestingInfo(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"vestingInfo",
+			simpleBuildMethodInput([]byte{0xf7, 0x8e, 0x63, 0x3d}, account),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, account, ret[0].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("vestingInfo", big.NewInt(1), big.NewInt(2), bigIntMaxUint64)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["vestingInfo"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 3)
+		require.Equal(t, big.NewInt(1), ops[0].(*big.Int))
+		require.Equal(t, big.NewInt(2), ops[1].(*big.Int))
+		require.Equal(t, bigIntMaxUint64, ops[2].(*big.Int))
+	})
+	t.Run("createVestingAccount(address,uint256,uint64,bool)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["createVestingAccount"].Inputs.Pack(account, bigIntMaxUint64, uint64(math.MaxInt64), true)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"createVestingAccount",
+			append([]byte{0xd5, 0x68, 0x0e, 0x5b}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 4)
+		require.Equal(t, account, ret[0].(common.Address))
+		require.Equal(t, bigIntMaxUint64, ret[1].(*big.Int))
+		require.Equal(t, uint64(math.MaxInt64), ret[2].(uint64))
+		require.True(t, ret[3].(bool))
+
+		bz, err = cpcInfo.PackMethodOutput("createVestingAccount", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+	t.Run("createPeriodicVestingAccount(address,uint64,(uint64,uint256)[])", func(t *testing.T) {
+		periods := []VestingPeriod{
+			{Length: 1, Amount: big.NewInt(2)},
+			{Length: math.MaxUint64, Amount: bigIntMaxUint64},
+		}
+		bz, err := cpcInfo.ABI.Methods["createPeriodicVestingAccount"].Inputs.Pack(account, uint64(math.MaxInt64), periods)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"createPeriodicVestingAccount",
+			append([]byte{0xc0, 0x67, 0x93, 0x64}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, account, ret[0].(common.Address))
+		require.Equal(t, uint64(math.MaxInt64), ret[1].(uint64))
+
+		gotPeriods, err := VestingPeriodsFromUnpacked(ret[2])
+		require.NoError(t, err)
+		require.Equal(t, periods, gotPeriods)
+
+		bz, err = cpcInfo.PackMethodOutput("createPeriodicVestingAccount", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+}
+
+func Test_Bank(t *testing.T) {
+	cpcInfo := BankCpcInfo
+
+	account := common.BytesToAddress([]byte("account"))
+
+	t.Run("name()", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("name", text)
+		require.NoError(t, err)
+		require.Equal(t, textAbiEncodedBz, bz)
+	})
+	t.Run("balanceOf(address,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["balanceOf"].Inputs.Pack(account, text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"balanceOf",
+			append([]byte{0xb9, 0xb0, 0x92, 0xc8}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, account, ret[0].(common.Address))
+		require.Equal(t, text, ret[1].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("balanceOf", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64, new(big.Int).SetBytes(bz))
+	})
+	t.Run("allBalances(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"allBalances",
+			simpleBuildMethodInput([]byte{0xe5, 0x3f, 0x71, 0x4f}, account),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, account, ret[0].(common.Address))
+
+		coins := []BankCoin{
+			{Denom: "uone", Amount: big.NewInt(1)},
+			{Denom: "utwo", Amount: bigIntMaxUint64},
+		}
+		bz, err := cpcInfo.PackMethodOutput("allBalances", coins)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["allBalances"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", coins), fmt.Sprintf("%v", ops[0]))
+	})
+	t.Run("denomMetadata(string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["denomMetadata"].Inputs.Pack(text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"denomMetadata",
+			append([]byte{0xbf, 0x16, 0x75, 0x69}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, text, ret[0].(string))
+
+		metadata := BankDenomMetadata{
+			Base:        "uone",
+			Display:     "one",
+			Name:        "One",
+			Symbol:      "ONE",
+			Description: text,
+			Decimals:    6,
+		}
+		bz, err = cpcInfo.PackMethodOutput("denomMetadata", metadata)
+		require.NoError(t, err)
+		ops, err := cpcInfo.ABI.Methods["denomMetadata"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		require.Equal(t, fmt.Sprintf("%v", metadata), fmt.Sprintf("%v", ops[0]))
+	})
+	t.Run("supplyOf(string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["supplyOf"].Inputs.Pack(text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"supplyOf",
+			append([]byte{0x3c, 0xda, 0x01, 0x03}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, text, ret[0].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("supplyOf", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64, new(big.Int).SetBytes(bz))
+	})
+	t.Run("multiSend(address[],string[],uint256[])", func(t *testing.T) {
+		recipients := []common.Address{account, common.BytesToAddress([]byte("another"))}
+		denoms := []string{"uone", "utwo"}
+		amounts := []*big.Int{big.NewInt(1), bigIntMaxUint64}
+
+		bz, err := cpcInfo.ABI.Methods["multiSend"].Inputs.Pack(recipients, denoms, amounts)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"multiSend",
+			append([]byte{0x04, 0x70, 0xb4, 0x7e}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, recipients, ret[0].([]common.Address))
+		require.Equal(t, denoms, ret[1].([]string))
+		require.Equal(t, amounts, ret[2].([]*big.Int))
+
+		bz, err = cpcInfo.PackMethodOutput("multiSend", true)
+		require.NoError(t, err)
+		require.Equal(t, bigIntOneBz, bz)
+	})
+}
+
 func simpleBuildMethodInput(sig []byte, args ...any) []byte {
 	if len(sig) != 4 {
 		panic("signature must be 4 bytes")
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct ValidatorDescription {
+    string moniker;
+    string identity;
+    string website;
+    string securityContact;
+    string details;
+}
+
+struct SigningInfo {
+    address consensusAddress;
+    int64 startHeight;
+    int64 indexOffset;
+    uint64 jailedUntil;
+    bool tombstoned;
+    int64 missedBlocksCounter;
+}
+
+interface ISlashingCPC {
+    /**
+     * @dev Emitted when the validator operator unjailed the validator.
+     */
+    event Unjail(address indexed validator);
+
+    /**
+     * @dev Emitted when the validator operator edited the description or the commission rate of the validator.
+     */
+    event EditValidator(address indexed validator);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the signing info of the validator.
+     * `jailedUntil` is the unix timestamp (in seconds) until which the validator is jailed.
+     */
+    function signingInfo(address validator) external view returns (SigningInfo memory);
+
+    /**
+     * @dev Returns the number of blocks missed by the validator within the current sliding window,
+     * and the size of the sliding window.
+     */
+    function missedBlocksCounter(address validator) external view returns (int64, int64);
+
+    /**
+     * @dev Unjail the validator operated by the caller.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits an {Unjail} event.
+     */
+    function unjail() external returns (bool);
+
+    /**
+     * @dev Edit the description of the validator operated by the caller.
+     * Fields with value `[do-not-modify]` are left unchanged.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits an {EditValidator} event.
+     */
+    function editValidatorDescription(ValidatorDescription memory description) external returns (bool);
+
+    /**
+     * @dev Edit the commission rate of the validator operated by the caller.
+     * `commissionRate` is a decimal with 18 decimal places, the change is bound by the max rate and max change rate of the validator.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits an {EditValidator} event.
+     */
+    function editValidatorCommissionRate(uint256 commissionRate) external returns (bool);
+}
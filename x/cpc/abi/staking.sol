@@ -11,11 +11,49 @@ struct StakingMessage {
     string oldValidator;
 }
 
+struct CancelUnbondingMessage {
+    address delegator;
+    string validator;
+    uint256 amount;
+    string denom;
+    int64 creationHeight;
+}
+
 struct WithdrawRewardMessage {
     address delegator;
     string fromValidator;
 }
 
+struct UnbondingDelegationEntry {
+    address validator;
+    int64 creationHeight;
+    uint64 completionTime;
+    uint256 initialBalance;
+    uint256 balance;
+}
+
+struct RedelegationEntry {
+    address srcValidator;
+    address dstValidator;
+    int64 creationHeight;
+    uint64 completionTime;
+    uint256 initialBalance;
+    uint256 sharesDst;
+}
+
+struct ValidatorInfo {
+    address validator;
+    string moniker;
+    uint8 status;
+    bool jailed;
+    uint256 tokens;
+    uint256 delegatorShares;
+    uint256 commissionRate;
+    uint256 commissionMaxRate;
+    uint256 commissionMaxChangeRate;
+    uint256 minSelfDelegation;
+}
+
 interface IStakingCPC {
     /**
      * @dev Emitted when the delegator delegated into a validator.
@@ -34,6 +72,12 @@ interface IStakingCPC {
      */
     event WithdrawReward(address indexed delegator, address indexed validator, uint256 value);
 
+    /**
+     * @dev Emitted when the delegator cancelled an unbonding delegation entry and delegated back to the validator.
+     * `value` is the cancelled amount, `creationHeight` is the creation height of the unbonding delegation entry.
+     */
+    event CancelUnbonding(address indexed delegator, address indexed validator, uint256 value, int64 creationHeight);
+
     /**
      * @dev Returns the name of the contract.
      */
@@ -74,6 +118,38 @@ interface IStakingCPC {
      */
     function rewardsOf(address account) external view returns (uint256);
 
+    /**
+     * @dev Returns the unbonding entries of an account across all validators.
+     * `completionTime` is the unix timestamp (in seconds) at which the entry matures.
+     */
+    function unbondingDelegationsOf(address account) external view returns (UnbondingDelegationEntry[] memory);
+
+    /**
+     * @dev Returns the pending redelegation entries of an account.
+     * `completionTime` is the unix timestamp (in seconds) at which the entry matures.
+     * `sharesDst` is the amount of shares (18 decimals) created at the destination validator.
+     */
+    function redelegationsOf(address account) external view returns (RedelegationEntry[] memory);
+
+    /**
+     * @dev Returns the information of a validator.
+     * `status` is 1 for unbonded, 2 for unbonding and 3 for bonded.
+     * `delegatorShares` and commission rates are decimals with 18 decimal places.
+     */
+    function validatorInfo(address validator) external view returns (ValidatorInfo memory);
+
+    /**
+     * @dev Returns at most `limit` validators, skipping the first `offset` validators, ordered by operator address.
+     * `limit` must be positive and is capped at 100.
+     * Returns the validators and the total number of validators.
+     */
+    function validators(uint256 offset, uint256 limit) external view returns (ValidatorInfo[] memory, uint256);
+
+    /**
+     * @dev Returns the fraction of the staking tokens which are currently bonded, with 18 decimal places.
+     */
+    function bondedRatio() external view returns (uint256);
+
     /**
      * @dev Delegate a `value` amount of staking coin from the caller's account to `validator`.
      *
@@ -101,6 +177,26 @@ interface IStakingCPC {
      */
     function redelegate(address srcValidator, address dstValidator, uint256 value) external returns (bool);
 
+    /**
+     * @dev Cancel a `value` amount of the caller's account unbonding delegation entry,
+     * created at `creationHeight`, from `validator` and delegate it back to `validator`.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {CancelUnbonding} event.
+     */
+    function cancelUnbondingDelegation(address validator, uint256 value, int64 creationHeight) external returns (bool);
+
+    /**
+     * @dev Cancel the caller's account unbonding delegation entry using EIP-712,
+     * same as `cancelUnbondingDelegation` with the information provided by the message.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {CancelUnbonding} event.
+     */
+    function cancelUnbondingDelegationByMessage(CancelUnbondingMessage memory message, bytes32 r, bytes32 s, uint8 v) external returns (bool);
+
     /**
      * @dev Delegate/Undelegate/Redelegate using EIP-712:
      * - Delegate a `value` amount of staking coin from the caller's account to `validator`.
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct VestingPeriod {
+    uint64 length;
+    uint256 amount;
+}
+
+interface IVestingCPC {
+    /**
+     * @dev Emitted when the funder created a vesting account.
+     * `value` is the total amount of staking coin to be vested.
+     */
+    event CreateVestingAccount(address indexed funder, address indexed account, uint256 value);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the amount of staking coin which are locked, vested and delegated-vesting of the vesting account.
+     * Returns zero values if the account is not a vesting account.
+     */
+    function vestingInfo(address account) external view returns (uint256 locked, uint256 vested, uint256 delegatedVesting);
+
+    /**
+     * @dev Create a continuous (or delayed if `delayed` is true) vesting account,
+     * funded with `value` amount of staking coin from the caller's account, vesting until `endTime` (unix timestamp in seconds).
+     * The account must have proven to be an external owned account (EOA) via `x/vauth` module.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {CreateVestingAccount} event.
+     */
+    function createVestingAccount(address account, uint256 value, uint64 endTime, bool delayed) external returns (bool);
+
+    /**
+     * @dev Create a periodic vesting account, funded with the total amount of staking coin of the periods from the caller's account,
+     * starting at `startTime` (unix timestamp in seconds), each period `length` is in seconds.
+     * The account must have proven to be an external owned account (EOA) via `x/vauth` module.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {CreateVestingAccount} event.
+     */
+    function createPeriodicVestingAccount(address account, uint64 startTime, VestingPeriod[] memory periods) external returns (bool);
+}
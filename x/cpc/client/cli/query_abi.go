@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"fmt"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryAbi is the CLI command for querying the ABI and supported methods of a custom precompiled contract
+func CmdQueryAbi() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "abi [address]",
+		Short:   "Querying the ABI, method selectors, read-only flags and required gas of a custom precompiled contract",
+		Example: "abi 0xcc01000000000000000000000000000000000001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid address: %s", args[0])
+			}
+
+			queryClient := cpctypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CustomPrecompiledContractAbi(cmd.Context(), &cpctypes.QueryCustomPrecompiledContractAbiRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
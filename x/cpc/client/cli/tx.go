@@ -22,6 +22,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetDeployTxCmd(),
+		NewCreateManagedErc20ContractTxCmd(),
+		NewUpdateCustomPrecompiledContractTxCmd(),
 	)
 
 	return cmd
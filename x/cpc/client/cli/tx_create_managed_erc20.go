@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateManagedErc20ContractTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-managed-erc20 [subdenom]",
+		Short: "Create a new factory denom and deploy the managed ERC20 contract for it, owned by the creator",
+		Long: `Create a new factory denom in format factory/{creator}/{subdenom} and deploy the managed ERC20 contract for it.
+The creator becomes the owner of the contract, who can mint new tokens, transfer the ownership and update the metadata via the ERC20 contract.`,
+		Example: fmt.Sprintf(
+			"$ %s %s tx create-managed-erc20 mytoken --%s My-Token --%s MTK --%s 18 --%s creator",
+			version.AppName, cpctypes.ModuleName,
+			flagErc20Name, flagErc20Symbol, flagErc20Decimals,
+			flags.FlagFrom,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+
+			if creator == "" {
+				return fmt.Errorf("flag --%s is required", flags.FlagFrom)
+			}
+
+			name, _ := cmd.Flags().GetString(flagErc20Name)
+			symbol, _ := cmd.Flags().GetString(flagErc20Symbol)
+			decimals, _ := cmd.Flags().GetInt64(flagErc20Decimals)
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &cpctypes.MsgCreateManagedErc20ContractRequest{
+				Creator:  creator,
+				Subdenom: args[0],
+				Name:     name,
+				Symbol:   symbol,
+				Decimals: uint32(decimals),
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	cmd.Flags().String(flagErc20Name, "", "Name of the ERC20 contract")
+	cmd.Flags().String(flagErc20Symbol, "", "Symbol of the ERC20 contract, also used as the display unit of the bank denom")
+	cmd.Flags().Int64(flagErc20Decimals, 0, "Decimals of the ERC20 contract")
+
+	return cmd
+}
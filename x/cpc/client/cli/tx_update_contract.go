@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagContractName      = "name"
+	flagContractTypedMeta = "typed-meta"
+	flagContractDisabled  = "disabled"
+)
+
+func NewUpdateCustomPrecompiledContractTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-contract [contract-address]",
+		Short: "Update name, typed metadata or disable/re-enable a custom precompiled contract, can only be done by the governance authority",
+		Long: `Update name, typed metadata or disable/re-enable a custom precompiled contract.
+Empty name or typed metadata means keeping the current value, the disabled flag is always applied.
+The authority is the governance module account, so the message is usually submitted via a governance proposal,
+use --generate-only to generate the message then put it into the proposal.`,
+		Example: fmt.Sprintf(
+			"$ %s %s tx update-contract 0xcc01000000000000000000000000000000000001 --%s=true --%s authority --generate-only",
+			version.AppName, cpctypes.ModuleName,
+			flagContractDisabled, flags.FlagFrom,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress().String()
+
+			if authority == "" {
+				return fmt.Errorf("flag --%s is required", flags.FlagFrom)
+			}
+
+			name, _ := cmd.Flags().GetString(flagContractName)
+			typedMeta, _ := cmd.Flags().GetString(flagContractTypedMeta)
+			disabled, _ := cmd.Flags().GetBool(flagContractDisabled)
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &cpctypes.MsgUpdateCustomPrecompiledContract{
+				Authority:       authority,
+				ContractAddress: args[0],
+				Name:            name,
+				TypedMeta:       typedMeta,
+				Disabled:        disabled,
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	cmd.Flags().String(flagContractName, "", "New name of the contract, keep the current name if empty")
+	cmd.Flags().String(flagContractTypedMeta, "", "New json-encoded typed metadata of the contract, keep the current metadata if empty")
+	cmd.Flags().Bool(flagContractDisabled, false, "Disable the contract, re-enable if false")
+
+	return cmd
+}
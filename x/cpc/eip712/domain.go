@@ -13,6 +13,9 @@ import (
 
 const PrimaryTypeNameEIP712Domain = "EIP712Domain"
 
+// TokenDomainVersion is the version of the EIP-2612 typed data domain of the ERC20 custom-precompiled-contracts.
+const TokenDomainVersion = "1"
+
 // GetDomain returns typed data domain for the given custom-precompiled-contract.
 func GetDomain(cpcAddr common.Address, chainId *big.Int) apitypes.TypedDataDomain {
 	return apitypes.TypedDataDomain{
@@ -34,3 +37,42 @@ func GetDomainTypes() []apitypes.Type {
 		{"salt", "string"},
 	}
 }
+
+// GetTokenDomain returns typed data domain for the given ERC20 custom-precompiled-contract,
+// following the standard token domain of EIP-2612: token name, version, chainId and verifyingContract.
+func GetTokenDomain(name string, cpcAddr common.Address, chainId *big.Int) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              name,
+		Version:           TokenDomainVersion,
+		ChainId:           (*cmath.HexOrDecimal256)(chainId),
+		VerifyingContract: cpcAddr.Hex(),
+	}
+}
+
+// GetTokenDomainTypes returns domain types for EIP712Domain of the ERC20 custom-precompiled-contracts.
+func GetTokenDomainTypes() []apitypes.Type {
+	return []apitypes.Type{
+		{"name", "string"},
+		{"version", "string"},
+		{"chainId", "uint256"},
+		{"verifyingContract", "address"},
+	}
+}
+
+// GetTokenDomainSeparator returns the EIP-2612 domain separator for the given ERC20 custom-precompiled-contract,
+// which is the hash of the typed data domain.
+func GetTokenDomainSeparator(name string, cpcAddr common.Address, chainId *big.Int) (common.Hash, error) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			PrimaryTypeNameEIP712Domain: GetTokenDomainTypes(),
+		},
+		Domain: GetTokenDomain(name, cpcAddr, chainId),
+	}
+
+	domainSeparator, err := typedData.HashStruct(PrimaryTypeNameEIP712Domain, typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(domainSeparator), nil
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/EscanBE/everlast/constants"
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
 	cmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 )
@@ -25,3 +27,50 @@ func TestGetDomain(t *testing.T) {
 		require.Equal(t, wantDomain, gotDomain)
 	})
 }
+
+func TestGetTokenDomain(t *testing.T) {
+	gotDomain := GetTokenDomain("Wrapped EVL", common.HexToAddress("0x1234567890123456789012345678901234567890"), big.NewInt(1))
+	wantDomain := apitypes.TypedDataDomain{
+		Name:              "Wrapped EVL",
+		Version:           "1",
+		ChainId:           (*cmath.HexOrDecimal256)(big.NewInt(1)),
+		VerifyingContract: "0x1234567890123456789012345678901234567890",
+	}
+	require.Equal(t, wantDomain, gotDomain)
+}
+
+func TestGetTokenDomainSeparator(t *testing.T) {
+	t.Run("same as the domain separator of OpenZeppelin ERC20Permit", func(t *testing.T) {
+		name := "Wrapped EVL"
+		contractAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+		chainId := big.NewInt(9000)
+
+		domainSeparator, err := GetTokenDomainSeparator(name, contractAddr, chainId)
+		require.NoError(t, err)
+
+		// keccak256(abi.encode(TYPE_HASH, keccak256(bytes(name)), keccak256(bytes(version)), chainId, address(this)))
+		typeHash := crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+		encoded := make([]byte, 0, 5*32)
+		encoded = append(encoded, typeHash...)
+		encoded = append(encoded, crypto.Keccak256([]byte(name))...)
+		encoded = append(encoded, crypto.Keccak256([]byte("1"))...)
+		encoded = append(encoded, common.BigToHash(chainId).Bytes()...)
+		encoded = append(encoded, common.BytesToHash(contractAddr.Bytes()).Bytes()...)
+		require.Equal(t, common.BytesToHash(crypto.Keccak256(encoded)), domainSeparator)
+	})
+
+	t.Run("token and chain specific", func(t *testing.T) {
+		contractAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+		domainSeparator, err := GetTokenDomainSeparator("Wrapped EVL", contractAddr, big.NewInt(1))
+		require.NoError(t, err)
+
+		otherTokenDomainSeparator, err := GetTokenDomainSeparator("Other Token", contractAddr, big.NewInt(1))
+		require.NoError(t, err)
+		require.NotEqual(t, domainSeparator, otherTokenDomainSeparator)
+
+		otherChainDomainSeparator, err := GetTokenDomainSeparator("Wrapped EVL", contractAddr, big.NewInt(2))
+		require.NoError(t, err)
+		require.NotEqual(t, domainSeparator, otherChainDomainSeparator)
+	})
+}
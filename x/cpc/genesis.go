@@ -10,6 +10,7 @@ import (
 	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // InitGenesis initializes genesis state based on exported genesis
@@ -23,24 +24,42 @@ func InitGenesis(
 		panic(err)
 	}
 
+	for _, contract := range data.DeployedContracts {
+		if err := k.ImportCustomPrecompiledContract(ctx, contract); err != nil {
+			panic(fmt.Errorf("error importing Custom Precompiled Contract %s: %s", common.BytesToAddress(contract.Address), err))
+		}
+	}
+
+	for _, allowance := range data.Erc20Allowances {
+		k.SetErc20CpcAllowance(ctx, common.HexToAddress(allowance.ContractAddress), common.HexToAddress(allowance.Owner), common.HexToAddress(allowance.Spender), allowance.Amount.BigInt())
+	}
+
+	for _, permitNonce := range data.Erc20PermitNonces {
+		k.SetErc20CpcPermitNonce(ctx, common.HexToAddress(permitNonce.ContractAddress), common.HexToAddress(permitNonce.Owner), permitNonce.Nonce)
+	}
+
+	// the contracts below are only deployed if not restored from the deployed contracts
+
 	if data.DeployErc20Native {
 		stakingParams, err := stakingKeeper.GetParams(ctx)
 		if err != nil {
 			panic(err)
 		}
 
-		meta := cpctypes.Erc20CustomPrecompiledContractMeta{
-			Symbol:   fmt.Sprintf("W%s", strings.ToUpper(constants.SymbolDenom)),
-			Decimals: constants.BaseDenomExponent,
-			MinDenom: stakingParams.BondDenom,
-		}
-		_, err = k.DeployErc20CustomPrecompiledContract(ctx, fmt.Sprintf("Wrapped %s", strings.ToUpper(constants.SymbolDenom)), meta)
-		if err != nil {
-			panic(fmt.Errorf("error deploying ERC-20 Custom Precompiled Contract for %s: %s", meta.MinDenom, err))
+		if k.GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, stakingParams.BondDenom) == nil {
+			meta := cpctypes.Erc20CustomPrecompiledContractMeta{
+				Symbol:   fmt.Sprintf("W%s", strings.ToUpper(constants.SymbolDenom)),
+				Decimals: constants.BaseDenomExponent,
+				MinDenom: stakingParams.BondDenom,
+			}
+			_, err = k.DeployErc20CustomPrecompiledContract(ctx, fmt.Sprintf("Wrapped %s", strings.ToUpper(constants.SymbolDenom)), meta)
+			if err != nil {
+				panic(fmt.Errorf("error deploying ERC-20 Custom Precompiled Contract for %s: %s", meta.MinDenom, err))
+			}
 		}
 	}
 
-	if data.DeployStakingContract {
+	if data.DeployStakingContract && !k.HasCustomPrecompiledContract(ctx, cpctypes.CpcStakingFixedAddress) {
 		meta := cpctypes.StakingCustomPrecompiledContractMeta{
 			Symbol:   fmt.Sprintf("Staking-%s", strings.ToUpper(constants.SymbolDenom)),
 			Decimals: constants.BaseDenomExponent,
@@ -51,11 +70,9 @@ func InitGenesis(
 		}
 	}
 
-	{ // always deploy Bech32 Custom Precompiled Contract
-		_, err := k.DeployBech32CustomPrecompiledContract(ctx)
-		if err != nil {
-			panic(fmt.Errorf("error deploying Bech32 Custom Precompiled Contract: %s", err))
-		}
+	// always deploy the singleton contracts which are supported by the protocol version
+	if err := k.DeployMissingSingletonCustomPrecompiledContracts(ctx); err != nil {
+		panic(err)
 	}
 }
 
@@ -65,5 +82,8 @@ func ExportGenesis(ctx sdk.Context, k cpckeeper.Keeper) cpctypes.GenesisState {
 		Params:                k.GetParams(ctx),
 		DeployErc20Native:     false,
 		DeployStakingContract: k.HasCustomPrecompiledContract(ctx, cpctypes.CpcStakingFixedAddress),
+		DeployedContracts:     k.GetAllCustomPrecompiledContractsMeta(ctx),
+		Erc20Allowances:       k.GetAllErc20CpcAllowances(ctx),
+		Erc20PermitNonces:     k.GetAllErc20CpcPermitNonces(ctx),
 	}
 }
@@ -0,0 +1,42 @@
+package cpc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/EscanBE/everlast/ibc"
+	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the transfer middleware given
+// the cpc keeper and the underlying application.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper cpckeeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k cpckeeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It calls the underlying app's OnRecvPacket callback, then deploys the ERC20 custom precompiled contract
+// for the received IBC voucher denom if needed.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
+
+	return im.keeper.OnRecvPacket(ctx, packet, ack)
+}
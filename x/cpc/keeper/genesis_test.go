@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/EscanBE/everlast/x/cpc"
+	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+)
+
+func (suite *CpcTestSuite) TestGenesis_ExportImport() {
+	suite.SetupStakingCPC()
+
+	ctx := suite.Ctx()
+	keeper := *suite.App().CpcKeeper()
+
+	creator := suite.CITS.WalletAccounts.Number(1)
+	spender := suite.CITS.WalletAccounts.Number(2)
+
+	denom, erc20Addr, err := keeper.CreateManagedErc20CustomPrecompiledContract(ctx, creator.GetCosmosAddress(), "gen", "Genesis Token", "GEN", 6)
+	suite.Require().NoError(err)
+
+	_, otherErc20Addr, err := keeper.CreateManagedErc20CustomPrecompiledContract(ctx, creator.GetCosmosAddress(), "gen2", "Genesis Token 2", "GEN2", 6)
+	suite.Require().NoError(err)
+
+	keeper.SetErc20CpcAllowance(ctx, erc20Addr, creator.GetEthAddress(), spender.GetEthAddress(), big.NewInt(1000))
+	keeper.SetErc20CpcAllowance(ctx, otherErc20Addr, creator.GetEthAddress(), spender.GetEthAddress(), big.NewInt(2000))
+	keeper.IncreaseErc20CpcPermitNonce(ctx, erc20Addr, creator.GetEthAddress())
+	keeper.IncreaseErc20CpcPermitNonce(ctx, erc20Addr, creator.GetEthAddress())
+
+	suite.Require().NoError(keeper.UpdateCustomPrecompiledContractMeta(ctx, cpctypes.CpcBech32FixedAddress, "", "", true))
+
+	exported := cpc.ExportGenesis(ctx, keeper)
+	suite.Require().NoError(exported.Validate())
+	suite.Len(exported.DeployedContracts, len(keeper.GetAllCustomPrecompiledContractsMeta(ctx)))
+	suite.Require().Len(exported.Erc20Allowances, 2)
+	exportedAllowances := make(map[string]sdkmath.Int)
+	for _, allowance := range exported.Erc20Allowances {
+		suite.Equal(creator.GetEthAddress().Hex(), allowance.Owner)
+		suite.Equal(spender.GetEthAddress().Hex(), allowance.Spender)
+		exportedAllowances[allowance.ContractAddress] = allowance.Amount
+	}
+	suite.Equal(map[string]sdkmath.Int{
+		erc20Addr.Hex():      sdkmath.NewInt(1000),
+		otherErc20Addr.Hex(): sdkmath.NewInt(2000),
+	}, exportedAllowances, "allowances must be exported per contract")
+	suite.Require().Len(exported.Erc20PermitNonces, 1)
+	suite.Equal(erc20Addr.Hex(), exported.Erc20PermitNonces[0].ContractAddress)
+	suite.Equal(uint64(2), exported.Erc20PermitNonces[0].Nonce)
+
+	// import into a fresh store
+	freshCtx, freshKeeper, _ := suite.newFreshCpcKeeper()
+
+	cpc.InitGenesis(freshCtx, freshKeeper, *suite.App().StakingKeeper(), exported)
+
+	reExported := cpc.ExportGenesis(freshCtx, freshKeeper)
+	suite.Equal(exported, reExported)
+
+	gotErc20Addr := freshKeeper.GetErc20CustomPrecompiledContractAddressByMinDenom(freshCtx, denom)
+	suite.Require().NotNil(gotErc20Addr)
+	suite.Equal(erc20Addr, *gotErc20Addr)
+	suite.Equal(int64(1000), freshKeeper.GetErc20CpcAllowance(freshCtx, erc20Addr, creator.GetEthAddress(), spender.GetEthAddress()).Int64())
+	suite.Equal(int64(2000), freshKeeper.GetErc20CpcAllowance(freshCtx, otherErc20Addr, creator.GetEthAddress(), spender.GetEthAddress()).Int64())
+	suite.Equal(uint64(2), freshKeeper.GetErc20CpcPermitNonce(freshCtx, erc20Addr, creator.GetEthAddress()))
+
+	bech32Meta := freshKeeper.GetCustomPrecompiledContractMeta(freshCtx, cpctypes.CpcBech32FixedAddress)
+	suite.Require().NotNil(bech32Meta)
+	suite.True(bech32Meta.Disabled, "disabled flag must be preserved")
+}
+
+// newFreshCpcKeeper returns a cpc keeper backed by an empty store, with no params set.
+func (suite *CpcTestSuite) newFreshCpcKeeper() (sdk.Context, cpckeeper.Keeper, storetypes.StoreKey) {
+	storeKey := storetypes.NewKVStoreKey(cpctypes.StoreKey)
+	freshCtx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	freshKeeper := cpckeeper.NewKeeper(
+		codec.NewProtoCodec(suite.App().InterfaceRegistry()),
+		storeKey,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		authkeeper.AccountKeeper{},
+		nil,
+		stakingkeeper.Keeper{},
+		distkeeper.Keeper{},
+		slashingkeeper.Keeper{},
+		authzkeeper.Keeper{},
+		nil,
+		ibctransferkeeper.Keeper{},
+		nil,
+		nil,
+	)
+
+	return freshCtx, freshKeeper, storeKey
+}
+
+func (suite *CpcTestSuite) TestGenesis_InitGenesisRejectsConflictingErc20() {
+	suite.SetupStakingCPC()
+
+	ctx := suite.Ctx()
+	keeper := *suite.App().CpcKeeper()
+
+	creator := suite.CITS.WalletAccounts.Number(1)
+	_, _, err := keeper.CreateManagedErc20CustomPrecompiledContract(ctx, creator.GetCosmosAddress(), "gen", "Genesis Token", "GEN", 6)
+	suite.Require().NoError(err)
+
+	exported := cpc.ExportGenesis(ctx, keeper)
+
+	// importing into a store that already has the contracts must fail
+	suite.Require().Panics(func() {
+		cpc.InitGenesis(ctx, keeper, *suite.App().StakingKeeper(), exported)
+	})
+}
@@ -7,9 +7,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/EscanBE/everlast/x/cpc/abi"
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 )
 
@@ -72,6 +74,50 @@ func (k queryServer) CustomPrecompiledContract(goCtx context.Context, req *cpcty
 	}, nil
 }
 
+// CustomPrecompiledContractAbi implements the Query/CustomPrecompiledContractAbi gRPC method
+func (k queryServer) CustomPrecompiledContractAbi(goCtx context.Context, req *cpctypes.QueryCustomPrecompiledContractAbiRequest) (*cpctypes.QueryCustomPrecompiledContractAbiResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	meta := k.GetCustomPrecompiledContractMeta(ctx, common.HexToAddress(req.Address))
+	if meta == nil {
+		return nil, status.Error(codes.NotFound, "contract not found")
+	}
+
+	cpcInfo, abiJson, found := abi.GetCustomPrecompiledContractInfoByType(meta.CustomPrecompiledType)
+	if !found {
+		return nil, status.Errorf(codes.Internal, "ABI not found for custom precompiled type %d", meta.CustomPrecompiledType)
+	}
+
+	params := k.GetParams(ctx)
+
+	var methods []cpctypes.CustomPrecompiledContractMethod
+	for _, executor := range NewCustomPrecompiledContract(*meta, k.Keeper).GetMethodExecutors() {
+		method4BytesSignature := executor.Method4BytesSignatures()
+
+		var signature string
+		if method, err := cpcInfo.ABI.MethodById(method4BytesSignature); err == nil {
+			signature = method.Sig
+		}
+
+		methods = append(methods, cpctypes.CustomPrecompiledContractMethod{
+			Selector:    hexutil.Encode(method4BytesSignature),
+			Signature:   signature,
+			ReadOnly:    executor.ReadOnly(),
+			RequiredGas: getMethodRequireGas(executor, params.GetMethodGas(meta.CustomPrecompiledType, method4BytesSignature)),
+		})
+	}
+
+	return &cpctypes.QueryCustomPrecompiledContractAbiResponse{
+		Contract: cpctypes.WrapCustomPrecompiledContractMeta(*meta),
+		Abi:      string(abiJson),
+		Methods:  methods,
+	}, nil
+}
+
 func (k queryServer) Erc20CustomPrecompiledContractByDenom(goCtx context.Context, req *cpctypes.QueryErc20CustomPrecompiledContractByDenomRequest) (*cpctypes.QueryErc20CustomPrecompiledContractByDenomResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
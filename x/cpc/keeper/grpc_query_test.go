@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+)
+
+func (suite *CpcTestSuite) TestQueryServer_CustomPrecompiledContractAbi() {
+	queryServer := cpckeeper.NewQueryServerImpl(*suite.App().CpcKeeper())
+
+	suite.Run("pass - query ABI of bank contract", func() {
+		res, err := queryServer.CustomPrecompiledContractAbi(suite.Ctx(), &cpctypes.QueryCustomPrecompiledContractAbiRequest{
+			Address: cpctypes.CpcBankFixedAddress.String(),
+		})
+		suite.Require().NoError(err)
+
+		suite.Equal("Bank", res.Contract.TypeName)
+
+		var abiEntries []map[string]any
+		suite.Require().NoError(json.Unmarshal([]byte(res.Abi), &abiEntries))
+		suite.NotEmpty(abiEntries)
+
+		methodsBySelector := make(map[string]cpctypes.CustomPrecompiledContractMethod)
+		for _, method := range res.Methods {
+			methodsBySelector[method.Selector] = method
+		}
+		suite.Len(methodsBySelector, 6)
+
+		balanceOf, found := methodsBySelector["0xb9b092c8"]
+		suite.Require().True(found)
+		suite.Equal("balanceOf(address,string)", balanceOf.Signature)
+		suite.True(balanceOf.ReadOnly)
+
+		multiSend, found := methodsBySelector["0x0470b47e"]
+		suite.Require().True(found)
+		suite.Equal("multiSend(address[],string[],uint256[])", multiSend.Signature)
+		suite.False(multiSend.ReadOnly)
+		suite.Equal(uint64(15_000), multiSend.RequiredGas)
+	})
+
+	suite.Run("pass - required gas follows the gas schedule", func() {
+		params := suite.App().CpcKeeper().GetParams(suite.Ctx())
+		params.GasSchedule = []cpctypes.CustomPrecompiledContractMethodGas{
+			{
+				CustomPrecompiledType: cpctypes.CpcTypeBank,
+				MethodSelector:        "0x0470b47e",
+				BaseGas:               50_000,
+			},
+		}
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(suite.Ctx(), params))
+
+		res, err := queryServer.CustomPrecompiledContractAbi(suite.Ctx(), &cpctypes.QueryCustomPrecompiledContractAbiRequest{
+			Address: cpctypes.CpcBankFixedAddress.String(),
+		})
+		suite.Require().NoError(err)
+
+		var found bool
+		for _, method := range res.Methods {
+			if method.Selector == "0x0470b47e" {
+				found = true
+				suite.Equal(uint64(50_000), method.RequiredGas)
+			}
+		}
+		suite.True(found)
+	})
+
+	suite.Run("fail - contract not found", func() {
+		_, err := queryServer.CustomPrecompiledContractAbi(suite.Ctx(), &cpctypes.QueryCustomPrecompiledContractAbiRequest{
+			Address: "0x0000000000000000000000000000000000000001",
+		})
+		suite.Require().ErrorContains(err, "contract not found")
+	})
+
+	suite.Run("fail - empty request", func() {
+		_, err := queryServer.CustomPrecompiledContractAbi(suite.Ctx(), nil)
+		suite.Require().Error(err)
+	})
+}
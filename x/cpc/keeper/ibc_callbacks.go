@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/EscanBE/everlast/ibc"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+)
+
+// OnRecvPacket deploys the ERC20 custom precompiled contract for the received IBC voucher denom,
+// if enabled by the module params and the denom has bank metadata but does not have any ERC20 contract yet.
+// Failure of the deployment is logged and does not affect the acknowledgement.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	params := k.GetParams(ctx)
+	if !params.AutoDeployErc20ForIbcDenoms {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not ICS-20 packet
+		return ack
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	if !strings.HasPrefix(coin.Denom, transfertypes.DenomPrefix+"/") {
+		// native coin returned back to this chain
+		return ack
+	}
+
+	if k.GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, coin.Denom) != nil {
+		return ack
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom)
+	if !found {
+		return ack
+	}
+
+	name, erc20Meta := erc20MetaFromBankMetadata(metadata)
+	// the bank metadata set by ibc-go does not carry the decimals of the source chain, governance can provide it
+	if ibcDenom := params.GetAutoDeployErc20IbcDenom(coin.Denom); ibcDenom != nil {
+		erc20Meta.Decimals = uint8(ibcDenom.Decimals)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	contractAddr, err := k.DeployErc20CustomPrecompiledContract(cacheCtx, name, erc20Meta)
+	if err != nil {
+		k.Logger(ctx).Error("failed to deploy ERC20 custom precompiled contract for IBC denom", "denom", coin.Denom, "error", err)
+		return ack
+	}
+	writeCache()
+
+	k.Logger(ctx).Info("deployed ERC20 custom precompiled contract for IBC denom", "denom", coin.Denom, "contract", contractAddr.Hex())
+
+	return ack
+}
+
+// erc20MetaFromBankMetadata builds the name and metadata for the ERC20 custom precompiled contract
+// of the given bank denom metadata, the decimals is the exponent of the display unit, or zero if not found.
+func erc20MetaFromBankMetadata(metadata banktypes.Metadata) (name string, erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta) {
+	var decimals uint32
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit != nil && denomUnit.Denom == metadata.Display {
+			decimals = denomUnit.Exponent
+			break
+		}
+	}
+
+	name = metadata.Name
+	if name == "" {
+		name = metadata.Base
+	}
+
+	erc20Meta = cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   metadata.Symbol,
+		Decimals: uint8(min(decimals, 255)),
+		MinDenom: metadata.Base,
+	}
+
+	return
+}
@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+func (suite *CpcTestSuite) TestKeeper_OnRecvPacket() {
+	const (
+		sourceChannel      = "channel-0"
+		destinationChannel = "channel-1"
+	)
+
+	receiver := suite.CITS.WalletAccounts.Number(1)
+
+	voucherDenomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, destinationChannel, "uatom"))
+	voucherDenom := voucherDenomTrace.IBCDenom()
+
+	buildPacket := func(denom string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "1000", "sender", receiver.GetCosmosAddress().String(), "")
+		return channeltypes.NewPacket(
+			data.GetBytes(),
+			1,
+			transfertypes.PortID, sourceChannel,
+			transfertypes.PortID, destinationChannel,
+			clienttypes.NewHeight(1, 100), 0,
+		)
+	}
+
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	setParamsWithIbcDenoms := func(ctx sdk.Context, enabled bool, ibcDenoms ...cpctypes.AutoDeployErc20IbcDenom) {
+		params := suite.App().CpcKeeper().GetParams(ctx)
+		params.AutoDeployErc20ForIbcDenoms = enabled
+		params.AutoDeployErc20IbcDenoms = ibcDenoms
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+	}
+
+	setParams := func(ctx sdk.Context, enabled bool) {
+		setParamsWithIbcDenoms(ctx, enabled, cpctypes.AutoDeployErc20IbcDenom{
+			FullDenomPath: voucherDenomTrace.GetFullDenomPath(),
+			Decimals:      6,
+		})
+	}
+
+	setBankMetadata := func(ctx sdk.Context) {
+		suite.App().BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+			Description: "IBC token",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: voucherDenom, Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+			Base:    voucherDenom,
+			Display: "atom",
+			Name:    "Cosmos Hub Atom",
+			Symbol:  "ATOM",
+		})
+	}
+
+	// same as the bank metadata set by the ibc-go transfer module when receiving a voucher for the first time
+	setIbcGoBankMetadata := func(ctx sdk.Context) {
+		suite.App().BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+			Description: "IBC token from " + voucherDenomTrace.GetFullDenomPath(),
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: voucherDenomTrace.BaseDenom, Exponent: 0},
+			},
+			Base:    voucherDenom,
+			Display: voucherDenomTrace.GetFullDenomPath(),
+			Name:    voucherDenomTrace.GetFullDenomPath() + " IBC token",
+			Symbol:  strings.ToUpper(voucherDenomTrace.BaseDenom),
+		})
+	}
+
+	mintVoucher := func() {
+		suite.CITS.MintCoin(receiver, sdk.NewCoin(voucherDenom, sdkmath.NewInt(1000)))
+	}
+
+	tests := []struct {
+		name       string
+		setup      func(ctx sdk.Context)
+		packet     channeltypes.Packet
+		ack        exported.Acknowledgement
+		wantDeploy bool
+		wantName   string
+		wantMeta   string
+	}{
+		{
+			name: "pass - deploy for IBC voucher denom with bank metadata",
+			setup: func(ctx sdk.Context) {
+				setParams(ctx, true)
+				setBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: true,
+			wantName:   "Cosmos Hub Atom",
+			wantMeta:   `{"symbol":"ATOM","decimals":6,"min_denom":"` + voucherDenom + `"}`,
+		},
+		{
+			name: "pass - deploy for IBC voucher denom with bank metadata set by ibc-go, decimals from the params",
+			setup: func(ctx sdk.Context) {
+				setParamsWithIbcDenoms(ctx, true, cpctypes.AutoDeployErc20IbcDenom{
+					FullDenomPath: voucherDenomTrace.GetFullDenomPath(),
+					Decimals:      18,
+				})
+				setIbcGoBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: true,
+			wantName:   voucherDenomTrace.GetFullDenomPath() + " IBC token",
+			wantMeta:   `{"symbol":"UATOM","decimals":18,"min_denom":"` + voucherDenom + `"}`,
+		},
+		{
+			name: "pass - deploy for IBC voucher denom with bank metadata set by ibc-go, zero decimals when not listed",
+			setup: func(ctx sdk.Context) {
+				setParamsWithIbcDenoms(ctx, true, cpctypes.AutoDeployErc20IbcDenom{
+					FullDenomPath: transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-2", "uatom"),
+					Decimals:      6,
+				})
+				setIbcGoBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: true,
+			wantName:   voucherDenomTrace.GetFullDenomPath() + " IBC token",
+			wantMeta:   `{"symbol":"UATOM","decimals":0,"min_denom":"` + voucherDenom + `"}`,
+		},
+		{
+			name: "pass - deploy for IBC voucher denom with bank metadata, decimals from the display unit when not listed",
+			setup: func(ctx sdk.Context) {
+				setParamsWithIbcDenoms(ctx, true)
+				setBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: true,
+			wantName:   "Cosmos Hub Atom",
+			wantMeta:   `{"symbol":"ATOM","decimals":6,"min_denom":"` + voucherDenom + `"}`,
+		},
+		{
+			name: "pass - deploy for IBC voucher denom, zero decimals listed overrides the display unit",
+			setup: func(ctx sdk.Context) {
+				setParamsWithIbcDenoms(ctx, true, cpctypes.AutoDeployErc20IbcDenom{
+					FullDenomPath: voucherDenomTrace.GetFullDenomPath(),
+					Decimals:      0,
+				})
+				setBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: true,
+			wantName:   "Cosmos Hub Atom",
+			wantMeta:   `{"symbol":"ATOM","decimals":0,"min_denom":"` + voucherDenom + `"}`,
+		},
+		{
+			name: "pass - not deploy when disabled by params",
+			setup: func(ctx sdk.Context) {
+				setParams(ctx, false)
+				setBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: false,
+		},
+		{
+			name: "pass - not deploy when missing bank metadata",
+			setup: func(ctx sdk.Context) {
+				setParams(ctx, true)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: false,
+		},
+		{
+			name: "pass - not deploy when error acknowledgement",
+			setup: func(ctx sdk.Context) {
+				setParams(ctx, true)
+				setBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket("uatom"),
+			ack:        channeltypes.NewErrorAcknowledgement(transfertypes.ErrInvalidAmount),
+			wantDeploy: false,
+		},
+		{
+			name: "pass - not deploy for native coin returned back",
+			setup: func(ctx sdk.Context) {
+				setParams(ctx, true)
+				setBankMetadata(ctx)
+				mintVoucher()
+			},
+			packet:     buildPacket(transfertypes.GetPrefixedDenom(transfertypes.PortID, sourceChannel, suite.CITS.ChainConstantsConfig.GetMinDenom())),
+			ack:        successAck,
+			wantDeploy: false,
+		},
+		{
+			name: "pass - not deploy when failed to deploy, ack is not affected",
+			setup: func(ctx sdk.Context) {
+				setParams(ctx, true)
+				setBankMetadata(ctx)
+				// zero supply
+			},
+			packet:     buildPacket("uatom"),
+			ack:        successAck,
+			wantDeploy: false,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.Ctx()
+			tt.setup(ctx)
+
+			gotAck := suite.App().CpcKeeper().OnRecvPacket(ctx, tt.packet, tt.ack)
+			suite.Equal(tt.ack, gotAck)
+
+			contractAddr := suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, voucherDenom)
+			if !tt.wantDeploy {
+				suite.Nil(contractAddr)
+				return
+			}
+
+			suite.Require().NotNil(contractAddr)
+
+			meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, *contractAddr)
+			suite.Require().NotNil(meta)
+			suite.Equal(cpctypes.CpcTypeErc20, meta.CustomPrecompiledType)
+			suite.Equal(tt.wantName, meta.Name)
+			suite.JSONEq(tt.wantMeta, meta.TypedMeta)
+
+			// receive again must not re-deploy
+			gotAck = suite.App().CpcKeeper().OnRecvPacket(ctx, tt.packet, tt.ack)
+			suite.Equal(tt.ack, gotAck)
+			suite.Equal(*contractAddr, *suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, voucherDenom))
+		})
+	}
+}
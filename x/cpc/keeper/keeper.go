@@ -3,8 +3,12 @@ package keeper
 import (
 	"fmt"
 
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 
@@ -19,33 +23,60 @@ import (
 
 // Keeper of the CPC store
 type Keeper struct {
-	cdc           codec.BinaryCodec
-	storeKey      storetypes.StoreKey
-	authority     sdk.AccAddress
-	accountKeeper authkeeper.AccountKeeper
-	bankKeeper    bankkeeper.Keeper
-	stakingKeeper stakingkeeper.Keeper
-	distKeeper    distkeeper.Keeper
+	cdc            codec.Codec
+	storeKey       storetypes.StoreKey
+	authority      sdk.AccAddress
+	accountKeeper  authkeeper.AccountKeeper
+	bankKeeper     bankkeeper.Keeper
+	stakingKeeper  stakingkeeper.Keeper
+	distKeeper     distkeeper.Keeper
+	slashingKeeper slashingkeeper.Keeper
+	authzKeeper    authzkeeper.Keeper
+	govKeeper      *govkeeper.Keeper
+	transferKeeper ibctransferkeeper.Keeper
+	vAuthKeeper    cpctypes.VAuthKeeper
+
+	// authzDisabledGrantMsgs is the list of messages that are not allowed to be granted via the authz custom precompiled contract,
+	// same as the disabled nested messages enforced by the Cosmos-lane ante handler.
+	authzDisabledGrantMsgs map[string]struct{}
 }
 
 // NewKeeper returns a new instance of the CPC keeper
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	key storetypes.StoreKey,
 	authority sdk.AccAddress,
 	ak authkeeper.AccountKeeper,
 	bk bankkeeper.Keeper,
 	sk stakingkeeper.Keeper,
 	dk distkeeper.Keeper,
+	slk slashingkeeper.Keeper,
+	azk authzkeeper.Keeper,
+	gk *govkeeper.Keeper,
+	tk ibctransferkeeper.Keeper,
+	vak cpctypes.VAuthKeeper,
+	disabledNestedMsgs []string,
 ) Keeper {
+	authzDisabledGrantMsgs := make(map[string]struct{}, len(disabledNestedMsgs))
+	for _, msgTypeURL := range disabledNestedMsgs {
+		authzDisabledGrantMsgs[msgTypeURL] = struct{}{}
+	}
+
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		authority:     authority,
-		accountKeeper: ak,
-		bankKeeper:    bk,
-		stakingKeeper: sk,
-		distKeeper:    dk,
+		cdc:            cdc,
+		storeKey:       key,
+		authority:      authority,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		stakingKeeper:  sk,
+		distKeeper:     dk,
+		slashingKeeper: slk,
+		authzKeeper:    azk,
+		govKeeper:      gk,
+		transferKeeper: tk,
+		vAuthKeeper:    vak,
+
+		authzDisabledGrantMsgs: authzDisabledGrantMsgs,
 	}
 }
 
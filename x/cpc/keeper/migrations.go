@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// It deploys the singleton custom precompiled contracts which were introduced after the chain started,
+// since those were only deployed at genesis, and prunes the legacy ERC20 allowances.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.pruneLegacyErc20Allowances(ctx)
+
+	return m.keeper.DeployMissingSingletonCustomPrecompiledContracts(ctx)
+}
+
+// pruneLegacyErc20Allowances deletes the ERC20 allowances which were keyed by (owner, spender) only.
+// Those were shared among all the ERC20 custom precompiled contracts, so the contract they were granted on
+// is unknown, and copying them to every contract would keep spenders able to spend any token.
+// Owners must approve again, the allowances are now keyed by (contract, owner, spender).
+func (m Migrator) pruneLegacyErc20Allowances(ctx sdk.Context) {
+	store := ctx.KVStore(m.keeper.storeKey)
+	legacyKeyLength := len(cpctypes.KeyPrefixErc20CpcAllowance) + 2*common.AddressLength
+
+	var legacyKeys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, cpctypes.KeyPrefixErc20CpcAllowance)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) == legacyKeyLength {
+			legacyKeys = append(legacyKeys, iterator.Key())
+		}
+	}
+	_ = iterator.Close()
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+
+	if len(legacyKeys) > 0 {
+		m.keeper.Logger(ctx).Info("pruned legacy ERC20 allowances", "count", len(legacyKeys))
+	}
+}
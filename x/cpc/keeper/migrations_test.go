@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+)
+
+func (suite *CpcTestSuite) TestMigrator_Migrate1to2() {
+	suite.Run("deploy missing singletons", func() {
+		ctx, keeper, _ := suite.newFreshCpcKeeper()
+
+		params := cpctypes.DefaultParams()
+		params.ProtocolVersion = uint32(cpctypes.ProtocolCpcV2)
+		suite.Require().NoError(keeper.SetParams(ctx, params))
+
+		// only the Bech32 contract existed before the migration
+		_, err := keeper.DeployBech32CustomPrecompiledContract(ctx)
+		suite.Require().NoError(err)
+		suite.Require().NoError(keeper.UpdateCustomPrecompiledContractMeta(ctx, cpctypes.CpcBech32FixedAddress, "", "", true))
+
+		err = cpckeeper.NewMigrator(keeper).Migrate1to2(ctx)
+		suite.Require().NoError(err)
+
+		for _, contractAddress := range suite.getGenesisDeployedCPCs(suite.Ctx()) {
+			suite.Truef(keeper.HasCustomPrecompiledContract(ctx, contractAddress), "contract %s should be deployed", contractAddress)
+		}
+		suite.False(keeper.HasCustomPrecompiledContract(ctx, cpctypes.CpcStakingFixedAddress), "staking contract is not a singleton")
+
+		bech32Meta := keeper.GetCustomPrecompiledContractMeta(ctx, cpctypes.CpcBech32FixedAddress)
+		suite.Require().NotNil(bech32Meta)
+		suite.True(bech32Meta.Disabled, "existing contract must not be re-deployed")
+
+		// migration is idempotent
+		suite.Require().NoError(cpckeeper.NewMigrator(keeper).Migrate1to2(ctx))
+	})
+
+	suite.Run("only deploy singletons supported by the protocol version", func() {
+		ctx, keeper, _ := suite.newFreshCpcKeeper()
+
+		params := cpctypes.DefaultParams()
+		params.ProtocolVersion = uint32(cpctypes.ProtocolCpcV1)
+		suite.Require().NoError(keeper.SetParams(ctx, params))
+
+		err := cpckeeper.NewMigrator(keeper).Migrate1to2(ctx)
+		suite.Require().NoError(err)
+
+		suite.True(keeper.HasCustomPrecompiledContract(ctx, cpctypes.CpcBech32FixedAddress))
+		for _, contractAddress := range []common.Address{
+			cpctypes.CpcGovFixedAddress,
+			cpctypes.CpcDistributionFixedAddress,
+			cpctypes.CpcIbcTransferFixedAddress,
+			cpctypes.CpcMulticallFixedAddress,
+			cpctypes.CpcSlashingFixedAddress,
+			cpctypes.CpcAuthzFixedAddress,
+			cpctypes.CpcVestingFixedAddress,
+			cpctypes.CpcBankFixedAddress,
+		} {
+			suite.Falsef(keeper.HasCustomPrecompiledContract(ctx, contractAddress), "contract %s is not supported by protocol v1", contractAddress)
+		}
+
+		// raising the protocol version via governance deploys the rest
+		params.ProtocolVersion = uint32(cpctypes.ProtocolCpcV2)
+		_, err = cpckeeper.NewMsgServerImpl(keeper).UpdateParams(ctx, &cpctypes.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			NewParams: params,
+		})
+		suite.Require().NoError(err)
+
+		for _, contractAddress := range suite.getGenesisDeployedCPCs(suite.Ctx()) {
+			suite.Truef(keeper.HasCustomPrecompiledContract(ctx, contractAddress), "contract %s should be deployed", contractAddress)
+		}
+	})
+
+	suite.Run("prune legacy ERC20 allowances", func() {
+		ctx, keeper, storeKey := suite.newFreshCpcKeeper()
+		suite.Require().NoError(keeper.SetParams(ctx, cpctypes.DefaultParams()))
+
+		contractAddr := common.BytesToAddress([]byte("contract"))
+		owner := common.BytesToAddress([]byte("owner"))
+		spender := common.BytesToAddress([]byte("spender"))
+
+		// allowance keyed by (owner, spender) only, before the migration
+		legacyKey := append(append(append([]byte{}, cpctypes.KeyPrefixErc20CpcAllowance...), owner.Bytes()...), spender.Bytes()...)
+		ctx.KVStore(storeKey).Set(legacyKey, big.NewInt(1000).Bytes())
+
+		keeper.SetErc20CpcAllowance(ctx, contractAddr, owner, spender, big.NewInt(500))
+
+		// legacy allowance is not exported as if it were keyed by contract
+		allowances := keeper.GetAllErc20CpcAllowances(ctx)
+		suite.Require().Len(allowances, 1)
+		suite.Equal(contractAddr.Hex(), allowances[0].ContractAddress)
+		suite.Equal(owner.Hex(), allowances[0].Owner)
+		suite.Equal(spender.Hex(), allowances[0].Spender)
+
+		err := cpckeeper.NewMigrator(keeper).Migrate1to2(ctx)
+		suite.Require().NoError(err)
+
+		suite.False(ctx.KVStore(storeKey).Has(legacyKey), "legacy allowance must be pruned")
+		suite.Equal("500", keeper.GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String(), "allowance keyed by contract must be kept")
+		suite.Len(keeper.GetAllErc20CpcAllowances(ctx), 1)
+	})
+}
@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	errorsmod "cosmossdk.io/errors"
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var _ cpctypes.MsgServer = &msgServer{}
@@ -23,7 +26,8 @@ func NewMsgServerImpl(keeper Keeper) cpctypes.MsgServer {
 
 // UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
 // it updates the parameters in the keeper only if the requested authority
-// is the Cosmos SDK governance module account
+// is the Cosmos SDK governance module account.
+// Renaming an ERC20 contract changes its EIP-2612 domain separator, so the permit signatures made before become invalid.
 func (k *msgServer) UpdateParams(goCtx context.Context, req *cpctypes.MsgUpdateParams) (*cpctypes.MsgUpdateParamsResponse, error) {
 	if k.authority.String() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
@@ -38,9 +42,34 @@ func (k *msgServer) UpdateParams(goCtx context.Context, req *cpctypes.MsgUpdateP
 		return nil, err
 	}
 
+	// the singleton contracts introduced by the new protocol version are deployed once it is enabled
+	if err := k.DeployMissingSingletonCustomPrecompiledContracts(ctx); err != nil {
+		return nil, err
+	}
+
 	return &cpctypes.MsgUpdateParamsResponse{}, nil
 }
 
+// UpdateCustomPrecompiledContract implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the custom precompiled contract only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *msgServer) UpdateCustomPrecompiledContract(goCtx context.Context, req *cpctypes.MsgUpdateCustomPrecompiledContract) (*cpctypes.MsgUpdateCustomPrecompiledContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.UpdateCustomPrecompiledContractMeta(ctx, common.HexToAddress(req.ContractAddress), req.Name, req.TypedMeta, req.Disabled); err != nil {
+		return nil, err
+	}
+
+	return &cpctypes.MsgUpdateCustomPrecompiledContractResponse{}, nil
+}
+
 func (k *msgServer) DeployErc20Contract(goCtx context.Context, req *cpctypes.MsgDeployErc20ContractRequest) (*cpctypes.MsgDeployErc20ContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	moduleParams := k.GetParams(ctx)
@@ -84,6 +113,25 @@ func (k *msgServer) DeployStakingContract(goCtx context.Context, req *cpctypes.M
 	}, nil
 }
 
+func (k *msgServer) CreateManagedErc20Contract(goCtx context.Context, req *cpctypes.MsgCreateManagedErc20ContractRequest) (*cpctypes.MsgCreateManagedErc20ContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidAddress, err), "invalid creator address: %s", req.Creator)
+	}
+
+	denom, contractAddr, err := k.CreateManagedErc20CustomPrecompiledContract(ctx, creator, req.Subdenom, req.Name, req.Symbol, uint8(req.Decimals))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpctypes.MsgCreateManagedErc20ContractResponse{
+		Denom:           denom,
+		ContractAddress: contractAddr.Hex(),
+	}, nil
+}
+
 func validateDeployer(authority string, moduleParams cpctypes.Params) error {
 	for _, whitelistedAddr := range moduleParams.WhitelistedDeployers {
 		if whitelistedAddr == authority {
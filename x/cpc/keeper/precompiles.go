@@ -3,7 +3,10 @@ package keeper
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/EscanBE/everlast/x/evm/vm"
@@ -26,7 +29,7 @@ func (k Keeper) GetProtocolCpcVersion(ctx sdk.Context) cpctypes.ProtocolCpc {
 }
 
 // SetCustomPrecompiledContractMeta sets custom precompiled contract metadata to KVStore.
-// This method will panic if overriding contract with type changed.
+// This method returns error if overriding contract with type changed.
 func (k Keeper) SetCustomPrecompiledContractMeta(ctx sdk.Context, contractMetadata cpctypes.CustomPrecompiledContractMeta, newDeployment bool) error {
 	protocolVersion := k.GetProtocolCpcVersion(ctx)
 	if err := contractMetadata.Validate(protocolVersion); err != nil {
@@ -42,7 +45,7 @@ func (k Keeper) SetCustomPrecompiledContractMeta(ctx sdk.Context, contractMetada
 	} else {
 		if previousRecord := k.GetCustomPrecompiledContractMeta(ctx, contractAddress); previousRecord != nil {
 			if previousRecord.CustomPrecompiledType != contractMetadata.CustomPrecompiledType {
-				panic(fmt.Sprintf("not allowed to change type of the precompiled contract: %d != %d", previousRecord.CustomPrecompiledType, contractMetadata.CustomPrecompiledType))
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "not allowed to change type of the precompiled contract: %d != %d", previousRecord.CustomPrecompiledType, contractMetadata.CustomPrecompiledType)
 			}
 		} else {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contract does not exist by address")
@@ -78,6 +81,65 @@ func (k Keeper) SetCustomPrecompiledContractMeta(ctx sdk.Context, contractMetada
 	return nil
 }
 
+// UpdateCustomPrecompiledContractMeta updates the name, typed metadata and the disabled flag of an existing custom precompiled contract.
+// Empty name or typed metadata means keeping the current value.
+// Renaming an ERC20 contract changes its EIP-2612 domain separator, the permit signatures made before become invalid.
+// Type of the contract and the min denom of ERC20 contracts are not allowed to be changed.
+func (k Keeper) UpdateCustomPrecompiledContractMeta(ctx sdk.Context, contractAddress common.Address, name, typedMeta string, disabled bool) error {
+	previousRecord := k.GetCustomPrecompiledContractMeta(ctx, contractAddress)
+	if previousRecord == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract does not exist by address: %s", contractAddress)
+	}
+
+	contractMetadata := *previousRecord
+	if name != "" {
+		contractMetadata.Name = name
+	}
+	if typedMeta != "" {
+		contractMetadata.TypedMeta = typedMeta
+	}
+	contractMetadata.Disabled = disabled
+
+	if contractMetadata.CustomPrecompiledType == cpctypes.CpcTypeErc20 {
+		var previousErc20Meta, erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta
+		if err := json.Unmarshal([]byte(previousRecord.TypedMeta), &previousErc20Meta); err != nil {
+			panic(err)
+		}
+		if err := json.Unmarshal([]byte(contractMetadata.TypedMeta), &erc20Meta); err != nil {
+			return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "invalid ERC20 metadata")
+		}
+		if erc20Meta.MinDenom != previousErc20Meta.MinDenom {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "not allowed to change min denom of the ERC20 contract: %s != %s", previousErc20Meta.MinDenom, erc20Meta.MinDenom)
+		}
+	}
+
+	return k.SetCustomPrecompiledContractMeta(ctx, contractMetadata, false)
+}
+
+// ImportCustomPrecompiledContract restores a deployed custom precompiled contract at its original address, used for importing genesis.
+// For ERC20 contracts, the reverse mapping from min denom to contract address is restored as well.
+func (k Keeper) ImportCustomPrecompiledContract(ctx sdk.Context, contractMetadata cpctypes.CustomPrecompiledContractMeta) error {
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMetadata, true); err != nil {
+		return err
+	}
+
+	if contractMetadata.CustomPrecompiledType == cpctypes.CpcTypeErc20 {
+		var erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta
+		if err := json.Unmarshal([]byte(contractMetadata.TypedMeta), &erc20Meta); err != nil {
+			return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "invalid ERC20 metadata")
+		}
+
+		if existingAddr := k.GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, erc20Meta.MinDenom); existingAddr != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrConflict, "existing contract for %s: %s", erc20Meta.MinDenom, *existingAddr)
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		store.Set(cpctypes.Erc20CustomPrecompiledContractMinDenomToAddressKey(erc20Meta.MinDenom), contractMetadata.Address)
+	}
+
+	return nil
+}
+
 // GetCustomPrecompiledContractMeta returns custom precompiled contract metadata from KVStore.
 func (k Keeper) GetCustomPrecompiledContractMeta(ctx sdk.Context, contractAddress common.Address) *cpctypes.CustomPrecompiledContractMeta {
 	store := ctx.KVStore(k.storeKey)
@@ -102,6 +164,43 @@ func (k Keeper) HasCustomPrecompiledContract(ctx sdk.Context, contractAddress co
 	return store.Has(key)
 }
 
+// DeployMissingSingletonCustomPrecompiledContracts deploys the fixed-address custom precompiled contracts
+// which are always available on chain, if they are supported by the current protocol version and not deployed yet.
+func (k Keeper) DeployMissingSingletonCustomPrecompiledContracts(ctx sdk.Context) error {
+	protocolVersion := k.GetProtocolCpcVersion(ctx)
+
+	for _, singleton := range []struct {
+		name    string
+		address common.Address
+		cpcType uint32
+		deploy  func(sdk.Context) (common.Address, error)
+	}{
+		{name: "Bech32", address: cpctypes.CpcBech32FixedAddress, cpcType: cpctypes.CpcTypeBech32, deploy: k.DeployBech32CustomPrecompiledContract},
+		{name: "Gov", address: cpctypes.CpcGovFixedAddress, cpcType: cpctypes.CpcTypeGov, deploy: k.DeployGovCustomPrecompiledContract},
+		{name: "Distribution", address: cpctypes.CpcDistributionFixedAddress, cpcType: cpctypes.CpcTypeDistribution, deploy: k.DeployDistributionCustomPrecompiledContract},
+		{name: "IBC Transfer", address: cpctypes.CpcIbcTransferFixedAddress, cpcType: cpctypes.CpcTypeIbcTransfer, deploy: k.DeployIbcTransferCustomPrecompiledContract},
+		{name: "Multicall", address: cpctypes.CpcMulticallFixedAddress, cpcType: cpctypes.CpcTypeMulticall, deploy: k.DeployMulticallCustomPrecompiledContract},
+		{name: "Slashing", address: cpctypes.CpcSlashingFixedAddress, cpcType: cpctypes.CpcTypeSlashing, deploy: k.DeploySlashingCustomPrecompiledContract},
+		{name: "Authz", address: cpctypes.CpcAuthzFixedAddress, cpcType: cpctypes.CpcTypeAuthz, deploy: k.DeployAuthzCustomPrecompiledContract},
+		{name: "Vesting", address: cpctypes.CpcVestingFixedAddress, cpcType: cpctypes.CpcTypeVesting, deploy: k.DeployVestingCustomPrecompiledContract},
+		{name: "Bank", address: cpctypes.CpcBankFixedAddress, cpcType: cpctypes.CpcTypeBank, deploy: k.DeployBankCustomPrecompiledContract},
+	} {
+		if !protocolVersion.IsSupportedCustomPrecompiledType(singleton.cpcType) {
+			continue
+		}
+
+		if k.HasCustomPrecompiledContract(ctx, singleton.address) {
+			continue
+		}
+
+		if _, err := singleton.deploy(ctx); err != nil {
+			return errorsmod.Wrapf(err, "error deploying %s Custom Precompiled Contract", singleton.name)
+		}
+	}
+
+	return nil
+}
+
 // GetAllCustomPrecompiledContractsMeta returns all custom precompiled contracts metadata from KVStore.
 func (k Keeper) GetAllCustomPrecompiledContractsMeta(ctx sdk.Context) []cpctypes.CustomPrecompiledContractMeta {
 	store := ctx.KVStore(k.storeKey)
@@ -166,24 +265,61 @@ func (k Keeper) GetErc20CustomPrecompiledContractAddressByMinDenom(ctx sdk.Conte
 
 var _ corevm.CustomPrecompiledContractMethodExecutorI = &customPrecompiledContractMethodExecutorImpl{}
 
+// NewCustomPrecompiledContractMethod creates a new method for the EVM, from the given executor.
+// If the method gas is provided, it overrides the default gas cost of the method.
 func NewCustomPrecompiledContractMethod(
 	executor ExtendedCustomPrecompiledContractMethodExecutorI,
 	protocolVersion cpctypes.ProtocolCpc,
+	methodGas *cpctypes.CustomPrecompiledContractMethodGas,
 ) corevm.CustomPrecompiledContractMethod {
+	var gasPerByte, gasPerIteration uint64
+	if methodGas != nil {
+		gasPerByte = methodGas.GasPerByte
+		gasPerIteration = methodGas.GasPerIteration
+	}
+
 	return corevm.CustomPrecompiledContractMethod{
 		Method4BytesSignatures: executor.Method4BytesSignatures(),
-		RequireGas:             executor.RequireGas(),
+		RequireGas:             getMethodRequireGas(executor, methodGas),
 		ReadOnly:               executor.ReadOnly(),
 		Executor: &customPrecompiledContractMethodExecutorImpl{
 			executor:        executor,
 			protocolVersion: protocolVersion,
+			requireGas:      getMethodRequireGas(executor, methodGas),
+			gasPerByte:      gasPerByte,
+			gasPerIteration: gasPerIteration,
 		},
 	}
 }
 
+// getMethodRequireGas returns the static gas required by the method, the base gas of the gas schedule takes precedence if provided.
+func getMethodRequireGas(
+	executor ExtendedCustomPrecompiledContractMethodExecutorI,
+	methodGas *cpctypes.CustomPrecompiledContractMethodGas,
+) uint64 {
+	if methodGas != nil && methodGas.BaseGas > 0 {
+		return methodGas.BaseGas
+	}
+	return executor.RequireGas()
+}
+
+// getCustomPrecompiledContractMethodRequireGas returns the static gas required by the method of the given contract,
+// same as the one used by the EVM. Returns zero if the method does not exist.
+func (k Keeper) getCustomPrecompiledContractMethodRequireGas(ctx sdk.Context, contractMetadata cpctypes.CustomPrecompiledContractMeta, method4BytesSignature []byte) uint64 {
+	for _, executor := range NewCustomPrecompiledContract(contractMetadata, k).GetMethodExecutors() {
+		if bytes.Equal(executor.Method4BytesSignatures(), method4BytesSignature) {
+			return getMethodRequireGas(executor, k.GetParams(ctx).GetMethodGas(contractMetadata.CustomPrecompiledType, method4BytesSignature))
+		}
+	}
+	return 0
+}
+
 type customPrecompiledContractMethodExecutorImpl struct {
 	executor        ExtendedCustomPrecompiledContractMethodExecutorI
 	protocolVersion cpctypes.ProtocolCpc
+	requireGas      uint64
+	gasPerByte      uint64
+	gasPerIteration uint64
 }
 
 func (m customPrecompiledContractMethodExecutorImpl) Execute(caller corevm.ContractRef, contractAddress common.Address, input []byte, evm *corevm.EVM) ([]byte, error) {
@@ -198,12 +334,51 @@ func (m customPrecompiledContractMethodExecutorImpl) Execute(caller corevm.Contr
 		))
 	}
 
-	ctx := evm.StateDB.(vm.CStateDB).GetCurrentContext()
-	return m.executor.Execute(caller, contractAddress, input, cpcExecutorEnv{
-		ctx:             ctx,
+	stateDB := evm.StateDB.(vm.CStateDB)
+	env := cpcExecutorEnv{
+		ctx:             stateDB.GetCurrentContext(),
 		evm:             evm,
+		caller:          caller,
 		protocolVersion: m.protocolVersion,
-	})
+		gasPerIteration: m.gasPerIteration,
+	}
+
+	if _, isContract := caller.(*corevm.Contract); isContract {
+		// When called by a contract, the dynamic gas is bounded by the gas provided to the call, after the static gas.
+		defer stateDB.SetCustomPrecompiledContractGasPool(stateDB.GetCustomPrecompiledContractGasPool())
+		stateDB.SetCustomPrecompiledContractGasPool(subGas(callGasOf(evm), m.requireGas))
+	} else if m.requireGas > 0 {
+		// When called by the transaction or by the multicall, the static gas was taken from the same gas as the pool,
+		// so it is reserved during the execution to prevent the dynamic gas from consuming it twice.
+		if !stateDB.ConsumeCustomPrecompiledContractGas(m.requireGas) {
+			return nil, corevm.ErrOutOfGas
+		}
+		defer stateDB.RefundCustomPrecompiledContractGas(m.requireGas)
+	}
+
+	gasPool := stateDB.GetCustomPrecompiledContractGasPool()
+	output, err := m.execute(caller, contractAddress, input, env)
+	if err != nil && !errors.Is(err, corevm.ErrExecutionReverted) {
+		// the EVM consumes all the gas provided to the failed call, the dynamic gas is not charged on top of it
+		stateDB.RefundCustomPrecompiledContractGas(gasPool - stateDB.GetCustomPrecompiledContractGasPool())
+	}
+	return output, err
+}
+
+func (m customPrecompiledContractMethodExecutorImpl) execute(caller corevm.ContractRef, contractAddress common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	if m.gasPerByte > 0 {
+		if err := env.useGas(mulGas(m.gasPerByte, uint64(len(input)))); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.executor.Execute(caller, contractAddress, input, env)
+}
+
+// callGasOf returns the gas provided by the calling contract to the current call, excluding the call stipend.
+// The EVM keeps it in an unexported field during the call, which is read without being modified.
+func callGasOf(evm *corevm.EVM) uint64 {
+	return reflect.ValueOf(evm).Elem().FieldByName("callGasTemp").Uint()
 }
 
 type CustomPrecompiledContractI interface {
@@ -221,6 +396,22 @@ func NewCustomPrecompiledContract(
 		return NewStakingCustomPrecompiledContract(metadata, keeper)
 	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeBech32 {
 		return NewBech32CustomPrecompiledContract(metadata)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeGov {
+		return NewGovCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeDistribution {
+		return NewDistributionCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeIbcTransfer {
+		return NewIbcTransferCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeMulticall {
+		return NewMulticallCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeSlashing {
+		return NewSlashingCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeAuthz {
+		return NewAuthzCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeVesting {
+		return NewVestingCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeBank {
+		return NewBankCustomPrecompiledContract(metadata, keeper)
 	}
 
 	panic(fmt.Sprintf("unsupported custom precompiled type %d", metadata.CustomPrecompiledType))
@@ -229,7 +420,35 @@ func NewCustomPrecompiledContract(
 type cpcExecutorEnv struct {
 	ctx             sdk.Context
 	evm             *corevm.EVM
+	caller          corevm.ContractRef
 	protocolVersion cpctypes.ProtocolCpc
+	gasPerIteration uint64
+}
+
+// useGas charges the dynamic gas during the execution, from the gas available to the call.
+// Returns ErrOutOfGas if the remaining gas is not enough, so the call fails and its state changes are reverted by the EVM.
+func (e cpcExecutorEnv) useGas(gas uint64) error {
+	if gas == 0 {
+		return nil
+	}
+	if !e.evm.StateDB.(vm.CStateDB).ConsumeCustomPrecompiledContractGas(gas) {
+		return corevm.ErrOutOfGas
+	}
+	return nil
+}
+
+// remainingGas returns the gas that can be charged by useGas.
+func (e cpcExecutorEnv) remainingGas() uint64 {
+	return e.evm.StateDB.(vm.CStateDB).GetCustomPrecompiledContractGasPool()
+}
+
+// consumeIterationGas charges the per-iteration gas, configured by the gas schedule, for the given number of iterations.
+// Methods that loop over a dynamic number of records must call this to be charged proportional to the work.
+func (e cpcExecutorEnv) consumeIterationGas(iterations int) error {
+	if e.gasPerIteration == 0 || iterations < 1 {
+		return nil
+	}
+	return e.useGas(mulGas(e.gasPerIteration, uint64(iterations)))
 }
 
 type ExtendedCustomPrecompiledContractMethodExecutorI interface {
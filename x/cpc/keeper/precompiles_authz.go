@@ -0,0 +1,533 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployAuthzCustomPrecompiledContract deploys a new authz custom precompiled contract.
+func (k Keeper) DeployAuthzCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcAuthzFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeAuthz,
+		Name:                  "Authz - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &authzCustomPrecompiledContract{}
+
+// authzCustomPrecompiledContract allows EVM accounts to interact with the `x/authz` module,
+// to grant/revoke authorizations and to execute bank send or staking delegate on behalf of the granter.
+type authzCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewAuthzCustomPrecompiledContract creates a new authz custom precompiled contract.
+func NewAuthzCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &authzCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&authzCustomPrecompiledContractRoName{contract: contract},
+		&authzCustomPrecompiledContractRoGrantOf{contract: contract},
+		&authzCustomPrecompiledContractRoSendSpendLimit{contract: contract},
+		&authzCustomPrecompiledContractRwGrantGeneric{contract: contract},
+		&authzCustomPrecompiledContractRwGrantSend{contract: contract},
+		&authzCustomPrecompiledContractRwRevoke{contract: contract},
+		&authzCustomPrecompiledContractRwExecSend{contract: contract},
+		&authzCustomPrecompiledContractRwExecDelegate{contract: contract},
+	}
+
+	return contract
+}
+
+func (m authzCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m authzCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+// grant grants the authorization from the granter to the grantee.
+func (m authzCustomPrecompiledContract) grant(
+	ctx sdk.Context,
+	granter, grantee common.Address,
+	authorization authz.Authorization,
+	expiration uint64,
+) error {
+	if _, disabled := m.keeper.authzDisabledGrantMsgs[authorization.MsgTypeURL()]; disabled {
+		return errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "not allowed to grant: %s", authorization.MsgTypeURL())
+	}
+
+	var expirationTime *time.Time
+	if expiration > 0 {
+		if expiration > math.MaxInt64 {
+			return errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "expiration is too large")
+		}
+		t := time.Unix(int64(expiration), 0).UTC()
+		expirationTime = &t
+	}
+
+	msgGrant, err := authz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), authorization, expirationTime)
+	if err != nil {
+		return errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+
+	if _, err := m.keeper.authzKeeper.Grant(ctx, msgGrant); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// exec executes the message on behalf of the granter, using the authorization granted to the grantee.
+func (m authzCustomPrecompiledContract) exec(ctx sdk.Context, grantee common.Address, msg sdk.Msg) error {
+	msgExec := authz.NewMsgExec(grantee.Bytes(), []sdk.Msg{msg})
+
+	if _, err := m.keeper.authzKeeper.Exec(ctx, &msgExec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m authzCustomPrecompiledContract) emitsEventGrant(granter, grantee common.Address, msgTypeUrl string, env cpcExecutorEnv) error {
+	return m.emitsEvent("Grant", common.HexToHash("0xbfab9413dcbf9e8d938e2cc64562caeeb065bece6869742e10dc389036faa79d"), granter, grantee, msgTypeUrl, env) // Grant(address,address,string)
+}
+
+func (m authzCustomPrecompiledContract) emitsEventRevoke(granter, grantee common.Address, msgTypeUrl string, env cpcExecutorEnv) error {
+	return m.emitsEvent("Revoke", common.HexToHash("0x89edca5e39ec72c8be42f61c849867ad405ab6f86a51818b624504b0c3f5f5b2"), granter, grantee, msgTypeUrl, env) // Revoke(address,address,string)
+}
+
+func (m authzCustomPrecompiledContract) emitsEventExec(granter, grantee common.Address, msgTypeUrl string, env cpcExecutorEnv) error {
+	return m.emitsEvent("Exec", common.HexToHash("0x0ac699489cc303fec1563d4819efc1de81e94468b1da5c8327123066f9e0445d"), granter, grantee, msgTypeUrl, env) // Exec(address,address,string)
+}
+
+func (m authzCustomPrecompiledContract) emitsEvent(eventName string, topic0 common.Hash, granter, grantee common.Address, msgTypeUrl string, env cpcExecutorEnv) error {
+	data, err := abi.AuthzCpcInfo.ABI.Events[eventName].Inputs.NonIndexed().Pack(msgTypeUrl)
+	if err != nil {
+		return err
+	}
+
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcAuthzFixedAddress,
+		Topics: []common.Hash{
+			topic0,
+			common.BytesToHash(granter.Bytes()),
+			common.BytesToHash(grantee.Bytes()),
+		},
+		Data: data,
+	})
+
+	return nil
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRoName{}
+
+type authzCustomPrecompiledContractRoName struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.AuthzCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e authzCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e authzCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e authzCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// grantOf(address,address,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRoGrantOf{}
+
+type authzCustomPrecompiledContractRoGrantOf struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRoGrantOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.AuthzCpcInfo.UnpackMethodInput("grantOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	granter := ips[0].(common.Address)
+	grantee := ips[1].(common.Address)
+	msgTypeUrl := ips[2].(string)
+
+	authorization, expiration := e.contract.keeper.authzKeeper.GetAuthorization(env.ctx, grantee.Bytes(), granter.Bytes(), msgTypeUrl)
+	if authorization == nil {
+		return abi.AuthzCpcInfo.PackMethodOutput("grantOf", false, uint64(0))
+	}
+
+	var expirationUnix uint64
+	if expiration != nil {
+		expirationUnix = uint64(expiration.Unix())
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("grantOf", true, expirationUnix)
+}
+
+func (e authzCustomPrecompiledContractRoGrantOf) Method4BytesSignatures() []byte {
+	return []byte{0x45, 0x2a, 0x58, 0x97}
+}
+
+func (e authzCustomPrecompiledContractRoGrantOf) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e authzCustomPrecompiledContractRoGrantOf) ReadOnly() bool {
+	return true
+}
+
+// sendSpendLimit(address,address,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRoSendSpendLimit{}
+
+type authzCustomPrecompiledContractRoSendSpendLimit struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRoSendSpendLimit) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.AuthzCpcInfo.UnpackMethodInput("sendSpendLimit", input)
+	if err != nil {
+		return nil, err
+	}
+
+	granter := ips[0].(common.Address)
+	grantee := ips[1].(common.Address)
+	denom := ips[2].(string)
+
+	spendLimit := big.NewInt(0)
+
+	authorization, _ := e.contract.keeper.authzKeeper.GetAuthorization(env.ctx, grantee.Bytes(), granter.Bytes(), sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	if sendAuthorization, ok := authorization.(*banktypes.SendAuthorization); ok {
+		spendLimit = sendAuthorization.SpendLimit.AmountOf(denom).BigInt()
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("sendSpendLimit", spendLimit)
+}
+
+func (e authzCustomPrecompiledContractRoSendSpendLimit) Method4BytesSignatures() []byte {
+	return []byte{0x6e, 0x1a, 0xaf, 0x12}
+}
+
+func (e authzCustomPrecompiledContractRoSendSpendLimit) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e authzCustomPrecompiledContractRoSendSpendLimit) ReadOnly() bool {
+	return true
+}
+
+// grantGeneric(address,string,uint64)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRwGrantGeneric{}
+
+type authzCustomPrecompiledContractRwGrantGeneric struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRwGrantGeneric) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.AuthzCpcInfo.UnpackMethodInput("grantGeneric", input)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee := ips[0].(common.Address)
+	msgTypeUrl := ips[1].(string)
+	expiration := ips[2].(uint64)
+
+	if msgTypeUrl == "" {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "message type url cannot be empty")
+	}
+
+	if err := e.contract.grant(env.ctx, caller.Address(), grantee, authz.NewGenericAuthorization(msgTypeUrl), expiration); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventGrant(caller.Address(), grantee, msgTypeUrl, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit event")
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("grantGeneric", true)
+}
+
+func (e authzCustomPrecompiledContractRwGrantGeneric) Method4BytesSignatures() []byte {
+	return []byte{0x4f, 0xc2, 0x44, 0x24}
+}
+
+func (e authzCustomPrecompiledContractRwGrantGeneric) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e authzCustomPrecompiledContractRwGrantGeneric) ReadOnly() bool {
+	return false
+}
+
+// grantSend(address,string,uint256,uint64)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRwGrantSend{}
+
+type authzCustomPrecompiledContractRwGrantSend struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRwGrantSend) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.AuthzCpcInfo.UnpackMethodInput("grantSend", input)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee := ips[0].(common.Address)
+	denom := ips[1].(string)
+	spendLimit := ips[2].(*big.Int)
+	expiration := ips[3].(uint64)
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+	if spendLimit.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "spend limit must be positive")
+	}
+
+	sendAuthorization := banktypes.NewSendAuthorization(
+		sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(spendLimit))), // spend limit
+		nil, // allow list, any recipient
+	)
+
+	if err := e.contract.grant(env.ctx, caller.Address(), grantee, sendAuthorization, expiration); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventGrant(caller.Address(), grantee, sendAuthorization.MsgTypeURL(), env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit event")
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("grantSend", true)
+}
+
+func (e authzCustomPrecompiledContractRwGrantSend) Method4BytesSignatures() []byte {
+	return []byte{0xe4, 0x33, 0x46, 0xec}
+}
+
+func (e authzCustomPrecompiledContractRwGrantSend) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e authzCustomPrecompiledContractRwGrantSend) ReadOnly() bool {
+	return false
+}
+
+// revoke(address,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRwRevoke{}
+
+type authzCustomPrecompiledContractRwRevoke struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRwRevoke) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.AuthzCpcInfo.UnpackMethodInput("revoke", input)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee := ips[0].(common.Address)
+	msgTypeUrl := ips[1].(string)
+
+	msgRevoke := authz.NewMsgRevoke(caller.Address().Bytes(), grantee.Bytes(), msgTypeUrl)
+	if _, err := e.contract.keeper.authzKeeper.Revoke(env.ctx, &msgRevoke); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventRevoke(caller.Address(), grantee, msgTypeUrl, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit event")
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("revoke", true)
+}
+
+func (e authzCustomPrecompiledContractRwRevoke) Method4BytesSignatures() []byte {
+	return []byte{0xaf, 0xd0, 0x22, 0x4b}
+}
+
+func (e authzCustomPrecompiledContractRwRevoke) RequireGas() uint64 {
+	return 50_000
+}
+
+func (e authzCustomPrecompiledContractRwRevoke) ReadOnly() bool {
+	return false
+}
+
+// execSend(address,address,string,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRwExecSend{}
+
+type authzCustomPrecompiledContractRwExecSend struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRwExecSend) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.AuthzCpcInfo.UnpackMethodInput("execSend", input)
+	if err != nil {
+		return nil, err
+	}
+
+	granter := ips[0].(common.Address)
+	to := ips[1].(common.Address)
+	denom := ips[2].(string)
+	amount := ips[3].(*big.Int)
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "send amount must be positive")
+	}
+
+	msgSend := banktypes.NewMsgSend(
+		granter.Bytes(), // from
+		to.Bytes(),      // to
+		sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))), // amount
+	)
+
+	if err := e.contract.exec(env.ctx, caller.Address(), msgSend); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventExec(granter, caller.Address(), sdk.MsgTypeURL(msgSend), env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit event")
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("execSend", true)
+}
+
+func (e authzCustomPrecompiledContractRwExecSend) Method4BytesSignatures() []byte {
+	return []byte{0x68, 0x96, 0xc2, 0x03}
+}
+
+func (e authzCustomPrecompiledContractRwExecSend) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e authzCustomPrecompiledContractRwExecSend) ReadOnly() bool {
+	return false
+}
+
+// execDelegate(address,address,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &authzCustomPrecompiledContractRwExecDelegate{}
+
+type authzCustomPrecompiledContractRwExecDelegate struct {
+	contract *authzCustomPrecompiledContract
+}
+
+func (e authzCustomPrecompiledContractRwExecDelegate) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.AuthzCpcInfo.UnpackMethodInput("execDelegate", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.contract.keeper.stakingKeeper
+
+	granter := ips[0].(common.Address)
+	validator := ips[1].(common.Address)
+	amount := ips[2].(*big.Int)
+
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "delegate amount must be positive")
+	}
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrStr, err := sk.ValidatorAddressCodec().BytesToString(validator.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	msgDelegate := stakingtypes.NewMsgDelegate(
+		sdk.AccAddress(granter.Bytes()).String(), // delegator
+		valAddrStr,                               // validator
+		sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount)), // delegation amount
+	)
+
+	if err := e.contract.exec(ctx, caller.Address(), msgDelegate); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventExec(granter, caller.Address(), sdk.MsgTypeURL(msgDelegate), env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit event")
+	}
+
+	return abi.AuthzCpcInfo.PackMethodOutput("execDelegate", true)
+}
+
+func (e authzCustomPrecompiledContractRwExecDelegate) Method4BytesSignatures() []byte {
+	return []byte{0x75, 0x51, 0x65, 0x6e}
+}
+
+func (e authzCustomPrecompiledContractRwExecDelegate) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e authzCustomPrecompiledContractRwExecDelegate) ReadOnly() bool {
+	return false
+}
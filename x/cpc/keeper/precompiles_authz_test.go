@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/everlast/app/antedl"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	topic0AuthzGrant  = "0xbfab9413dcbf9e8d938e2cc64562caeeb065bece6869742e10dc389036faa79d"
+	topic0AuthzRevoke = "0x89edca5e39ec72c8be42f61c849867ad405ab6f86a51818b624504b0c3f5f5b2"
+	topic0AuthzExec   = "0x0ac699489cc303fec1563d4819efc1de81e94468b1da5c8327123066f9e0445d"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployAuthzCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcAuthzFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployAuthzCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcAuthzFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcAuthzFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_AuthzCustomPrecompiledContract_Topic0() {
+	suite.Equal(common.HexToHash(topic0AuthzGrant), abi.AuthzCpcInfo.ABI.Events["Grant"].ID)
+	suite.Equal(common.HexToHash(topic0AuthzRevoke), abi.AuthzCpcInfo.ABI.Events["Revoke"].ID)
+	suite.Equal(common.HexToHash(topic0AuthzExec), abi.AuthzCpcInfo.ABI.Events["Exec"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_AuthzCustomPrecompiledContract() {
+	granter := suite.CITS.WalletAccounts.Number(1)
+	grantee := suite.CITS.WalletAccounts.Number(2)
+	receiver := suite.CITS.WalletAccounts.Number(3)
+	operator := suite.CITS.WalletAccounts.Number(4)
+
+	bondDenom := suite.bondDenom(suite.Ctx())
+	suite.CITS.MintCoin(granter, sdk.NewCoin(bondDenom, sdkmath.NewInt(1e18)))
+
+	msgSendTypeUrl := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgDelegateTypeUrl := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	callContract := func(from common.Address, method string, args ...any) (ret []byte, logs []*ethtypes.Log, vmErr string) {
+		input, err := abi.AuthzCpcInfo.ABI.Pack(method, args...)
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcAuthzFixedAddress, input)
+		suite.Require().NoError(err)
+
+		receipt := &ethtypes.Receipt{}
+		suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+
+		return res.Ret, receipt.Logs, res.VmError
+	}
+
+	requireEventLog := func(log *ethtypes.Log, topic0 string, granter, grantee common.Address, msgTypeUrl string) {
+		suite.Equal(topic0, log.Topics[0].String())
+		suite.Equal(granter, common.BytesToAddress(log.Topics[1].Bytes()))
+		suite.Equal(grantee, common.BytesToAddress(log.Topics[2].Bytes()))
+		data, err := ethabi.Arguments{{Type: abi.AuthzCpcInfo.ABI.Events["Grant"].Inputs[2].Type}}.Unpack(log.Data)
+		suite.Require().NoError(err)
+		suite.Equal(msgTypeUrl, data[0].(string))
+	}
+
+	suite.Run("pass - grant send", func() {
+		ret, logs, vmErr := callContract(granter.GetEthAddress(), "grantSend", grantee.GetEthAddress(), bondDenom, big.NewInt(1000), uint64(0))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		requireEventLog(logs[0], topic0AuthzGrant, granter.GetEthAddress(), grantee.GetEthAddress(), msgSendTypeUrl)
+
+		ret, _, vmErr = callContract(grantee.GetEthAddress(), "sendSpendLimit", granter.GetEthAddress(), grantee.GetEthAddress(), bondDenom)
+		suite.Require().Empty(vmErr)
+		spendLimit, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(int64(1000), spendLimit.Int64())
+
+		ret, _, vmErr = callContract(grantee.GetEthAddress(), "grantOf", granter.GetEthAddress(), grantee.GetEthAddress(), msgSendTypeUrl)
+		suite.Require().Empty(vmErr)
+		outputs, err := abi.AuthzCpcInfo.ABI.Methods["grantOf"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.True(outputs[0].(bool))
+		suite.Zero(outputs[1].(uint64))
+	})
+
+	suite.Run("pass - exec send", func() {
+		balanceBefore := suite.App().BankKeeper().GetBalance(suite.Ctx(), receiver.GetCosmosAddress(), bondDenom)
+
+		ret, logs, vmErr := callContract(grantee.GetEthAddress(), "execSend", granter.GetEthAddress(), receiver.GetEthAddress(), bondDenom, big.NewInt(600))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		requireEventLog(logs[0], topic0AuthzExec, granter.GetEthAddress(), grantee.GetEthAddress(), msgSendTypeUrl)
+
+		balanceAfter := suite.App().BankKeeper().GetBalance(suite.Ctx(), receiver.GetCosmosAddress(), bondDenom)
+		suite.Equal(int64(600), balanceAfter.Amount.Sub(balanceBefore.Amount).Int64())
+
+		ret, _, vmErr = callContract(grantee.GetEthAddress(), "sendSpendLimit", granter.GetEthAddress(), grantee.GetEthAddress(), bondDenom)
+		suite.Require().Empty(vmErr)
+		spendLimit, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(int64(400), spendLimit.Int64())
+	})
+
+	suite.Run("fail - exec send exceeds spend limit", func() {
+		_, _, vmErr := callContract(grantee.GetEthAddress(), "execSend", granter.GetEthAddress(), receiver.GetEthAddress(), bondDenom, big.NewInt(401))
+		suite.Require().Contains(vmErr, "insufficient funds")
+	})
+
+	suite.Run("fail - exec send without authorization", func() {
+		_, _, vmErr := callContract(receiver.GetEthAddress(), "execSend", granter.GetEthAddress(), receiver.GetEthAddress(), bondDenom, big.NewInt(1))
+		suite.Require().Contains(vmErr, "authorization not found")
+	})
+
+	suite.Run("pass - revoke", func() {
+		ret, logs, vmErr := callContract(granter.GetEthAddress(), "revoke", grantee.GetEthAddress(), msgSendTypeUrl)
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		requireEventLog(logs[0], topic0AuthzRevoke, granter.GetEthAddress(), grantee.GetEthAddress(), msgSendTypeUrl)
+
+		ret, _, vmErr = callContract(grantee.GetEthAddress(), "grantOf", granter.GetEthAddress(), grantee.GetEthAddress(), msgSendTypeUrl)
+		suite.Require().Empty(vmErr)
+		outputs, err := abi.AuthzCpcInfo.ABI.Methods["grantOf"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.False(outputs[0].(bool))
+
+		_, _, vmErr = callContract(grantee.GetEthAddress(), "execSend", granter.GetEthAddress(), receiver.GetEthAddress(), bondDenom, big.NewInt(1))
+		suite.Require().Contains(vmErr, "authorization not found")
+	})
+
+	suite.Run("pass - grant generic and exec delegate", func() {
+		suite.createValidator(suite.Ctx(), operator, sdkmath.NewInt(1e9))
+
+		expiration := uint64(suite.Ctx().BlockTime().Unix() + 3600)
+		_, logs, vmErr := callContract(granter.GetEthAddress(), "grantGeneric", grantee.GetEthAddress(), msgDelegateTypeUrl, expiration)
+		suite.Require().Empty(vmErr)
+		suite.Require().Len(logs, 1)
+		requireEventLog(logs[0], topic0AuthzGrant, granter.GetEthAddress(), grantee.GetEthAddress(), msgDelegateTypeUrl)
+
+		ret, _, vmErr := callContract(grantee.GetEthAddress(), "grantOf", granter.GetEthAddress(), grantee.GetEthAddress(), msgDelegateTypeUrl)
+		suite.Require().Empty(vmErr)
+		outputs, err := abi.AuthzCpcInfo.ABI.Methods["grantOf"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.True(outputs[0].(bool))
+		suite.Equal(expiration, outputs[1].(uint64))
+
+		ret, logs, vmErr = callContract(grantee.GetEthAddress(), "execDelegate", granter.GetEthAddress(), operator.GetEthAddress(), big.NewInt(1e9))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		requireEventLog(logs[0], topic0AuthzExec, granter.GetEthAddress(), grantee.GetEthAddress(), msgDelegateTypeUrl)
+
+		delegation, err := suite.App().StakingKeeper().GetDelegation(suite.Ctx(), granter.GetCosmosAddress(), operator.GetValidatorAddress())
+		suite.Require().NoError(err)
+		suite.True(delegation.Shares.IsPositive())
+	})
+
+	suite.Run("fail - grant expired", func() {
+		_, _, vmErr := callContract(granter.GetEthAddress(), "grantGeneric", grantee.GetEthAddress(), msgDelegateTypeUrl, uint64(1))
+		suite.Require().NotEmpty(vmErr)
+	})
+
+	suite.Run("fail - grant to self", func() {
+		_, _, vmErr := callContract(granter.GetEthAddress(), "grantGeneric", granter.GetEthAddress(), msgSendTypeUrl, uint64(0))
+		suite.Require().NotEmpty(vmErr)
+	})
+
+	suite.Run("fail - grant zero spend limit", func() {
+		_, _, vmErr := callContract(granter.GetEthAddress(), "grantSend", grantee.GetEthAddress(), bondDenom, big.NewInt(0), uint64(0))
+		suite.Require().Contains(vmErr, "spend limit must be positive")
+	})
+
+	suite.Run("fail - not allowed to grant disabled messages", func() {
+		// same as the disabled nested messages of the ante handler
+		disabledNestedMsgs := antedl.DefaultDisabledNestedMsgs()
+		suite.Require().NotEmpty(disabledNestedMsgs)
+		for _, msgTypeUrl := range disabledNestedMsgs {
+			_, _, vmErr := callContract(granter.GetEthAddress(), "grantGeneric", grantee.GetEthAddress(), msgTypeUrl, uint64(0))
+			suite.Require().Contains(vmErr, "not allowed to grant")
+		}
+	})
+}
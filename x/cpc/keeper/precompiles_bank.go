@@ -0,0 +1,383 @@
+package keeper
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	sdkmath "cosmossdk.io/math"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployBankCustomPrecompiledContract deploys a new bank custom precompiled contract.
+func (k Keeper) DeployBankCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcBankFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeBank,
+		Name:                  "Bank - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &bankCustomPrecompiledContract{}
+
+// bankCustomPrecompiledContract exposes every native asset through a single fixed address,
+// without the need of deploying an ERC20 custom precompiled contract per denom.
+type bankCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewBankCustomPrecompiledContract creates a new bank custom precompiled contract.
+func NewBankCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &bankCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&bankCustomPrecompiledContractRoName{contract: contract},
+		&bankCustomPrecompiledContractRoBalanceOf{contract: contract},
+		&bankCustomPrecompiledContractRoAllBalances{contract: contract},
+		&bankCustomPrecompiledContractRoDenomMetadata{contract: contract},
+		&bankCustomPrecompiledContractRoSupplyOf{contract: contract},
+		&bankCustomPrecompiledContractRwMultiSend{contract: contract},
+	}
+
+	return contract
+}
+
+func (m bankCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m bankCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m bankCustomPrecompiledContract) emitsEventTransfer(from, to common.Address, denom string, amount *big.Int, env cpcExecutorEnv) error {
+	data, err := abi.BankCpcInfo.ABI.Events["Transfer"].Inputs.NonIndexed().Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcBankFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78"), // Transfer(address,address,string,uint256)
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: data,
+	})
+
+	return nil
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoName{}
+
+type bankCustomPrecompiledContractRoName struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.BankCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.BankCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e bankCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e bankCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e bankCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// balanceOf(address,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoBalanceOf{}
+
+type bankCustomPrecompiledContractRoBalanceOf struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("balanceOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+	denom := ips[1].(string)
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+
+	balance := e.contract.keeper.bankKeeper.GetBalance(env.ctx, account.Bytes(), denom)
+
+	return abi.BankCpcInfo.PackMethodOutput("balanceOf", balance.Amount.BigInt())
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) Method4BytesSignatures() []byte {
+	return []byte{0xb9, 0xb0, 0x92, 0xc8}
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) RequireGas() uint64 {
+	return 0
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) ReadOnly() bool {
+	return true
+}
+
+// allBalances(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoAllBalances{}
+
+type bankCustomPrecompiledContractRoAllBalances struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoAllBalances) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("allBalances", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+
+	balances := e.contract.keeper.bankKeeper.GetAllBalances(env.ctx, account.Bytes())
+	if err := env.consumeIterationGas(len(balances)); err != nil {
+		return nil, err
+	}
+
+	coins := make([]abi.BankCoin, len(balances))
+	for i, balance := range balances {
+		coins[i] = abi.BankCoin{
+			Denom:  balance.Denom,
+			Amount: balance.Amount.BigInt(),
+		}
+	}
+
+	return abi.BankCpcInfo.PackMethodOutput("allBalances", coins)
+}
+
+func (e bankCustomPrecompiledContractRoAllBalances) Method4BytesSignatures() []byte {
+	return []byte{0xe5, 0x3f, 0x71, 0x4f}
+}
+
+func (e bankCustomPrecompiledContractRoAllBalances) RequireGas() uint64 {
+	return 5_000
+}
+
+func (e bankCustomPrecompiledContractRoAllBalances) ReadOnly() bool {
+	return true
+}
+
+// denomMetadata(string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoDenomMetadata{}
+
+type bankCustomPrecompiledContractRoDenomMetadata struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("denomMetadata", input)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := ips[0].(string)
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+
+	metadata, found := e.contract.keeper.bankKeeper.GetDenomMetaData(env.ctx, denom)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "denom metadata not found: %s", denom)
+	}
+
+	var decimals uint8
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display && denomUnit.Exponent <= 255 {
+			decimals = uint8(denomUnit.Exponent)
+			break
+		}
+	}
+
+	return abi.BankCpcInfo.PackMethodOutput("denomMetadata", abi.BankDenomMetadata{
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		Description: metadata.Description,
+		Decimals:    decimals,
+	})
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) Method4BytesSignatures() []byte {
+	return []byte{0xbf, 0x16, 0x75, 0x69}
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) RequireGas() uint64 {
+	return 0
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) ReadOnly() bool {
+	return true
+}
+
+// supplyOf(string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoSupplyOf{}
+
+type bankCustomPrecompiledContractRoSupplyOf struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("supplyOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := ips[0].(string)
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+
+	supply := e.contract.keeper.bankKeeper.GetSupply(env.ctx, denom)
+
+	return abi.BankCpcInfo.PackMethodOutput("supplyOf", supply.Amount.BigInt())
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) Method4BytesSignatures() []byte {
+	return []byte{0x3c, 0xda, 0x01, 0x03}
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) RequireGas() uint64 {
+	return 0
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) ReadOnly() bool {
+	return true
+}
+
+// multiSend(address[],string[],uint256[])
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRwMultiSend{}
+
+type bankCustomPrecompiledContractRwMultiSend struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRwMultiSend) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("multiSend", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	bankKeeper := e.contract.keeper.bankKeeper
+
+	from := caller.Address()
+	recipients := ips[0].([]common.Address)
+	denoms := ips[1].([]string)
+	amounts := ips[2].([]*big.Int)
+
+	if len(recipients) < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "recipients cannot be empty")
+	}
+	if len(recipients) != len(denoms) || len(recipients) != len(amounts) {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "recipients, denoms and amounts must have the same length")
+	}
+
+	if err := env.consumeIterationGas(len(recipients)); err != nil {
+		return nil, err
+	}
+
+	for i, recipient := range recipients {
+		denom := denoms[i]
+		amount := amounts[i]
+
+		if recipient == (common.Address{}) {
+			return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "transfer %d: recipient cannot be zero address", i)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "transfer %d: %s", i, err.Error())
+		}
+		if amount.Sign() < 1 {
+			return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "transfer %d: amount must be positive", i)
+		}
+		if bankKeeper.BlockedAddr(recipient.Bytes()) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "transfer %d: %s is not allowed to receive funds", i, sdk.AccAddress(recipient.Bytes()))
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+		if err := bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return nil, err
+		}
+
+		if err := bankKeeper.SendCoins(ctx, from.Bytes(), recipient.Bytes(), coins); err != nil {
+			return nil, errorsmod.Wrapf(errors.Join(cpctypes.ErrExecFailure, err), "transfer %d: failed to transfer coins", i)
+		}
+
+		if err := e.contract.emitsEventTransfer(from, recipient, denom, amount, env); err != nil {
+			return nil, err
+		}
+	}
+
+	return abi.BankCpcInfo.PackMethodOutput("multiSend", true)
+}
+
+func (e bankCustomPrecompiledContractRwMultiSend) Method4BytesSignatures() []byte {
+	return []byte{0x04, 0x70, 0xb4, 0x7e}
+}
+
+func (e bankCustomPrecompiledContractRwMultiSend) RequireGas() uint64 {
+	return 15_000
+}
+
+func (e bankCustomPrecompiledContractRwMultiSend) ReadOnly() bool {
+	return false
+}
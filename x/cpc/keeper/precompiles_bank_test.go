@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	topic0BankTransfer = "0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployBankCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcBankFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployBankCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcBankFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcBankFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_BankCustomPrecompiledContract_Topic0() {
+	suite.Equal(common.HexToHash(topic0BankTransfer), abi.BankCpcInfo.ABI.Events["Transfer"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_BankCustomPrecompiledContract() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+	account3 := suite.CITS.WalletAccounts.Number(3)
+
+	const denom1 = "ubankone"
+	const denom2 = "ubanktwo"
+
+	suite.CITS.MintCoin(account1, sdk.NewCoin(denom1, sdkmath.NewInt(1000)))
+	suite.CITS.MintCoin(account1, sdk.NewCoin(denom2, sdkmath.NewInt(2000)))
+
+	suite.App().BankKeeper().SetDenomMetaData(suite.Ctx(), banktypes.Metadata{
+		Description: "The first token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom1, Exponent: 0},
+			{Denom: "bankone", Exponent: 6},
+		},
+		Base:    denom1,
+		Display: "bankone",
+		Name:    "One",
+		Symbol:  "ONE",
+	})
+
+	callContract := func(from common.Address, method string, args ...any) (ret []byte, logs []*ethtypes.Log, vmErr string) {
+		input, err := abi.BankCpcInfo.ABI.Pack(method, args...)
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcBankFixedAddress, input)
+		suite.Require().NoError(err)
+
+		receipt := &ethtypes.Receipt{}
+		suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+
+		return res.Ret, receipt.Logs, res.VmError
+	}
+
+	suite.Run("pass - balance of", func() {
+		ret, _, vmErr := callContract(account2.GetEthAddress(), "balanceOf", account1.GetEthAddress(), denom2)
+		suite.Require().Empty(vmErr)
+
+		balance, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(int64(2000), balance.Int64())
+	})
+
+	suite.Run("fail - balance of invalid denom", func() {
+		_, _, vmErr := callContract(account2.GetEthAddress(), "balanceOf", account1.GetEthAddress(), "1")
+		suite.Require().Contains(vmErr, "invalid denom")
+	})
+
+	suite.Run("pass - all balances", func() {
+		ret, _, vmErr := callContract(account2.GetEthAddress(), "allBalances", account1.GetEthAddress())
+		suite.Require().Empty(vmErr)
+
+		ops, err := abi.BankCpcInfo.ABI.Methods["allBalances"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(ops, 1)
+
+		var wantCoins []abi.BankCoin
+		for _, balance := range suite.App().BankKeeper().GetAllBalances(suite.Ctx(), account1.GetCosmosAddress()) {
+			wantCoins = append(wantCoins, abi.BankCoin{
+				Denom:  balance.Denom,
+				Amount: balance.Amount.BigInt(),
+			})
+		}
+		suite.Require().GreaterOrEqual(len(wantCoins), 2)
+		suite.Equal(fmt.Sprintf("%v", wantCoins), fmt.Sprintf("%v", ops[0]))
+	})
+
+	suite.Run("pass - denom metadata", func() {
+		ret, _, vmErr := callContract(account2.GetEthAddress(), "denomMetadata", denom1)
+		suite.Require().Empty(vmErr)
+
+		ops, err := abi.BankCpcInfo.ABI.Methods["denomMetadata"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(ops, 1)
+
+		suite.Equal(fmt.Sprintf("%v", abi.BankDenomMetadata{
+			Base:        denom1,
+			Display:     "bankone",
+			Name:        "One",
+			Symbol:      "ONE",
+			Description: "The first token",
+			Decimals:    6,
+		}), fmt.Sprintf("%v", ops[0]))
+	})
+
+	suite.Run("fail - denom metadata not found", func() {
+		_, _, vmErr := callContract(account2.GetEthAddress(), "denomMetadata", denom2)
+		suite.Require().Contains(vmErr, "denom metadata not found")
+	})
+
+	suite.Run("pass - supply of", func() {
+		ret, _, vmErr := callContract(account2.GetEthAddress(), "supplyOf", denom1)
+		suite.Require().Empty(vmErr)
+
+		supply, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(suite.App().BankKeeper().GetSupply(suite.Ctx(), denom1).Amount.Int64(), supply.Int64())
+	})
+
+	suite.Run("pass - multi send", func() {
+		ret, logs, vmErr := callContract(
+			account1.GetEthAddress(), "multiSend",
+			[]common.Address{account2.GetEthAddress(), account3.GetEthAddress()},
+			[]string{denom1, denom2},
+			[]*big.Int{big.NewInt(100), big.NewInt(200)},
+		)
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Equal(int64(900), suite.CITS.QueryBalanceByDenom(0, account1.GetCosmosAddress().String(), denom1).Amount.Int64())
+		suite.Equal(int64(1800), suite.CITS.QueryBalanceByDenom(0, account1.GetCosmosAddress().String(), denom2).Amount.Int64())
+		suite.Equal(int64(100), suite.CITS.QueryBalanceByDenom(0, account2.GetCosmosAddress().String(), denom1).Amount.Int64())
+		suite.Equal(int64(200), suite.CITS.QueryBalanceByDenom(0, account3.GetCosmosAddress().String(), denom2).Amount.Int64())
+
+		suite.Require().Len(logs, 2)
+		suite.Equal(topic0BankTransfer, logs[0].Topics[0].String())
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(account2.GetEthAddress(), common.BytesToAddress(logs[0].Topics[2].Bytes()))
+		data, err := abi.BankCpcInfo.ABI.Events["Transfer"].Inputs.NonIndexed().Unpack(logs[0].Data)
+		suite.Require().NoError(err)
+		suite.Equal(denom1, data[0].(string))
+		suite.Equal(int64(100), data[1].(*big.Int).Int64())
+
+		suite.Equal(account3.GetEthAddress(), common.BytesToAddress(logs[1].Topics[2].Bytes()))
+	})
+
+	suite.Run("fail - multi send with mis-match length", func() {
+		_, _, vmErr := callContract(
+			account1.GetEthAddress(), "multiSend",
+			[]common.Address{account2.GetEthAddress(), account3.GetEthAddress()},
+			[]string{denom1},
+			[]*big.Int{big.NewInt(100), big.NewInt(200)},
+		)
+		suite.Require().Contains(vmErr, "must have the same length")
+	})
+
+	suite.Run("fail - multi send with empty recipients", func() {
+		_, _, vmErr := callContract(
+			account1.GetEthAddress(), "multiSend",
+			[]common.Address{}, []string{}, []*big.Int{},
+		)
+		suite.Require().Contains(vmErr, "recipients cannot be empty")
+	})
+
+	suite.Run("fail - multi send with zero amount", func() {
+		_, _, vmErr := callContract(
+			account1.GetEthAddress(), "multiSend",
+			[]common.Address{account2.GetEthAddress()}, []string{denom1}, []*big.Int{big.NewInt(0)},
+		)
+		suite.Require().Contains(vmErr, "amount must be positive")
+	})
+
+	suite.Run("fail - multi send more than balance", func() {
+		_, _, vmErr := callContract(
+			account1.GetEthAddress(), "multiSend",
+			[]common.Address{account2.GetEthAddress()}, []string{denom1}, []*big.Int{big.NewInt(1_000_000)},
+		)
+		suite.Require().Contains(vmErr, "insufficient funds")
+	})
+}
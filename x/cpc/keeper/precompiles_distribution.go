@@ -0,0 +1,477 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployDistributionCustomPrecompiledContract deploys a new distribution custom precompiled contract.
+func (k Keeper) DeployDistributionCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcDistributionFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeDistribution,
+		Name:                  "Distribution - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &distributionCustomPrecompiledContract{}
+
+// distributionCustomPrecompiledContract allows EVM accounts to interact with the `x/distribution` module,
+// features which are related to delegator rewards are provided by the staking custom precompiled contract.
+type distributionCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewDistributionCustomPrecompiledContract creates a new distribution custom precompiled contract.
+func NewDistributionCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &distributionCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&distributionCustomPrecompiledContractRoName{contract: contract},
+		&distributionCustomPrecompiledContractRoWithdrawAddressOf{contract: contract},
+		&distributionCustomPrecompiledContractRoValidatorOutstandingRewards{contract: contract},
+		&distributionCustomPrecompiledContractRoValidatorCommission{contract: contract},
+		&distributionCustomPrecompiledContractRoCommunityPool{contract: contract},
+		&distributionCustomPrecompiledContractRwSetWithdrawAddress{contract: contract},
+		&distributionCustomPrecompiledContractRwWithdrawValidatorCommission{contract: contract},
+		&distributionCustomPrecompiledContractRwFundCommunityPool{contract: contract},
+	}
+
+	return contract
+}
+
+func (m distributionCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m distributionCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m distributionCustomPrecompiledContract) emitsEventSetWithdrawAddress(delegator, withdrawAddress common.Address, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcDistributionFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0xae416f064415339eb2fc98ef48a0fc06ee07e3b33d469e001c477eac6e68947c"), // SetWithdrawAddress(address,address)
+			common.BytesToHash(delegator.Bytes()),
+			common.BytesToHash(withdrawAddress.Bytes()),
+		},
+	})
+}
+
+func (m distributionCustomPrecompiledContract) emitsEventWithdrawCommission(validator common.Address, amount *big.Int, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcDistributionFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x2a016beab1c9536945a8f4c51b734f24f9d1458b765600efdabb6a3c52251e75"), // WithdrawCommission(address,uint256)
+			common.BytesToHash(validator.Bytes()),
+		},
+		Data: common.BytesToHash(amount.Bytes()).Bytes(),
+	})
+}
+
+func (m distributionCustomPrecompiledContract) emitsEventFundCommunityPool(depositor common.Address, amount *big.Int, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcDistributionFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0xcaf76e243f2c5363d9c1509c402f2eadf947c6ce9920350c4e3572cb3dd3487e"), // FundCommunityPool(address,uint256)
+			common.BytesToHash(depositor.Bytes()),
+		},
+		Data: common.BytesToHash(amount.Bytes()).Bytes(),
+	})
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoName{}
+
+type distributionCustomPrecompiledContractRoName struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.DistributionCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e distributionCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e distributionCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e distributionCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// withdrawAddressOf(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoWithdrawAddressOf{}
+
+type distributionCustomPrecompiledContractRoWithdrawAddressOf struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoWithdrawAddressOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("withdrawAddressOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAddr := ips[0].(common.Address)
+
+	withdrawAddr, err := e.contract.keeper.distKeeper.GetDelegatorWithdrawAddr(env.ctx, delegatorAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("withdrawAddressOf", common.BytesToAddress(withdrawAddr))
+}
+
+func (e distributionCustomPrecompiledContractRoWithdrawAddressOf) Method4BytesSignatures() []byte {
+	return []byte{0x7f, 0x69, 0xa5, 0x34}
+}
+
+func (e distributionCustomPrecompiledContractRoWithdrawAddressOf) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e distributionCustomPrecompiledContractRoWithdrawAddressOf) ReadOnly() bool {
+	return true
+}
+
+// validatorOutstandingRewards(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoValidatorOutstandingRewards{}
+
+type distributionCustomPrecompiledContractRoValidatorOutstandingRewards struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("validatorOutstandingRewards", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.contract.keeper.stakingKeeper
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAddr := ips[0].(common.Address)
+	valAddrStr, err := sk.ValidatorAddressCodec().BytesToString(validatorAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	resRewards, err := distkeeper.NewQuerier(e.contract.keeper.distKeeper).ValidatorOutstandingRewards(ctx, &disttypes.QueryValidatorOutstandingRewardsRequest{
+		ValidatorAddress: valAddrStr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("validatorOutstandingRewards", resRewards.Rewards.Rewards.AmountOf(bondDenom).TruncateInt().BigInt())
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) Method4BytesSignatures() []byte {
+	return []byte{0xf2, 0x8e, 0x9b, 0x39}
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) ReadOnly() bool {
+	return true
+}
+
+// validatorCommission(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoValidatorCommission{}
+
+type distributionCustomPrecompiledContractRoValidatorCommission struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("validatorCommission", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.contract.keeper.stakingKeeper
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAddr := ips[0].(common.Address)
+	valAddrStr, err := sk.ValidatorAddressCodec().BytesToString(validatorAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	resCommission, err := distkeeper.NewQuerier(e.contract.keeper.distKeeper).ValidatorCommission(ctx, &disttypes.QueryValidatorCommissionRequest{
+		ValidatorAddress: valAddrStr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("validatorCommission", resCommission.Commission.Commission.AmountOf(bondDenom).TruncateInt().BigInt())
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) Method4BytesSignatures() []byte {
+	return []byte{0x83, 0xa2, 0x50, 0x78}
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) ReadOnly() bool {
+	return true
+}
+
+// communityPool()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoCommunityPool{}
+
+type distributionCustomPrecompiledContractRoCommunityPool struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoCommunityPool) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.DistributionCpcInfo.UnpackMethodInput("communityPool", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resPool, err := distkeeper.NewQuerier(e.contract.keeper.distKeeper).CommunityPool(ctx, &disttypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("communityPool", resPool.Pool.AmountOf(bondDenom).TruncateInt().BigInt())
+}
+
+func (e distributionCustomPrecompiledContractRoCommunityPool) Method4BytesSignatures() []byte {
+	return []byte{0x14, 0xd1, 0x40, 0xb0}
+}
+
+func (e distributionCustomPrecompiledContractRoCommunityPool) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e distributionCustomPrecompiledContractRoCommunityPool) ReadOnly() bool {
+	return true
+}
+
+// setWithdrawAddress(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRwSetWithdrawAddress{}
+
+type distributionCustomPrecompiledContractRwSetWithdrawAddress struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("setWithdrawAddress", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	delegator := sdk.AccAddress(caller.Address().Bytes())
+	withdrawAddr := ips[0].(common.Address)
+	if withdrawAddr == (common.Address{}) {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "withdraw address cannot be empty")
+	}
+
+	msgSetWithdrawAddress := disttypes.NewMsgSetWithdrawAddress(
+		delegator,                            // delegator
+		sdk.AccAddress(withdrawAddr.Bytes()), // withdraw address
+	)
+	if _, err := distkeeper.NewMsgServerImpl(e.contract.keeper.distKeeper).SetWithdrawAddress(ctx, msgSetWithdrawAddress); err != nil {
+		return nil, err
+	}
+
+	e.contract.emitsEventSetWithdrawAddress(caller.Address(), withdrawAddr, env)
+
+	return abi.DistributionCpcInfo.PackMethodOutput("setWithdrawAddress", true)
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) Method4BytesSignatures() []byte {
+	return []byte{0x3a, 0xb1, 0xa4, 0x94}
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) RequireGas() uint64 {
+	return 30_000
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) ReadOnly() bool {
+	return false
+}
+
+// withdrawValidatorCommission()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRwWithdrawValidatorCommission{}
+
+type distributionCustomPrecompiledContractRwWithdrawValidatorCommission struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.DistributionCpcInfo.UnpackMethodInput("withdrawValidatorCommission", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.contract.keeper.stakingKeeper
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrStr, err := sk.ValidatorAddressCodec().BytesToString(caller.Address().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	resWithdraw, err := distkeeper.NewMsgServerImpl(e.contract.keeper.distKeeper).WithdrawValidatorCommission(ctx, &disttypes.MsgWithdrawValidatorCommission{
+		ValidatorAddress: valAddrStr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	amount := resWithdraw.Amount.AmountOf(bondDenom).BigInt()
+	if amount.Sign() == 1 {
+		e.contract.emitsEventWithdrawCommission(caller.Address(), amount, env)
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("withdrawValidatorCommission", amount)
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) Method4BytesSignatures() []byte {
+	return []byte{0x0b, 0xde, 0x07, 0x6d}
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) ReadOnly() bool {
+	return false
+}
+
+// fundCommunityPool(uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRwFundCommunityPool{}
+
+type distributionCustomPrecompiledContractRwFundCommunityPool struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("fundCommunityPool", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	depositor := sdk.AccAddress(caller.Address().Bytes())
+	amount := ips[0].(*big.Int)
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "fund amount must be positive")
+	}
+
+	msgFundCommunityPool := disttypes.NewMsgFundCommunityPool(
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount))), // fund amount
+		depositor.String(), // depositor
+	)
+	if _, err := distkeeper.NewMsgServerImpl(e.contract.keeper.distKeeper).FundCommunityPool(ctx, msgFundCommunityPool); err != nil {
+		return nil, err
+	}
+
+	e.contract.emitsEventFundCommunityPool(caller.Address(), amount, env)
+
+	return abi.DistributionCpcInfo.PackMethodOutput("fundCommunityPool", true)
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) Method4BytesSignatures() []byte {
+	return []byte{0xde, 0xe6, 0x86, 0x23}
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) ReadOnly() bool {
+	return false
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	topic0SetWithdrawAddress = "0xae416f064415339eb2fc98ef48a0fc06ee07e3b33d469e001c477eac6e68947c"
+	topic0WithdrawCommission = "0x2a016beab1c9536945a8f4c51b734f24f9d1458b765600efdabb6a3c52251e75"
+	topic0FundCommunityPool  = "0xcaf76e243f2c5363d9c1509c402f2eadf947c6ce9920350c4e3572cb3dd3487e"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployDistributionCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcDistributionFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployDistributionCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcDistributionFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcDistributionFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_DistributionCustomPrecompiledContract_Topic0() {
+	suite.Equal(common.HexToHash(topic0SetWithdrawAddress), abi.DistributionCpcInfo.ABI.Events["SetWithdrawAddress"].ID)
+	suite.Equal(common.HexToHash(topic0WithdrawCommission), abi.DistributionCpcInfo.ABI.Events["WithdrawCommission"].ID)
+	suite.Equal(common.HexToHash(topic0FundCommunityPool), abi.DistributionCpcInfo.ABI.Events["FundCommunityPool"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_DistributionCustomPrecompiledContract() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+	operator := suite.CITS.WalletAccounts.Number(3)
+
+	bondDenom := suite.bondDenom(suite.Ctx())
+
+	callContract := func(from common.Address, input []byte) (ret []byte, logs []*ethtypes.Log, vmErr string) {
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcDistributionFixedAddress, input)
+		suite.Require().NoError(err)
+
+		receipt := &ethtypes.Receipt{}
+		suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+
+		return res.Ret, receipt.Logs, res.VmError
+	}
+
+	suite.Run("pass - set withdraw address", func() {
+		ret, _, vmErr := callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("withdrawAddressOf(address)"), account1.GetEthAddress()))
+		suite.Require().Empty(vmErr)
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(ret))
+
+		ret, logs, vmErr := callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("setWithdrawAddress(address)"), account2.GetEthAddress()))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0SetWithdrawAddress, logs[0].Topics[0].String())
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(account2.GetEthAddress(), common.BytesToAddress(logs[0].Topics[2].Bytes()))
+
+		ret, _, vmErr = callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("withdrawAddressOf(address)"), account1.GetEthAddress()))
+		suite.Require().Empty(vmErr)
+		suite.Equal(account2.GetEthAddress(), common.BytesToAddress(ret))
+	})
+
+	suite.Run("fail - set withdraw address to empty address", func() {
+		_, _, vmErr := callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("setWithdrawAddress(address)"), common.Address{}))
+		suite.Require().Contains(vmErr, "withdraw address cannot be empty")
+	})
+
+	suite.Run("pass - fund community pool", func() {
+		ret, _, vmErr := callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("communityPool()")))
+		suite.Require().Empty(vmErr)
+		poolBefore, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+
+		fundAmount := big.NewInt(1e9)
+		ret, logs, vmErr := callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("fundCommunityPool(uint256)"), fundAmount))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0FundCommunityPool, logs[0].Topics[0].String())
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(fundAmount.String(), new(big.Int).SetBytes(logs[0].Data).String())
+
+		ret, _, vmErr = callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("communityPool()")))
+		suite.Require().Empty(vmErr)
+		poolAfter, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(new(big.Int).Add(poolBefore, fundAmount).String(), poolAfter.String())
+	})
+
+	suite.Run("fail - fund community pool with zero amount", func() {
+		_, _, vmErr := callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("fundCommunityPool(uint256)"), big.NewInt(0)))
+		suite.Require().Contains(vmErr, "fund amount must be positive")
+	})
+
+	suite.Run("pass - query and withdraw validator commission", func() {
+		suite.createValidator(suite.Ctx(), operator, sdkmath.NewInt(1e9))
+		validator, err := suite.App().StakingKeeper().Validator(suite.Ctx(), operator.GetValidatorAddress())
+		suite.Require().NoError(err)
+
+		const rewardAmount = 1e9
+		rewardCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, rewardAmount))
+		suite.CITS.MintCoin(account2, rewardCoins[0])
+		err = suite.App().BankKeeper().SendCoinsFromAccountToModule(suite.Ctx(), account2.GetCosmosAddress(), disttypes.ModuleName, rewardCoins)
+		suite.Require().NoError(err)
+		err = suite.App().DistributionKeeper().AllocateTokensToValidator(suite.Ctx(), validator, sdk.NewDecCoinsFromCoins(rewardCoins...))
+		suite.Require().NoError(err)
+
+		ret, _, vmErr := callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("validatorOutstandingRewards(address)"), operator.GetEthAddress()))
+		suite.Require().Empty(vmErr)
+		outstanding, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(int64(rewardAmount), outstanding.Int64())
+
+		ret, _, vmErr = callContract(account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("validatorCommission(address)"), operator.GetEthAddress()))
+		suite.Require().Empty(vmErr)
+		commission, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(int64(rewardAmount/2), commission.Int64()) // commission rate is 50%
+
+		balanceBefore := suite.App().BankKeeper().GetBalance(suite.Ctx(), operator.GetCosmosAddress(), bondDenom)
+
+		ret, logs, vmErr := callContract(operator.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("withdrawValidatorCommission()")))
+		suite.Require().Empty(vmErr)
+		withdrawn, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(commission.String(), withdrawn.String())
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0WithdrawCommission, logs[0].Topics[0].String())
+		suite.Equal(operator.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(commission.String(), new(big.Int).SetBytes(logs[0].Data).String())
+
+		balanceAfter := suite.App().BankKeeper().GetBalance(suite.Ctx(), operator.GetCosmosAddress(), bondDenom)
+		suite.Equal(commission.String(), balanceAfter.Amount.Sub(balanceBefore.Amount).BigInt().String())
+	})
+
+	suite.Run("fail - withdraw commission of non-validator", func() {
+		_, _, vmErr := callContract(account2.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("withdrawValidatorCommission()")))
+		suite.Require().NotEmpty(vmErr)
+	})
+}
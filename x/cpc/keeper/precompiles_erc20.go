@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/EscanBE/everlast/x/cpc/abi"
 
@@ -14,11 +15,14 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+
+	"github.com/EscanBE/everlast/x/cpc/eip712"
 	"github.com/ethereum/go-ethereum/common"
 	corevm "github.com/ethereum/go-ethereum/core/vm"
 )
@@ -37,8 +41,11 @@ func (k Keeper) DeployErc20CustomPrecompiledContract(ctx sdk.Context, name strin
 		return common.Address{}, errorsmod.Wrapf(sdkerrors.ErrConflict, "existing contract for %s: %s", erc20Meta.MinDenom, common.BytesToAddress(existingAddrBz))
 	}
 
-	if !k.bankKeeper.GetSupply(ctx, erc20Meta.MinDenom).IsPositive() {
-		return common.Address{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "zero supply for %s", erc20Meta.MinDenom)
+	if !erc20Meta.IsManaged() {
+		// managed tokens are minted by the owner after deployment
+		if !k.bankKeeper.GetSupply(ctx, erc20Meta.MinDenom).IsPositive() {
+			return common.Address{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "zero supply for %s", erc20Meta.MinDenom)
+		}
 	}
 
 	// deployment
@@ -62,10 +69,45 @@ func (k Keeper) DeployErc20CustomPrecompiledContract(ctx sdk.Context, name strin
 	return newContractAddress, nil
 }
 
-// SetErc20CpcAllowance sets allowance for ERC20 custom precompiled contract.
-func (k Keeper) SetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Address, allowance *big.Int) {
+// CreateManagedErc20CustomPrecompiledContract creates a new factory denom with bank denom metadata,
+// then deploys a new managed ERC20 custom precompiled contract for it, owned by the creator.
+func (k Keeper) CreateManagedErc20CustomPrecompiledContract(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	subdenom, name, symbol string,
+	decimals uint8,
+) (denom string, contractAddr common.Address, err error) {
+	denom, err = cpctypes.GetFactoryDenom(creator.String(), subdenom)
+	if err != nil {
+		return
+	}
+
+	if k.bankKeeper.HasDenomMetaData(ctx, denom) || k.bankKeeper.HasSupply(ctx, denom) {
+		err = errorsmod.Wrapf(sdkerrors.ErrConflict, "denom already exists: %s", denom)
+		return
+	}
+
+	bankDenomMetadata := cpctypes.NewManagedErc20BankDenomMetadata(denom, name, symbol, decimals)
+	if err = bankDenomMetadata.Validate(); err != nil {
+		err = errorsmod.Wrap(errors.Join(sdkerrors.ErrInvalidRequest, err), "does not satisfy bank denom metadata validation")
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, bankDenomMetadata)
+
+	contractAddr, err = k.DeployErc20CustomPrecompiledContract(ctx, name, cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   symbol,
+		Decimals: decimals,
+		MinDenom: denom,
+		Owner:    common.BytesToAddress(creator).Hex(),
+	})
+	return
+}
+
+// SetErc20CpcAllowance sets allowance of the spender over the owner's tokens, for the ERC20 custom precompiled contract.
+func (k Keeper) SetErc20CpcAllowance(ctx sdk.Context, contractAddr, owner, spender common.Address, allowance *big.Int) {
 	store := ctx.KVStore(k.storeKey)
-	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(owner, spender)
+	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(contractAddr, owner, spender)
 
 	switch allowance.Sign() {
 	case 0:
@@ -82,10 +124,10 @@ func (k Keeper) SetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Addr
 	}
 }
 
-// GetErc20CpcAllowance returns allowance for ERC20 custom precompiled contract.
-func (k Keeper) GetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Address) *big.Int {
+// GetErc20CpcAllowance returns allowance of the spender over the owner's tokens, for the ERC20 custom precompiled contract.
+func (k Keeper) GetErc20CpcAllowance(ctx sdk.Context, contractAddr, owner, spender common.Address) *big.Int {
 	store := ctx.KVStore(k.storeKey)
-	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(owner, spender)
+	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(contractAddr, owner, spender)
 
 	bz := store.Get(key)
 	if len(bz) == 0 {
@@ -95,6 +137,91 @@ func (k Keeper) GetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Addr
 	return new(big.Int).SetBytes(bz)
 }
 
+// GetAllErc20CpcAllowances returns all allowances of the ERC20 custom precompiled contracts, used for exporting genesis.
+// Legacy allowances, which were not keyed by contract and are pruned by the store migration, are ignored.
+func (k Keeper) GetAllErc20CpcAllowances(ctx sdk.Context) []cpctypes.GenesisErc20Allowance {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, cpctypes.KeyPrefixErc20CpcAllowance)
+
+	var allowances []cpctypes.GenesisErc20Allowance
+
+	defer func() {
+		_ = iterator.Close()
+	}()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(cpctypes.KeyPrefixErc20CpcAllowance):]
+		if len(key) != 3*common.AddressLength {
+			continue
+		}
+		allowances = append(allowances, cpctypes.GenesisErc20Allowance{
+			ContractAddress: common.BytesToAddress(key[:common.AddressLength]).Hex(),
+			Owner:           common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength]).Hex(),
+			Spender:         common.BytesToAddress(key[2*common.AddressLength:]).Hex(),
+			Amount:          sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(iterator.Value())),
+		})
+	}
+
+	return allowances
+}
+
+// GetErc20CpcPermitNonce returns the current EIP-2612 permit nonce of the owner, for the ERC20 custom precompiled contract.
+func (k Keeper) GetErc20CpcPermitNonce(ctx sdk.Context, contractAddr, owner common.Address) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	key := cpctypes.Erc20CustomPrecompiledContractPermitNonceKey(contractAddr, owner)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncreaseErc20CpcPermitNonce increases the EIP-2612 permit nonce of the owner by one, for the ERC20 custom precompiled contract.
+func (k Keeper) IncreaseErc20CpcPermitNonce(ctx sdk.Context, contractAddr, owner common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	key := cpctypes.Erc20CustomPrecompiledContractPermitNonceKey(contractAddr, owner)
+
+	nonce := k.GetErc20CpcPermitNonce(ctx, contractAddr, owner)
+	store.Set(key, sdk.Uint64ToBigEndian(nonce+1))
+}
+
+// SetErc20CpcPermitNonce sets the EIP-2612 permit nonce of the owner, for the ERC20 custom precompiled contract.
+// Used for importing genesis.
+func (k Keeper) SetErc20CpcPermitNonce(ctx sdk.Context, contractAddr, owner common.Address, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := cpctypes.Erc20CustomPrecompiledContractPermitNonceKey(contractAddr, owner)
+
+	if nonce == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetAllErc20CpcPermitNonces returns all EIP-2612 permit nonces of the ERC20 custom precompiled contracts, used for exporting genesis.
+func (k Keeper) GetAllErc20CpcPermitNonces(ctx sdk.Context) []cpctypes.GenesisErc20PermitNonce {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, cpctypes.KeyPrefixErc20CpcPermitNonce)
+
+	var permitNonces []cpctypes.GenesisErc20PermitNonce
+
+	defer func() {
+		_ = iterator.Close()
+	}()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(cpctypes.KeyPrefixErc20CpcPermitNonce):]
+		permitNonces = append(permitNonces, cpctypes.GenesisErc20PermitNonce{
+			ContractAddress: common.BytesToAddress(key[:common.AddressLength]).Hex(),
+			Owner:           common.BytesToAddress(key[common.AddressLength:]).Hex(),
+			Nonce:           sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return permitNonces
+}
+
 // contract
 
 var _ CustomPrecompiledContractI = &erc20CustomPrecompiledContract{}
@@ -122,6 +249,18 @@ type erc20CustomPrecompiledContract struct {
 // Also supports burnable:
 //   - burnFrom(address,uint256)
 //   - burn(uint256)
+//
+// Also supports EIP-2612 permit:
+//   - permit(address,address,uint256,uint256,uint8,bytes32,bytes32)
+//   - nonces(address)
+//   - DOMAIN_SEPARATOR()
+//
+// Also supports managed tokens, restricted to the owner:
+//   - owner()
+//   - mint(address,uint256)
+//   - transferOwnership(address)
+//   - renounceOwnership()
+//   - setMetadata(string,string)
 func NewErc20CustomPrecompiledContract(
 	metadata cpctypes.CustomPrecompiledContractMeta,
 	keeper Keeper,
@@ -167,6 +306,30 @@ func NewErc20CustomPrecompiledContract(
 		&erc20CustomPrecompiledContractRwBurn{
 			transferFrom: transferFromME,
 		},
+		&erc20CustomPrecompiledContractRwPermit{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRoNonces{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRoDomainSeparator{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRoOwner{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRwMint{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRwTransferOwnership{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRwRenounceOwnership{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRwSetMetadata{
+			contract: contract,
+		},
 	}
 
 	return contract
@@ -192,6 +355,70 @@ func (m *erc20CustomPrecompiledContract) GetErc20Metadata() cpctypes.Erc20Custom
 	return meta
 }
 
+// getLatestMetadata returns the latest metadata from the store.
+// Name, symbol and owner of managed tokens can be changed during the execution,
+// so the in-memory metadata must not be used for them.
+func (m *erc20CustomPrecompiledContract) getLatestMetadata(ctx sdk.Context) (cpctypes.CustomPrecompiledContractMeta, cpctypes.Erc20CustomPrecompiledContractMeta) {
+	contractMeta := m.keeper.GetCustomPrecompiledContractMeta(ctx, common.BytesToAddress(m.metadata.Address))
+	if contractMeta == nil {
+		panic(fmt.Sprintf("contract not found: %s", common.BytesToAddress(m.metadata.Address)))
+	}
+
+	var erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta
+	if err := json.Unmarshal([]byte(contractMeta.TypedMeta), &erc20Meta); err != nil {
+		panic(err)
+	}
+
+	return *contractMeta, erc20Meta
+}
+
+// checkOwner returns error if the token is not managed or the account is not the owner.
+func (m *erc20CustomPrecompiledContract) checkOwner(ctx sdk.Context, account common.Address) error {
+	_, erc20Meta := m.getLatestMetadata(ctx)
+	if !erc20Meta.IsManaged() || erc20Meta.GetOwner() != account {
+		return fmt.Errorf(`OwnableUnauthorizedAccount("%s")`, account.String())
+	}
+	return nil
+}
+
+// setMetadata persists the updated name and ERC20 metadata.
+// The name is the EIP-712 domain name of permit, so renaming invalidates the permit signatures made before.
+func (m *erc20CustomPrecompiledContract) setMetadata(ctx sdk.Context, name string, erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta) error {
+	contractMeta, _ := m.getLatestMetadata(ctx)
+	contractMeta.Name = name
+	contractMeta.TypedMeta = string(cpcutils.MustMarshalJson(erc20Meta))
+
+	return m.keeper.SetCustomPrecompiledContractMeta(ctx, contractMeta, false)
+}
+
+// transferOwnership sets the new owner of the managed token and emits the OwnershipTransferred event.
+// Zero address new owner means renouncing the ownership.
+func (m *erc20CustomPrecompiledContract) transferOwnership(ctx sdk.Context, stateDB corevm.StateDB, contractAddr, newOwner common.Address) error {
+	contractMeta, erc20Meta := m.getLatestMetadata(ctx)
+	previousOwner := erc20Meta.GetOwner()
+
+	if newOwner == (common.Address{}) {
+		erc20Meta.Owner = ""
+	} else {
+		erc20Meta.Owner = newOwner.Hex()
+	}
+
+	if err := m.setMetadata(ctx, contractMeta.Name, erc20Meta); err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address: contractAddr,
+		Topics: []common.Hash{
+			common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"), // OwnershipTransferred(address,address)
+			common.BytesToHash(previousOwner.Bytes()),
+			common.BytesToHash(newOwner.Bytes()),
+		},
+	})
+
+	return nil
+}
+
 // ERC-20: name()
 
 var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRoName{}
@@ -200,12 +427,14 @@ type erc20CustomPrecompiledContractRoName struct {
 	contract *erc20CustomPrecompiledContract
 }
 
-func (e erc20CustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+func (e erc20CustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
 	_, err := abi.Erc20CpcInfo.UnpackMethodInput("name", input)
 	if err != nil {
 		return nil, err
 	}
-	return abi.Erc20CpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+
+	contractMeta, _ := e.contract.getLatestMetadata(env.ctx)
+	return abi.Erc20CpcInfo.PackMethodOutput("name", contractMeta.Name)
 }
 
 func (e erc20CustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
@@ -228,13 +457,14 @@ type erc20CustomPrecompiledContractRoSymbol struct {
 	contract *erc20CustomPrecompiledContract
 }
 
-func (e erc20CustomPrecompiledContractRoSymbol) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+func (e erc20CustomPrecompiledContractRoSymbol) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
 	_, err := abi.Erc20CpcInfo.UnpackMethodInput("symbol", input)
 	if err != nil {
 		return nil, err
 	}
 
-	return abi.Erc20CpcInfo.PackMethodOutput("symbol", e.contract.GetErc20Metadata().Symbol)
+	_, erc20Meta := e.contract.getLatestMetadata(env.ctx)
+	return abi.Erc20CpcInfo.PackMethodOutput("symbol", erc20Meta.Symbol)
 }
 
 func (e erc20CustomPrecompiledContractRoSymbol) Method4BytesSignatures() []byte {
@@ -373,7 +603,7 @@ func (e erc20CustomPrecompiledContractRwTransferFrom) Execute(caller corevm.Cont
 	}
 
 	if from != caller.Address() {
-		if err := e.spendAllowance(ctx, from, caller.Address(), amount); err != nil {
+		if err := e.spendAllowance(ctx, contractAddr, from, caller.Address(), amount); err != nil {
 			return nil, err
 		}
 	}
@@ -381,9 +611,9 @@ func (e erc20CustomPrecompiledContractRwTransferFrom) Execute(caller corevm.Cont
 	return e.transfer(ctx, from, to, amount, contractAddr, stateDB)
 }
 
-func (e erc20CustomPrecompiledContractRwTransferFrom) spendAllowance(ctx sdk.Context, owner, spender common.Address, amount *big.Int) error {
+func (e erc20CustomPrecompiledContractRwTransferFrom) spendAllowance(ctx sdk.Context, contractAddr, owner, spender common.Address, amount *big.Int) error {
 	// check allowance
-	currentAllowance := e.contract.keeper.GetErc20CpcAllowance(ctx, owner, spender)
+	currentAllowance := e.contract.keeper.GetErc20CpcAllowance(ctx, contractAddr, owner, spender)
 	if currentAllowance.Cmp(cpctypes.BigMaxUint256) == 0 {
 		// Does not update the allowance value in case of infinite allowance.
 	} else {
@@ -392,7 +622,7 @@ func (e erc20CustomPrecompiledContractRwTransferFrom) spendAllowance(ctx sdk.Con
 		}
 
 		currentAllowance = new(big.Int).Sub(currentAllowance, amount)
-		e.contract.keeper.SetErc20CpcAllowance(ctx, owner, spender, currentAllowance)
+		e.contract.keeper.SetErc20CpcAllowance(ctx, contractAddr, owner, spender, currentAllowance)
 	}
 
 	return nil
@@ -523,7 +753,14 @@ func (e erc20CustomPrecompiledContractRwApprove) Execute(caller corevm.ContractR
 		return nil, fmt.Errorf(`ERC20InvalidSpender("%s")`, spender.String())
 	}
 
-	e.contract.keeper.SetErc20CpcAllowance(ctx, owner, spender, value)
+	e.contract.approve(ctx, stateDB, contractAddr, owner, spender, value)
+
+	return abi.Erc20CpcInfo.PackMethodOutput("approve", true)
+}
+
+// approve sets the allowance of the spender over the owner's tokens and emits the Approval event.
+func (m erc20CustomPrecompiledContract) approve(ctx sdk.Context, stateDB corevm.StateDB, contractAddr, owner, spender common.Address, value *big.Int) {
+	m.keeper.SetErc20CpcAllowance(ctx, contractAddr, owner, spender, value)
 
 	stateDB.AddLog(&ethtypes.Log{
 		Address: contractAddr,
@@ -534,8 +771,6 @@ func (e erc20CustomPrecompiledContractRwApprove) Execute(caller corevm.ContractR
 		},
 		Data: common.BytesToHash(value.Bytes()).Bytes(),
 	})
-
-	return abi.Erc20CpcInfo.PackMethodOutput("approve", true)
 }
 
 func (e erc20CustomPrecompiledContractRwApprove) Method4BytesSignatures() []byte {
@@ -558,7 +793,7 @@ type erc20CustomPrecompiledContractRoAllowance struct {
 	contract *erc20CustomPrecompiledContract
 }
 
-func (e erc20CustomPrecompiledContractRoAllowance) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+func (e erc20CustomPrecompiledContractRoAllowance) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
 	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("allowance", input)
 	if err != nil {
 		return nil, err
@@ -568,7 +803,7 @@ func (e erc20CustomPrecompiledContractRoAllowance) Execute(_ corevm.ContractRef,
 	owner := ips[0].(common.Address)
 	spender := ips[1].(common.Address)
 
-	allowance := e.contract.keeper.GetErc20CpcAllowance(ctx, owner, spender)
+	allowance := e.contract.keeper.GetErc20CpcAllowance(ctx, contractAddr, owner, spender)
 
 	return abi.Erc20CpcInfo.PackMethodOutput("allowance", allowance)
 }
@@ -610,7 +845,7 @@ func (e erc20CustomPrecompiledContractRwBurnFrom) Execute(caller corevm.Contract
 	}
 
 	if address != caller.Address() {
-		if err := e.transferFrom.spendAllowance(ctx, address, caller.Address(), amount); err != nil {
+		if err := e.transferFrom.spendAllowance(ctx, contractAddr, address, caller.Address(), amount); err != nil {
 			return nil, err
 		}
 	}
@@ -668,3 +903,377 @@ func (e erc20CustomPrecompiledContractRwBurn) RequireGas() uint64 {
 func (e erc20CustomPrecompiledContractRwBurn) ReadOnly() bool {
 	return false
 }
+
+// EIP-2612: permit(address,address,uint256,uint256,uint8,bytes32,bytes32)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwPermit{}
+
+type erc20CustomPrecompiledContractRwPermit struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("permit", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	stateDB := env.evm.StateDB
+
+	owner := ips[0].(common.Address)
+	spender := ips[1].(common.Address)
+	value := ips[2].(*big.Int)
+	deadline := ips[3].(*big.Int)
+	v := ips[4].(uint8)
+	r := ips[5].([32]byte)
+	s := ips[6].([32]byte)
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, fmt.Errorf(`ERC2612ExpiredSignature(%s)`, deadline.String())
+	}
+
+	if owner == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidApprover("%s")`, owner.String())
+	} else if spender == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidSpender("%s")`, spender.String())
+	}
+
+	nonce := e.contract.keeper.GetErc20CpcPermitNonce(ctx, contractAddr, owner)
+	contractMeta, _ := e.contract.getLatestMetadata(ctx)
+
+	permitMessage := abi.Erc20PermitMessage{
+		Name:     contractMeta.Name,
+		Contract: contractAddr,
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Nonce:    new(big.Int).SetUint64(nonce),
+		Deadline: deadline,
+	}
+
+	match, recoveredAddr, err := eip712.VerifySignature(owner, permitMessage, r, s, v, env.evm.ChainConfig().ChainID)
+	if err != nil || !match {
+		return nil, fmt.Errorf(`ERC2612InvalidSigner("%s", "%s")`, recoveredAddr.String(), owner.String())
+	}
+
+	e.contract.keeper.IncreaseErc20CpcPermitNonce(ctx, contractAddr, owner)
+
+	e.contract.approve(ctx, stateDB, contractAddr, owner, spender, value)
+
+	return abi.Erc20CpcInfo.PackMethodOutput("permit")
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) Method4BytesSignatures() []byte {
+	return []byte{0xd5, 0x05, 0xac, 0xcf}
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) RequireGas() uint64 {
+	return 50_000
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) ReadOnly() bool {
+	return false
+}
+
+// EIP-2612: nonces(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRoNonces{}
+
+type erc20CustomPrecompiledContractRoNonces struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("nonces", input)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := ips[0].(common.Address)
+
+	nonce := e.contract.keeper.GetErc20CpcPermitNonce(env.ctx, contractAddr, owner)
+
+	return abi.Erc20CpcInfo.PackMethodOutput("nonces", new(big.Int).SetUint64(nonce))
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) Method4BytesSignatures() []byte {
+	return []byte{0x7e, 0xce, 0xbe, 0x00}
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) RequireGas() uint64 {
+	return 1000
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) ReadOnly() bool {
+	return true
+}
+
+// EIP-2612: DOMAIN_SEPARATOR()
+// The domain name is the current name of the contract, it changes when the contract is renamed.
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRoDomainSeparator{}
+
+type erc20CustomPrecompiledContractRoDomainSeparator struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.Erc20CpcInfo.UnpackMethodInput("DOMAIN_SEPARATOR", input)
+	if err != nil {
+		return nil, err
+	}
+
+	contractMeta, _ := e.contract.getLatestMetadata(env.ctx)
+	domainSeparator, err := eip712.GetTokenDomainSeparator(contractMeta.Name, contractAddr, env.evm.ChainConfig().ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Erc20CpcInfo.PackMethodOutput("DOMAIN_SEPARATOR", [32]byte(domainSeparator))
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) Method4BytesSignatures() []byte {
+	return []byte{0x36, 0x44, 0xe5, 0x15}
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) RequireGas() uint64 {
+	return 0
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) ReadOnly() bool {
+	return true
+}
+
+// Managed: owner()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRoOwner{}
+
+type erc20CustomPrecompiledContractRoOwner struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRoOwner) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.Erc20CpcInfo.UnpackMethodInput("owner", input)
+	if err != nil {
+		return nil, err
+	}
+
+	_, erc20Meta := e.contract.getLatestMetadata(env.ctx)
+
+	return abi.Erc20CpcInfo.PackMethodOutput("owner", erc20Meta.GetOwner())
+}
+
+func (e erc20CustomPrecompiledContractRoOwner) Method4BytesSignatures() []byte {
+	return []byte{0x8d, 0xa5, 0xcb, 0x5b}
+}
+
+func (e erc20CustomPrecompiledContractRoOwner) RequireGas() uint64 {
+	return 0
+}
+
+func (e erc20CustomPrecompiledContractRoOwner) ReadOnly() bool {
+	return true
+}
+
+// Managed: mint(address,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwMint{}
+
+type erc20CustomPrecompiledContractRwMint struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRwMint) Execute(caller corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("mint", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	stateDB := env.evm.StateDB
+
+	to := ips[0].(common.Address)
+	amount := ips[1].(*big.Int)
+
+	if err := e.contract.checkOwner(env.ctx, caller.Address()); err != nil {
+		return nil, err
+	}
+
+	if to == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidReceiver("%s")`, to.String())
+	}
+
+	if amount.Sign() != 0 {
+		coins := sdk.NewCoins(sdk.NewCoin(e.contract.GetErc20Metadata().MinDenom, sdkmath.NewIntFromBigInt(amount)))
+		if err := e.contract.keeper.bankKeeper.MintCoins(ctx, cpctypes.ModuleName, coins); err != nil {
+			return nil, errorsmod.Wrapf(errors.Join(cpctypes.ErrExecFailure, err), "failed to mint coins")
+		}
+		if err := e.contract.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, cpctypes.ModuleName, to.Bytes(), coins); err != nil {
+			return nil, errorsmod.Wrapf(errors.Join(cpctypes.ErrExecFailure, err), "failed to transfer minted coins")
+		}
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address: contractAddr,
+		Topics: []common.Hash{
+			common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+			common.BytesToHash(common.Address{}.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.BytesToHash(amount.Bytes()).Bytes(),
+	})
+
+	return abi.Erc20CpcInfo.PackMethodOutput("mint")
+}
+
+func (e erc20CustomPrecompiledContractRwMint) Method4BytesSignatures() []byte {
+	return []byte{0x40, 0xc1, 0x0f, 0x19}
+}
+
+func (e erc20CustomPrecompiledContractRwMint) RequireGas() uint64 {
+	return 20_000
+}
+
+func (e erc20CustomPrecompiledContractRwMint) ReadOnly() bool {
+	return false
+}
+
+// Managed: transferOwnership(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwTransferOwnership{}
+
+type erc20CustomPrecompiledContractRwTransferOwnership struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRwTransferOwnership) Execute(caller corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("transferOwnership", input)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner := ips[0].(common.Address)
+
+	if err := e.contract.checkOwner(env.ctx, caller.Address()); err != nil {
+		return nil, err
+	}
+
+	if newOwner == (common.Address{}) {
+		return nil, fmt.Errorf(`OwnableInvalidOwner("%s")`, newOwner.String())
+	}
+
+	if err := e.contract.transferOwnership(env.ctx, env.evm.StateDB, contractAddr, newOwner); err != nil {
+		return nil, err
+	}
+
+	return abi.Erc20CpcInfo.PackMethodOutput("transferOwnership")
+}
+
+func (e erc20CustomPrecompiledContractRwTransferOwnership) Method4BytesSignatures() []byte {
+	return []byte{0xf2, 0xfd, 0xe3, 0x8b}
+}
+
+func (e erc20CustomPrecompiledContractRwTransferOwnership) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e erc20CustomPrecompiledContractRwTransferOwnership) ReadOnly() bool {
+	return false
+}
+
+// Managed: renounceOwnership()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwRenounceOwnership{}
+
+type erc20CustomPrecompiledContractRwRenounceOwnership struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRwRenounceOwnership) Execute(caller corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.Erc20CpcInfo.UnpackMethodInput("renounceOwnership", input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.checkOwner(env.ctx, caller.Address()); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.transferOwnership(env.ctx, env.evm.StateDB, contractAddr, common.Address{}); err != nil {
+		return nil, err
+	}
+
+	return abi.Erc20CpcInfo.PackMethodOutput("renounceOwnership")
+}
+
+func (e erc20CustomPrecompiledContractRwRenounceOwnership) Method4BytesSignatures() []byte {
+	return []byte{0x71, 0x50, 0x18, 0xa6}
+}
+
+func (e erc20CustomPrecompiledContractRwRenounceOwnership) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e erc20CustomPrecompiledContractRwRenounceOwnership) ReadOnly() bool {
+	return false
+}
+
+// Managed: setMetadata(string,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwSetMetadata{}
+
+type erc20CustomPrecompiledContractRwSetMetadata struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRwSetMetadata) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("setMetadata", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	newName := ips[0].(string)
+	newSymbol := ips[1].(string)
+
+	if err := e.contract.checkOwner(env.ctx, caller.Address()); err != nil {
+		return nil, err
+	}
+
+	if newName == "" || strings.TrimSpace(newName) != newName {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "name cannot be empty or have leading/trailing white spaces")
+	}
+	if newSymbol == "" || strings.TrimSpace(newSymbol) != newSymbol {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "symbol cannot be empty or have leading/trailing white spaces")
+	}
+
+	_, erc20Meta := e.contract.getLatestMetadata(ctx)
+	erc20Meta.Symbol = newSymbol
+
+	bankDenomMetadata := cpctypes.NewManagedErc20BankDenomMetadata(erc20Meta.MinDenom, newName, newSymbol, erc20Meta.Decimals)
+	if err := bankDenomMetadata.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errors.Join(cpctypes.ErrInvalidCpcInput, err), "does not satisfy bank denom metadata validation")
+	}
+
+	if err := e.contract.setMetadata(ctx, newName, erc20Meta); err != nil {
+		return nil, err
+	}
+
+	e.contract.keeper.bankKeeper.SetDenomMetaData(ctx, bankDenomMetadata)
+
+	return abi.Erc20CpcInfo.PackMethodOutput("setMetadata")
+}
+
+func (e erc20CustomPrecompiledContractRwSetMetadata) Method4BytesSignatures() []byte {
+	return []byte{0x51, 0x33, 0x5b, 0x50}
+}
+
+func (e erc20CustomPrecompiledContractRwSetMetadata) RequireGas() uint64 {
+	return 30_000
+}
+
+func (e erc20CustomPrecompiledContractRwSetMetadata) ReadOnly() bool {
+	return false
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/EscanBE/everlast/constants"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *CpcTestSuite) TestKeeper_CreateManagedErc20Contract() {
+	creator := suite.CITS.WalletAccounts.Number(1)
+
+	msg := &cpctypes.MsgCreateManagedErc20ContractRequest{
+		Creator:  creator.GetCosmosAddress().String(),
+		Subdenom: "mytoken",
+		Name:     "My Token",
+		Symbol:   "MTK",
+		Decimals: 6,
+	}
+	suite.Require().NoError(msg.ValidateBasic())
+
+	msgServer := cpckeeper.NewMsgServerImpl(*suite.App().CpcKeeper())
+
+	res, err := msgServer.CreateManagedErc20Contract(suite.Ctx(), msg)
+	suite.Require().NoError(err)
+
+	wantDenom := "factory/" + creator.GetCosmosAddress().String() + "/mytoken"
+	suite.Equal(wantDenom, res.Denom)
+
+	contractAddr := suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(suite.Ctx(), wantDenom)
+	suite.Require().NotNil(contractAddr)
+	suite.Equal(contractAddr.Hex(), res.ContractAddress)
+
+	meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), *contractAddr)
+	suite.Require().NotNil(meta)
+	suite.Equal(cpctypes.CpcTypeErc20, meta.CustomPrecompiledType)
+	suite.Equal("My Token", meta.Name)
+	suite.JSONEq(`{"symbol":"MTK","decimals":6,"min_denom":"`+wantDenom+`","owner":"`+creator.GetEthAddress().Hex()+`"}`, meta.TypedMeta)
+
+	bankMetadata, found := suite.App().BankKeeper().GetDenomMetaData(suite.Ctx(), wantDenom)
+	suite.Require().True(found)
+	suite.Equal("My Token", bankMetadata.Name)
+	suite.Equal("MTK", bankMetadata.Symbol)
+	suite.Equal("MTK", bankMetadata.Display)
+
+	suite.Run("fail - can not create the same denom twice", func() {
+		_, err := msgServer.CreateManagedErc20Contract(suite.Ctx(), msg)
+		suite.Require().ErrorContains(err, "denom already exists")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract_Managed() {
+	ownerAccount := suite.CITS.WalletAccounts.Number(1)
+	owner := ownerAccount.GetEthAddress()
+	other := suite.CITS.WalletAccounts.Number(2).GetEthAddress()
+	receiver := common.BytesToAddress([]byte("receiver"))
+
+	denom, contractAddr, err := suite.App().CpcKeeper().CreateManagedErc20CustomPrecompiledContract(
+		suite.Ctx(), ownerAccount.GetCosmosAddress(), "mytoken", "My Token", "MTK", 6,
+	)
+	suite.Require().NoError(err)
+
+	callContract := func(from common.Address, input []byte) (ret []byte, logs []*ethtypes.Log, vmErr string) {
+		res, err := suite.EthCallApply(suite.Ctx(), &from, contractAddr, input)
+		suite.Require().NoError(err)
+
+		if res.VmError == "" {
+			var receipt ethtypes.Receipt
+			suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+			logs = receipt.Logs
+		}
+
+		return res.Ret, logs, res.VmError
+	}
+
+	getOwner := func() common.Address {
+		ret, _, vmErr := callContract(other, get4BytesSignature("owner()"))
+		suite.Require().Empty(vmErr)
+		return common.BytesToAddress(ret)
+	}
+
+	suite.Run("pass - owner()", func() {
+		suite.Equal(owner, getOwner())
+	})
+
+	suite.Run("pass - mint(address,uint256) by owner", func() {
+		amount := big.NewInt(1_000_000)
+
+		ret, logs, vmErr := callContract(owner, simpleBuildContractInput(get4BytesSignature("mint(address,uint256)"), receiver, amount))
+		suite.Require().Empty(vmErr)
+		suite.Empty(ret)
+
+		suite.Equal(amount.String(), suite.App().BankKeeper().GetBalance(suite.Ctx(), receiver.Bytes(), denom).Amount.String())
+		suite.Equal(amount.String(), suite.App().BankKeeper().GetSupply(suite.Ctx(), denom).Amount.String())
+
+		if suite.Len(logs, 1, "expect event Transfer") {
+			log := logs[0]
+			if suite.Len(log.Topics, 3, "expect 3 topics") {
+				// Transfer event
+				suite.Equal("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", log.Topics[0].String())
+				suite.Equal(common.Address{}.String(), common.BytesToAddress(log.Topics[1].Bytes()).String())
+				suite.Equal(receiver.String(), common.BytesToAddress(log.Topics[2].Bytes()).String())
+			}
+		}
+	})
+
+	suite.Run("fail - mint(address,uint256) by non-owner", func() {
+		_, _, vmErr := callContract(other, simpleBuildContractInput(get4BytesSignature("mint(address,uint256)"), receiver, big.NewInt(1)))
+		suite.Contains(vmErr, "OwnableUnauthorizedAccount")
+	})
+
+	suite.Run("fail - mint(address,uint256) to zero address", func() {
+		_, _, vmErr := callContract(owner, simpleBuildContractInput(get4BytesSignature("mint(address,uint256)"), common.Address{}, big.NewInt(1)))
+		suite.Contains(vmErr, "ERC20InvalidReceiver")
+	})
+
+	suite.Run("pass - setMetadata(string,string)", func() {
+		_, _, vmErr := callContract(owner, buildSetMetadataInput(suite, "New Token", "NTK"))
+		suite.Require().Empty(vmErr)
+
+		ret, _, vmErr := callContract(other, get4BytesSignature("name()"))
+		suite.Require().Empty(vmErr)
+		gotName, err := cpcutils.AbiDecodeString(ret)
+		suite.Require().NoError(err)
+		suite.Equal("New Token", gotName)
+
+		ret, _, vmErr = callContract(other, get4BytesSignature("symbol()"))
+		suite.Require().Empty(vmErr)
+		gotSymbol, err := cpcutils.AbiDecodeString(ret)
+		suite.Require().NoError(err)
+		suite.Equal("NTK", gotSymbol)
+
+		bankMetadata, found := suite.App().BankKeeper().GetDenomMetaData(suite.Ctx(), denom)
+		suite.Require().True(found)
+		suite.Equal("New Token", bankMetadata.Name)
+		suite.Equal("NTK", bankMetadata.Symbol)
+		suite.Equal("NTK", bankMetadata.Display)
+	})
+
+	suite.Run("fail - setMetadata(string,string) by non-owner", func() {
+		_, _, vmErr := callContract(other, buildSetMetadataInput(suite, "Other Token", "OTK"))
+		suite.Contains(vmErr, "OwnableUnauthorizedAccount")
+	})
+
+	suite.Run("fail - setMetadata(string,string) with empty symbol", func() {
+		_, _, vmErr := callContract(owner, buildSetMetadataInput(suite, "Other Token", ""))
+		suite.Contains(vmErr, "symbol cannot be empty")
+	})
+
+	suite.Run("fail - transferOwnership(address) to zero address", func() {
+		_, _, vmErr := callContract(owner, simpleBuildContractInput(get4BytesSignature("transferOwnership(address)"), common.Address{}))
+		suite.Contains(vmErr, "OwnableInvalidOwner")
+	})
+
+	suite.Run("pass - transferOwnership(address)", func() {
+		_, logs, vmErr := callContract(owner, simpleBuildContractInput(get4BytesSignature("transferOwnership(address)"), other))
+		suite.Require().Empty(vmErr)
+		suite.Equal(other, getOwner())
+
+		if suite.Len(logs, 1, "expect event OwnershipTransferred") {
+			log := logs[0]
+			if suite.Len(log.Topics, 3, "expect 3 topics") {
+				// OwnershipTransferred event
+				suite.Equal("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0", log.Topics[0].String())
+				suite.Equal(owner.String(), common.BytesToAddress(log.Topics[1].Bytes()).String())
+				suite.Equal(other.String(), common.BytesToAddress(log.Topics[2].Bytes()).String())
+			}
+		}
+
+		// previous owner can no longer mint
+		_, _, vmErr = callContract(owner, simpleBuildContractInput(get4BytesSignature("mint(address,uint256)"), receiver, big.NewInt(1)))
+		suite.Contains(vmErr, "OwnableUnauthorizedAccount")
+	})
+
+	suite.Run("pass - renounceOwnership()", func() {
+		_, logs, vmErr := callContract(other, get4BytesSignature("renounceOwnership()"))
+		suite.Require().Empty(vmErr)
+		suite.Equal(common.Address{}, getOwner())
+		suite.Len(logs, 1, "expect event OwnershipTransferred")
+
+		_, _, vmErr = callContract(other, simpleBuildContractInput(get4BytesSignature("mint(address,uint256)"), receiver, big.NewInt(1)))
+		suite.Contains(vmErr, "OwnableUnauthorizedAccount")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract_NonManaged() {
+	contractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), constants.DisplayDenom, cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   constants.DisplayDenom,
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: constants.BaseDenom,
+	})
+	suite.Require().NoError(err)
+
+	from := suite.CITS.WalletAccounts.Number(1).GetEthAddress()
+
+	suite.Run("pass - owner() returns zero address", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), &from, contractAddr, get4BytesSignature("owner()"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(common.Address{}, common.BytesToAddress(res.Ret))
+	})
+
+	suite.Run("fail - mint(address,uint256) is not allowed", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), &from, contractAddr, simpleBuildContractInput(get4BytesSignature("mint(address,uint256)"), from, big.NewInt(1)))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "OwnableUnauthorizedAccount")
+	})
+}
+
+func buildSetMetadataInput(suite *CpcTestSuite, name, symbol string) []byte {
+	input, err := abi.Erc20CpcInfo.ABI.Pack("setMetadata", name, symbol)
+	suite.Require().NoError(err)
+	return input
+}
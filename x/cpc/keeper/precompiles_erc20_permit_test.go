@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/EscanBE/everlast/constants"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	"github.com/EscanBE/everlast/x/cpc/eip712"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract_Permit() {
+	erc20Meta := cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   constants.DisplayDenom,
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: constants.BaseDenom,
+	}
+
+	contractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), constants.DisplayDenom, erc20Meta)
+	suite.Require().NoError(err)
+
+	ownerAccount := suite.CITS.WalletAccounts.Number(1)
+	owner := ownerAccount.GetEthAddress()
+	relayer := suite.CITS.WalletAccounts.Number(2).GetEthAddress()
+	spender := common.BytesToAddress([]byte("spender"))
+
+	chainId := suite.App().EvmKeeper().GetEip155ChainId(suite.Ctx()).BigInt()
+
+	nonces := func() *big.Int {
+		input := simpleBuildContractInput(get4BytesSignature("nonces(address)"), owner)
+
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		nonce, err := cpcutils.AbiDecodeUint256(res.Ret)
+		suite.Require().NoError(err)
+		return nonce
+	}
+
+	allowanceOf := func(contractAddr common.Address) *big.Int {
+		input := simpleBuildContractInput(get4BytesSignature("allowance(address,address)"), owner, spender)
+
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		allowance, err := cpcutils.AbiDecodeUint256(res.Ret)
+		suite.Require().NoError(err)
+		return allowance
+	}
+
+	allowance := func() *big.Int {
+		return allowanceOf(contractAddr)
+	}
+
+	buildPermitInputForDomain := func(name string, contractAddr, spender common.Address, value, nonce, deadline *big.Int) []byte {
+		r, s, v := suite.hashEip712Message(abi.Erc20PermitMessage{
+			Name:     name,
+			Contract: contractAddr,
+			Owner:    owner,
+			Spender:  spender,
+			Value:    value,
+			Nonce:    nonce,
+			Deadline: deadline,
+		}, ownerAccount)
+
+		input, err := abi.Erc20CpcInfo.ABI.Pack("permit", owner, spender, value, deadline, v, r, s)
+		suite.Require().NoError(err)
+		return input
+	}
+
+	buildPermitInput := func(spender common.Address, value, nonce, deadline *big.Int) []byte {
+		return buildPermitInputForDomain(constants.DisplayDenom, contractAddr, spender, value, nonce, deadline)
+	}
+
+	deadline := big.NewInt(suite.Ctx().BlockTime().Add(time.Hour).Unix())
+	value := big.NewInt(500)
+
+	suite.Run("pass - DOMAIN_SEPARATOR()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, get4BytesSignature("DOMAIN_SEPARATOR()"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		wantDomainSeparator, err := eip712.GetTokenDomainSeparator(constants.DisplayDenom, contractAddr, chainId)
+		suite.Require().NoError(err)
+		suite.Equal(wantDomainSeparator.Bytes(), res.Ret)
+	})
+
+	suite.Run("pass - nonces(address) starts from zero", func() {
+		suite.Equal(int64(0), nonces().Int64())
+	})
+
+	suite.Run("pass - permit(...) submitted by relayer", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, buildPermitInput(spender, value, big.NewInt(0), deadline))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Empty(res.Ret)
+
+		suite.Equal(value.String(), allowance().String())
+		suite.Equal(int64(1), nonces().Int64())
+
+		var receipt ethtypes.Receipt
+		err = receipt.UnmarshalBinary(res.MarshalledReceipt)
+		suite.Require().NoError(err)
+		if suite.Len(receipt.Logs, 1, "expect event Approval") {
+			log := receipt.Logs[0]
+			if suite.Len(log.Topics, 3, "expect 3 topics") {
+				// Approval event
+				suite.Equal("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925", log.Topics[0].String())
+				suite.Equal(owner.String(), common.BytesToAddress(log.Topics[1].Bytes()).String())
+				suite.Equal(spender.String(), common.BytesToAddress(log.Topics[2].Bytes()).String())
+			}
+		}
+	})
+
+	suite.Run("fail - permit(...) can not be replayed", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, buildPermitInput(spender, value, big.NewInt(0), deadline))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612InvalidSigner")
+
+		suite.Equal(int64(1), nonces().Int64())
+	})
+
+	suite.Run("fail - permit(...) with expired deadline", func() {
+		expired := big.NewInt(suite.Ctx().BlockTime().Add(-time.Second).Unix())
+
+		res, err := suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, buildPermitInput(spender, value, nonces(), expired))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612ExpiredSignature")
+	})
+
+	suite.Run("fail - permit(...) to zero spender", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, buildPermitInput(common.Address{}, value, nonces(), deadline))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC20InvalidSpender")
+	})
+
+	suite.Run("fail - permit(...) signed with another token name", func() {
+		input := buildPermitInputForDomain("Another Token", contractAddr, spender, value, nonces(), deadline)
+		res, err := suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612InvalidSigner")
+	})
+
+	suite.Run("fail - permit(...) signed for another contract does not grant allowance", func() {
+		const otherName = "Other Token"
+		suite.CITS.MintCoin(ownerAccount, sdk.NewCoin("uother", sdkmath.NewInt(1000)))
+		otherContractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), otherName, cpctypes.Erc20CustomPrecompiledContractMeta{
+			Symbol:   "OTHER",
+			Decimals: 6,
+			MinDenom: "uother",
+		})
+		suite.Require().NoError(err)
+
+		suite.Require().Zero(allowanceOf(otherContractAddr).Sign())
+		allowanceBefore := allowance()
+
+		// a permit for this contract must not be accepted by the other contract
+		input := buildPermitInput(spender, big.NewInt(1000), nonces(), deadline)
+		res, err := suite.EthCallApply(suite.Ctx(), &relayer, otherContractAddr, input)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612InvalidSigner")
+
+		// a valid permit on this contract must not grant allowance on the other contract
+		res, err = suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		suite.Equal("1000", allowance().String())
+		suite.NotEqual(allowanceBefore.String(), allowance().String())
+		suite.Zero(allowanceOf(otherContractAddr).Sign(), "allowance must not leak to the other contract")
+
+		// and the other way around
+		otherInput := buildPermitInputForDomain(otherName, otherContractAddr, spender, big.NewInt(7), big.NewInt(0), deadline)
+		res, err = suite.EthCallApply(suite.Ctx(), &relayer, otherContractAddr, otherInput)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		suite.Equal("7", allowanceOf(otherContractAddr).String())
+		suite.Equal("1000", allowance().String(), "allowance of the other contract must not overwrite")
+	})
+
+	suite.Run("pass - renaming changes DOMAIN_SEPARATOR() and invalidates the permit signed before", func() {
+		const newName = "Renamed Token"
+
+		signedBeforeRename := buildPermitInput(spender, big.NewInt(11), nonces(), deadline)
+
+		err := suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), contractAddr, newName, "", false)
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, get4BytesSignature("DOMAIN_SEPARATOR()"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		wantDomainSeparator, err := eip712.GetTokenDomainSeparator(newName, contractAddr, chainId)
+		suite.Require().NoError(err)
+		suite.Equal(wantDomainSeparator.Bytes(), res.Ret)
+
+		res, err = suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, signedBeforeRename)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612InvalidSigner")
+
+		res, err = suite.EthCallApply(suite.Ctx(), &relayer, contractAddr, buildPermitInputForDomain(newName, contractAddr, spender, big.NewInt(11), nonces(), deadline))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal("11", allowance().String())
+	})
+}
@@ -101,29 +101,36 @@ func (suite *CpcTestSuite) TestKeeper_DeployErc20CustomPrecompiledContract() {
 }
 
 func (suite *CpcTestSuite) TestKeeper_SetErc20CpcAllowance() {
+	contractAddr := common.BytesToAddress([]byte("contract"))
+	otherContractAddr := common.BytesToAddress([]byte("other-contract"))
 	owner := common.BytesToAddress([]byte("owner"))
 	spender := common.BytesToAddress([]byte("spender"))
 
 	suite.Run("pass - (get) when not set, returns empty", func() {
-		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), owner, spender)
+		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), contractAddr, owner, spender)
 		suite.Zero(allowance.Sign())
 	})
 
 	suite.Run("pass - (set) can set", func() {
-		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), owner, spender, big.NewInt(2))
+		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), contractAddr, owner, spender, big.NewInt(2))
 	})
 
 	suite.Run("pass - (get) returns correctly", func() {
-		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), owner, spender)
+		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), contractAddr, owner, spender)
 		suite.Equal("2", allowance.String())
 	})
 
+	suite.Run("pass - (get) allowance is specific to the contract", func() {
+		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), otherContractAddr, owner, spender)
+		suite.Zero(allowance.Sign())
+	})
+
 	suite.Run("pass - (get/set) can working with max uint256", func() {
 		maxUint256 := cpctypes.BigMaxUint256
 		suite.Require().Equal(1, maxUint256.Sign())
-		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), owner, spender, maxUint256)
+		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), contractAddr, owner, spender, maxUint256)
 
-		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), owner, spender)
+		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), contractAddr, owner, spender)
 		suite.Require().Equal(maxUint256, allowance)
 	})
 
@@ -132,7 +139,7 @@ func (suite *CpcTestSuite) TestKeeper_SetErc20CpcAllowance() {
 		suite.Require().Equal(1, uint264.Sign())
 
 		suite.Require().Panics(func() {
-			suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), owner, spender, uint264)
+			suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), contractAddr, owner, spender, uint264)
 		})
 	})
 }
@@ -376,7 +383,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		suite.Require().NoError(err)
 		suite.Empty(res.VmError)
 
-		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 
 		// spender transfer on-behalf of owner
 		balanceOfSenderBefore := balance(ctx, owner)
@@ -399,7 +406,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		balanceOfReceiverAfter := balance(ctx, receiver)
 		suite.Equal(balanceOfReceiverAfter.Sub(balanceOfReceiverBefore).String(), transferAmount.String())
 
-		suite.Equal(new(big.Int).Sub(grantAmount, transferAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Equal(new(big.Int).Sub(grantAmount, transferAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 	})
 
 	suite.Run("pass - transferFrom(address,address,uint256) with infinity allowance", func() {
@@ -439,7 +446,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 
 		suite.Equal(
 			cpctypes.BigMaxUint256.String(),
-			suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String(),
+			suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String(),
 			"should not update the infinite allowance",
 		)
 	})
@@ -459,7 +466,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		suite.Require().NoError(err)
 		suite.Empty(res.VmError)
 
-		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 
 		// spender transfer on-behalf of owner
 		balanceOfSenderBefore := balance(ctx, owner)
@@ -478,7 +485,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		balanceOfReceiverAfter := balance(ctx, receiver)
 		suite.Equal(balanceOfReceiverBefore.String(), balanceOfReceiverAfter.String())
 
-		suite.Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String()) // unchanged
+		suite.Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String()) // unchanged
 	})
 
 	suite.Run("fail - transferFrom(address,address,uint256) without allowance", func() {
@@ -595,7 +602,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		suite.Require().NoError(err)
 		suite.Empty(res.VmError)
 
-		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 
 		// spender burns on-behalf of owner
 		balanceOfOwnerBefore := balance(ctx, owner)
@@ -614,7 +621,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		balanceOfOwnerAfter := balance(ctx, owner)
 		suite.Equal(balanceOfOwnerBefore.Sub(balanceOfOwnerAfter).String(), burnAmount.String())
 
-		suite.Equal(new(big.Int).Sub(grantAmount, burnAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Equal(new(big.Int).Sub(grantAmount, burnAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 	})
 
 	suite.Run("pass - burn(uint256)", func() {
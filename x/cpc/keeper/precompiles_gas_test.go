@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"math"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (suite *CpcTestSuite) TestKeeper_CustomPrecompiledContractGasSchedule() {
+	suite.SetupStakingCPC()
+
+	validator1 := suite.CITS.ValidatorAccounts.Number(1)
+	input := simpleBuildContractInput(get4BytesSignature("delegatedValidators(address)"), validator1.GetEthAddress())
+
+	setGasSchedule := func(ctx sdk.Context, gasSchedule ...cpctypes.CustomPrecompiledContractMethodGas) {
+		params := suite.App().CpcKeeper().GetParams(ctx)
+		params.GasSchedule = gasSchedule
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+	}
+
+	delegatedValidatorsGas := func(baseGas, gasPerByte, gasPerIteration uint64) cpctypes.CustomPrecompiledContractMethodGas {
+		return cpctypes.CustomPrecompiledContractMethodGas{
+			CustomPrecompiledType: cpctypes.CpcTypeStaking,
+			MethodSelector:        "0x5fdb550d",
+			BaseGas:               baseGas,
+			GasPerByte:            gasPerByte,
+			GasPerIteration:       gasPerIteration,
+		}
+	}
+
+	res, err := suite.EthCallApply(suite.Ctx(), validator1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+	defaultGasUsed := res.GasUsed
+
+	const defaultRequireGas = 10_000
+
+	suite.Run("base gas overrides the default gas", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setGasSchedule(ctx, delegatedValidatorsGas(50_000, 0, 0))
+
+		res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(defaultGasUsed-defaultRequireGas+50_000, res.GasUsed)
+	})
+
+	suite.Run("zero base gas keeps the default gas", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setGasSchedule(ctx, delegatedValidatorsGas(0, 0, 0))
+
+		res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(defaultGasUsed, res.GasUsed)
+	})
+
+	suite.Run("charges per byte of input and per iteration", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setGasSchedule(ctx, delegatedValidatorsGas(0, 10, 7_000))
+
+		delegations, err := suite.App().StakingKeeper().GetAllDelegatorDelegations(ctx, validator1.GetCosmosAddress())
+		suite.Require().NoError(err)
+		suite.Require().NotEmpty(delegations)
+
+		res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(defaultGasUsed+10*uint64(len(input))+7_000*uint64(len(delegations)), res.GasUsed)
+	})
+
+	suite.Run("out of gas when dynamic gas exceeds the remaining gas", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setGasSchedule(ctx, delegatedValidatorsGas(0, 0, math.MaxUint64))
+
+		res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Equal(vm.ErrOutOfGas.Error(), res.VmError)
+		suite.Empty(res.Ret)
+	})
+
+	suite.Run("gas schedule of other type does not apply", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		gas := delegatedValidatorsGas(50_000, 10, 7_000)
+		gas.CustomPrecompiledType = cpctypes.CpcTypeErc20
+		setGasSchedule(ctx, gas)
+
+		res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(defaultGasUsed, res.GasUsed)
+	})
+
+	suite.Run("state changes are reverted when dynamic gas exceeds the remaining gas", func() {
+		account1 := suite.CITS.WalletAccounts.Number(1)
+		suite.CITS.TxPrepareContextWithdrawDelegatorAndValidatorReward(account1, 1, 10)
+
+		ctx, _ := suite.Ctx().CacheContext()
+		setGasSchedule(ctx, cpctypes.CustomPrecompiledContractMethodGas{
+			CustomPrecompiledType: cpctypes.CpcTypeStaking,
+			MethodSelector:        "0xc7b8981c", // withdrawRewards()
+			GasPerIteration:       math.MaxUint64,
+		})
+
+		bondDenom := suite.bondDenom(ctx)
+		balanceBefore := suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), bondDenom)
+
+		input := simpleBuildContractInput(get4BytesSignature("withdrawRewards()"))
+		res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Equal(vm.ErrOutOfGas.Error(), res.VmError)
+
+		balanceAfter := suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), bondDenom)
+		suite.Equal(balanceBefore.String(), balanceAfter.String(), "rewards must not be withdrawn")
+	})
+
+	suite.Run("dynamic gas of the calls made by multicall is charged during the execution", func() {
+		multicallInput, err := abi.MulticallCpcInfo.ABI.Pack("multicall", []abi.MulticallCall{{
+			Target:   cpctypes.CpcStakingFixedAddress,
+			CallData: input,
+		}})
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), validator1.GetEthAddressP(), cpctypes.CpcMulticallFixedAddress, multicallInput)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		multicallGasUsed := res.GasUsed
+
+		suite.Run("charged", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+			setGasSchedule(ctx, delegatedValidatorsGas(0, 10, 7_000))
+
+			delegations, err := suite.App().StakingKeeper().GetAllDelegatorDelegations(ctx, validator1.GetCosmosAddress())
+			suite.Require().NoError(err)
+
+			res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcMulticallFixedAddress, multicallInput)
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Equal(multicallGasUsed+10*uint64(len(input))+7_000*uint64(len(delegations)), res.GasUsed)
+		})
+
+		suite.Run("out of gas", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+			setGasSchedule(ctx, delegatedValidatorsGas(0, 0, math.MaxUint64))
+
+			res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcMulticallFixedAddress, multicallInput)
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, vm.ErrOutOfGas.Error())
+		})
+	})
+
+	suite.Run("dynamic gas of the calls made by contract is charged from the gas provided to the call", func() {
+		proxy := suite.CITS.WalletAccounts.Number(2).GetEthAddress()
+		setProxy := func(ctx sdk.Context) {
+			code := proxyContractCode(cpctypes.CpcStakingFixedAddress)
+			codeHash := crypto.Keccak256Hash(code)
+			suite.App().EvmKeeper().SetCode(ctx, codeHash.Bytes(), code)
+			suite.App().EvmKeeper().SetCodeHash(ctx, proxy, codeHash)
+		}
+
+		ctx, _ := suite.Ctx().CacheContext()
+		setProxy(ctx)
+		res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), proxy, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		proxyGasUsed := res.GasUsed
+
+		const gasLimit = 300_000
+
+		suite.Run("charged", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+			setProxy(ctx)
+			setGasSchedule(ctx, delegatedValidatorsGas(0, 10, 7_000))
+
+			delegations, err := suite.App().StakingKeeper().GetAllDelegatorDelegations(ctx, validator1.GetCosmosAddress())
+			suite.Require().NoError(err)
+
+			res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), proxy, input)
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Equal(proxyGasUsed+10*uint64(len(input))+7_000*uint64(len(delegations)), res.GasUsed)
+		})
+
+		suite.Run("not limited to the gas left to the calling contract", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+			setProxy(ctx)
+
+			delegations, err := suite.App().StakingKeeper().GetAllDelegatorDelegations(ctx, validator1.GetCosmosAddress())
+			suite.Require().NoError(err)
+
+			// far more than 1/64 of the gas kept by the calling contract, but less than the gas provided to the call
+			gasPerIteration := uint64(gasLimit/2) / uint64(len(delegations))
+			setGasSchedule(ctx, delegatedValidatorsGas(0, 0, gasPerIteration))
+
+			res, err := suite.EthCallApplyWithGas(ctx, validator1.GetEthAddressP(), proxy, input, gasLimit)
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Equal(proxyGasUsed+gasPerIteration*uint64(len(delegations)), res.GasUsed)
+		})
+
+		suite.Run("out of gas when dynamic gas exceeds the gas provided to the call", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+			setProxy(ctx)
+			setGasSchedule(ctx, delegatedValidatorsGas(0, 0, gasLimit))
+
+			res, err := suite.EthCallApplyWithGas(ctx, validator1.GetEthAddressP(), proxy, input, gasLimit)
+			suite.Require().NoError(err)
+			suite.Equal(vm.ErrExecutionReverted.Error(), res.VmError, "the call must fail and the contract reverts")
+			suite.Less(res.GasUsed, uint64(gasLimit), "the gas kept by the calling contract must be returned")
+		})
+	})
+}
+
+// proxyContractCode returns the runtime code of a contract that forwards the call data to the target with all the gas,
+// then returns the return data, or reverts with it if the call failed.
+func proxyContractCode(target common.Address) []byte {
+	code := []byte{
+		0x36,       // CALLDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0x60, 0x00, // PUSH1 0
+		0x37,       // CALLDATACOPY
+		0x60, 0x00, // PUSH1 0 (retSize)
+		0x60, 0x00, // PUSH1 0 (retOffset)
+		0x36,       // CALLDATASIZE (argsSize)
+		0x60, 0x00, // PUSH1 0 (argsOffset)
+		0x60, 0x00, // PUSH1 0 (value)
+		0x73, // PUSH20 target
+	}
+	code = append(code, target.Bytes()...)
+	return append(code,
+		0x5a,       // GAS
+		0xf1,       // CALL
+		0x3d,       // RETURNDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0x60, 0x00, // PUSH1 0
+		0x3e,       // RETURNDATACOPY
+		0x60, 0x33, // PUSH1 51
+		0x57,       // JUMPI
+		0x3d,       // RETURNDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0xfd,       // REVERT
+		0x5b,       // JUMPDEST (51)
+		0x3d,       // RETURNDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0xf3, // RETURN
+	)
+}
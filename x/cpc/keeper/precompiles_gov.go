@@ -0,0 +1,778 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployGovCustomPrecompiledContract deploys a new gov custom precompiled contract.
+func (k Keeper) DeployGovCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcGovFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeGov,
+		Name:                  "Gov - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &govCustomPrecompiledContract{}
+
+// govCustomPrecompiledContract allows EVM accounts to interact with the `x/gov` module.
+type govCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewGovCustomPrecompiledContract creates a new gov custom precompiled contract.
+func NewGovCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &govCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&govCustomPrecompiledContractRoName{contract: contract},
+		&govCustomPrecompiledContractRoProposal{contract: contract},
+		&govCustomPrecompiledContractRoTallyResult{contract: contract},
+		&govCustomPrecompiledContractRoVoteOf{contract: contract},
+		&govCustomPrecompiledContractRoDepositOf{contract: contract},
+		&govCustomPrecompiledContractRwSubmitProposal{contract: contract},
+		&govCustomPrecompiledContractRwDeposit{contract: contract},
+		&govCustomPrecompiledContractRwVote{contract: contract},
+		&govCustomPrecompiledContractRwVoteWeighted{contract: contract},
+	}
+
+	return contract
+}
+
+func (m govCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m govCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m govCustomPrecompiledContract) emitsEventSubmitProposal(proposer common.Address, proposalId uint64, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcGovFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0xf49a3a8232aff8553333cfd734e3a7ef1ab4764cd0494eb145216773b64bf349"), // SubmitProposal(address,uint64)
+			common.BytesToHash(proposer.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(proposalId)),
+		},
+	})
+}
+
+func (m govCustomPrecompiledContract) emitsEventDeposit(depositor common.Address, proposalId uint64, amount *big.Int, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcGovFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x685c54f1ed866ac5147f6f2eb395af5c2402e0c09df9227ef0b61e1b3f83083d"), // Deposit(address,uint64,uint256)
+			common.BytesToHash(depositor.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(proposalId)),
+		},
+		Data: common.BytesToHash(amount.Bytes()).Bytes(),
+	})
+}
+
+func (m govCustomPrecompiledContract) emitsEventVote(voter common.Address, proposalId uint64, option uint8, weight *big.Int, env cpcExecutorEnv) {
+	data := make([]byte, 0, 64)
+	data = append(data, common.BytesToHash([]byte{option}).Bytes()...)
+	data = append(data, common.BytesToHash(weight.Bytes()).Bytes()...)
+
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcGovFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x100a07a0172b248dc40ae6a6db63a5401e761f6e102caf81a1a2030de4e6cc9a"), // Vote(address,uint64,uint8,uint256)
+			common.BytesToHash(voter.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(proposalId)),
+		},
+		Data: data,
+	})
+}
+
+// autoEmitEventsFromSdkEvents emits SubmitProposal/Deposit/Vote events based on sdk events emitted by the gov module.
+func (m govCustomPrecompiledContract) autoEmitEventsFromSdkEvents(
+	em sdk.EventManagerI, originalEventCounts int, env cpcExecutorEnv,
+) error {
+	events := m.getSdkEventsFromEventManager(em)
+	if len(events) <= originalEventCounts {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "no new-event found")
+	}
+
+	if originalEventCounts > 0 {
+		// emit new events only to avoid re-emitting the same events which was already emitted
+		events = events[originalEventCounts:]
+	}
+
+	bondDenom, err := m.keeper.stakingKeeper.BondDenom(env.ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to get bond denom")
+	}
+
+	for _, event := range events {
+		avProposalId := event.Attributes[govtypes.AttributeKeyProposalID]
+		proposalId, err := strconv.ParseUint(avProposalId, 10, 64)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to parse proposal id: %s", avProposalId)
+		}
+
+		if event.Type == govtypes.EventTypeSubmitProposal {
+			avProposer := event.Attributes[govtypes.AttributeKeyProposalProposer]
+			proposer, err := sdk.AccAddressFromBech32(avProposer)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to parse proposer address: %s", avProposer)
+			}
+
+			m.emitsEventSubmitProposal(common.BytesToAddress(proposer), proposalId, env)
+		} else if event.Type == govtypes.EventTypeProposalDeposit {
+			avDepositor := event.Attributes[govtypes.AttributeKeyDepositor]
+			depositor, err := sdk.AccAddressFromBech32(avDepositor)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to parse depositor address: %s", avDepositor)
+			}
+
+			avAmount := event.Attributes[sdk.AttributeKeyAmount]
+			coins, err := sdk.ParseCoinsNormalized(avAmount)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to parse coins: %s", avAmount)
+			}
+
+			amount := coins.AmountOf(bondDenom).BigInt()
+			if amount.Sign() == 1 {
+				m.emitsEventDeposit(common.BytesToAddress(depositor), proposalId, amount, env)
+			}
+		} else if event.Type == govtypes.EventTypeProposalVote {
+			avVoter := event.Attributes[govtypes.AttributeKeyVoter]
+			voter, err := sdk.AccAddressFromBech32(avVoter)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to parse voter address: %s", avVoter)
+			}
+
+			avOption := event.Attributes[govtypes.AttributeKeyOption]
+			var options govv1.WeightedVoteOptions
+			if err := json.Unmarshal([]byte(avOption), &options); err != nil {
+				return errorsmod.Wrapf(err, "failed to parse vote options: %s", avOption)
+			}
+
+			for _, option := range options {
+				weight, err := sdkmath.LegacyNewDecFromStr(option.Weight)
+				if err != nil {
+					return errorsmod.Wrapf(err, "failed to parse vote weight: %s", option.Weight)
+				}
+
+				m.emitsEventVote(common.BytesToAddress(voter), proposalId, uint8(option.Option), weight.BigInt(), env)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m govCustomPrecompiledContract) getSdkEventsFromEventManager(em sdk.EventManagerI) []normalizedEvent {
+	return findEvents(em, func(event sdk.Event) *normalizedEvent {
+		newNormalizedEvent := func(wantedKeys ...string) *normalizedEvent {
+			ne := &normalizedEvent{
+				Type:       event.Type,
+				Attributes: make(map[string]string),
+			}
+			ne.putWantedAttrsByKey(event.Attributes, wantedKeys...)
+			return ne
+		}
+
+		switch event.Type {
+		case govtypes.EventTypeSubmitProposal:
+			const wantAttributesCount = 3
+			if len(event.Attributes) != wantAttributesCount {
+				// skip the `voting_period_start` event
+				return nil
+			}
+			return newNormalizedEvent(
+				govtypes.AttributeKeyProposalID, govtypes.AttributeKeyProposalProposer, govtypes.AttributeKeyProposalMessages,
+			).requireAttributesCountOrNil(wantAttributesCount)
+		case govtypes.EventTypeProposalDeposit:
+			const wantAttributesCount = 3
+			if len(event.Attributes) != wantAttributesCount {
+				// skip the `voting_period_start` event
+				return nil
+			}
+			return newNormalizedEvent(
+				govtypes.AttributeKeyDepositor, sdk.AttributeKeyAmount, govtypes.AttributeKeyProposalID,
+			).requireAttributesCountOrNil(wantAttributesCount)
+		case govtypes.EventTypeProposalVote:
+			const wantAttributesCount = 3
+			if len(event.Attributes) != wantAttributesCount {
+				return nil
+			}
+			return newNormalizedEvent(
+				govtypes.AttributeKeyVoter, govtypes.AttributeKeyOption, govtypes.AttributeKeyProposalID,
+			).requireAttributesCountOrNil(wantAttributesCount)
+		default:
+			return nil
+		}
+	})
+}
+
+// toUnixSecondsOrZero returns the unix seconds of the given time, or zero if not set.
+func toUnixSecondsOrZero(t *time.Time) uint64 {
+	if t == nil || t.IsZero() || t.Unix() < 0 {
+		return 0
+	}
+	return uint64(t.Unix())
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoName{}
+
+type govCustomPrecompiledContractRoName struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.GovCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e govCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e govCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e govCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// proposal(uint64)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoProposal{}
+
+type govCustomPrecompiledContractRoProposal struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoProposal) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("proposal", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	proposalId := ips[0].(uint64)
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resProposal, err := govkeeper.NewQueryServer(e.contract.keeper.govKeeper).Proposal(ctx, &govv1.QueryProposalRequest{
+		ProposalId: proposalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := resProposal.Proposal
+	proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to parse proposer address: %s", proposal.Proposer)
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("proposal", abi.GovProposalInfo{
+		Id:              proposal.Id,
+		Status:          uint8(proposal.Status),
+		Proposer:        common.BytesToAddress(proposer),
+		Title:           proposal.Title,
+		Summary:         proposal.Summary,
+		Metadata:        proposal.Metadata,
+		Expedited:       proposal.Expedited,
+		TotalDeposit:    sdk.Coins(proposal.TotalDeposit).AmountOf(bondDenom).BigInt(),
+		SubmitTime:      toUnixSecondsOrZero(proposal.SubmitTime),
+		DepositEndTime:  toUnixSecondsOrZero(proposal.DepositEndTime),
+		VotingStartTime: toUnixSecondsOrZero(proposal.VotingStartTime),
+		VotingEndTime:   toUnixSecondsOrZero(proposal.VotingEndTime),
+	})
+}
+
+func (e govCustomPrecompiledContractRoProposal) Method4BytesSignatures() []byte {
+	return []byte{0x7a, 0xfa, 0x0a, 0xa3}
+}
+
+func (e govCustomPrecompiledContractRoProposal) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e govCustomPrecompiledContractRoProposal) ReadOnly() bool {
+	return true
+}
+
+// tallyResult(uint64)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoTallyResult{}
+
+type govCustomPrecompiledContractRoTallyResult struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoTallyResult) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("tallyResult", input)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalId := ips[0].(uint64)
+
+	// tally of proposal in voting period prunes the votes, so it must be computed on a branched context
+	cacheCtx, _ := env.ctx.CacheContext()
+
+	resTally, err := govkeeper.NewQueryServer(e.contract.keeper.govKeeper).TallyResult(cacheCtx, &govv1.QueryTallyResultRequest{
+		ProposalId: proposalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parseCount := func(count string) (*big.Int, error) {
+		if count == "" {
+			return big.NewInt(0), nil
+		}
+		amount, ok := sdkmath.NewIntFromString(count)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse tally count: %s", count)
+		}
+		return amount.BigInt(), nil
+	}
+
+	var tallyResult abi.GovTallyResult
+	for _, field := range []struct {
+		ptr   **big.Int
+		count string
+	}{
+		{ptr: &tallyResult.Yes, count: resTally.Tally.YesCount},
+		{ptr: &tallyResult.Abstain, count: resTally.Tally.AbstainCount},
+		{ptr: &tallyResult.No, count: resTally.Tally.NoCount},
+		{ptr: &tallyResult.NoWithVeto, count: resTally.Tally.NoWithVetoCount},
+	} {
+		*field.ptr, err = parseCount(field.count)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("tallyResult", tallyResult)
+}
+
+func (e govCustomPrecompiledContractRoTallyResult) Method4BytesSignatures() []byte {
+	return []byte{0x10, 0x11, 0x46, 0xbe}
+}
+
+func (e govCustomPrecompiledContractRoTallyResult) RequireGas() uint64 {
+	return 50_000
+}
+
+func (e govCustomPrecompiledContractRoTallyResult) ReadOnly() bool {
+	return true
+}
+
+// voteOf(uint64,address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoVoteOf{}
+
+type govCustomPrecompiledContractRoVoteOf struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoVoteOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("voteOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalId := ips[0].(uint64)
+	voterAddr := ips[1].(common.Address)
+
+	options := make([]abi.GovWeightedVoteOption, 0)
+
+	vote, err := e.contract.keeper.govKeeper.Votes.Get(env.ctx, collections.Join(proposalId, sdk.AccAddress(voterAddr.Bytes())))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+	} else {
+		for _, option := range vote.Options {
+			weight, err := sdkmath.LegacyNewDecFromStr(option.Weight)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "failed to parse vote weight: %s", option.Weight)
+			}
+
+			options = append(options, abi.GovWeightedVoteOption{
+				Option: uint8(option.Option),
+				Weight: weight.String(),
+			})
+		}
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("voteOf", options)
+}
+
+func (e govCustomPrecompiledContractRoVoteOf) Method4BytesSignatures() []byte {
+	return []byte{0xf9, 0xb8, 0x49, 0x69}
+}
+
+func (e govCustomPrecompiledContractRoVoteOf) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e govCustomPrecompiledContractRoVoteOf) ReadOnly() bool {
+	return true
+}
+
+// depositOf(uint64,address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoDepositOf{}
+
+type govCustomPrecompiledContractRoDepositOf struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoDepositOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("depositOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	proposalId := ips[0].(uint64)
+	depositorAddr := ips[1].(common.Address)
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resDeposit, err := govkeeper.NewQueryServer(e.contract.keeper.govKeeper).Deposit(ctx, &govv1.QueryDepositRequest{
+		ProposalId: proposalId,
+		Depositor:  sdk.AccAddress(depositorAddr.Bytes()).String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return abi.GovCpcInfo.PackMethodOutput("depositOf", big.NewInt(0))
+		}
+		return nil, err
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("depositOf", sdk.Coins(resDeposit.Deposit.Amount).AmountOf(bondDenom).BigInt())
+}
+
+func (e govCustomPrecompiledContractRoDepositOf) Method4BytesSignatures() []byte {
+	return []byte{0x44, 0x13, 0xa5, 0xd3}
+}
+
+func (e govCustomPrecompiledContractRoDepositOf) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e govCustomPrecompiledContractRoDepositOf) ReadOnly() bool {
+	return true
+}
+
+// submitProposal(string,string,string,string,uint256,bool)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwSubmitProposal{}
+
+type govCustomPrecompiledContractRwSubmitProposal struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("submitProposal", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer := sdk.AccAddress(caller.Address().Bytes())
+	messagesJson := ips[0].(string)
+	metadata := ips[1].(string)
+	title := ips[2].(string)
+	summary := ips[3].(string)
+	initialDepositAmount := ips[4].(*big.Int)
+	expedited := ips[5].(bool)
+
+	var rawMessages []json.RawMessage
+	if err := json.Unmarshal([]byte(messagesJson), &rawMessages); err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "messages must be a JSON array: %s", err)
+	}
+
+	messages := make([]sdk.Msg, len(rawMessages))
+	for i, rawMessage := range rawMessages {
+		var msg sdk.Msg
+		if err := e.contract.keeper.cdc.UnmarshalInterfaceJSON(rawMessage, &msg); err != nil {
+			return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "failed to decode message at index %d: %s", i, err)
+		}
+		messages[i] = msg
+	}
+
+	initialDeposit := sdk.NewCoins()
+	if initialDepositAmount.Sign() > 0 {
+		initialDeposit = sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(initialDepositAmount)))
+	}
+
+	msgSubmitProposal, err := govv1.NewMsgSubmitProposal(messages, initialDeposit, proposer.String(), metadata, title, summary, expedited)
+	if err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "failed to build proposal: %s", err)
+	}
+
+	originalGovEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+
+	resSubmitProposal, err := govkeeper.NewMsgServerImpl(e.contract.keeper.govKeeper).SubmitProposal(ctx, msgSubmitProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalGovEventsCount, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("submitProposal", resSubmitProposal.ProposalId)
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) Method4BytesSignatures() []byte {
+	return []byte{0x1e, 0xe7, 0x05, 0x6b}
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) RequireGas() uint64 {
+	return 500_000
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) ReadOnly() bool {
+	return false
+}
+
+// deposit(uint64,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwDeposit{}
+
+type govCustomPrecompiledContractRwDeposit struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRwDeposit) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("deposit", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	depositor := sdk.AccAddress(caller.Address().Bytes())
+	proposalId := ips[0].(uint64)
+	amount := ips[1].(*big.Int)
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "deposit amount must be positive")
+	}
+
+	originalGovEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+
+	msgDeposit := govv1.NewMsgDeposit(
+		depositor,  // depositor
+		proposalId, // proposal
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount))), // deposit amount
+	)
+	if _, err := govkeeper.NewMsgServerImpl(e.contract.keeper.govKeeper).Deposit(ctx, msgDeposit); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalGovEventsCount, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("deposit", true)
+}
+
+func (e govCustomPrecompiledContractRwDeposit) Method4BytesSignatures() []byte {
+	return []byte{0x61, 0x70, 0xc4, 0xb1}
+}
+
+func (e govCustomPrecompiledContractRwDeposit) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e govCustomPrecompiledContractRwDeposit) ReadOnly() bool {
+	return false
+}
+
+// vote(uint64,uint8,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwVote{}
+
+type govCustomPrecompiledContractRwVote struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRwVote) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("vote", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	voter := sdk.AccAddress(caller.Address().Bytes())
+	proposalId := ips[0].(uint64)
+	option := govv1.VoteOption(ips[1].(uint8))
+	metadata := ips[2].(string)
+
+	if !govv1.ValidVoteOption(option) || option == govv1.OptionEmpty {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "invalid vote option: %d", option)
+	}
+
+	originalGovEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+
+	msgVote := govv1.NewMsgVote(voter, proposalId, option, metadata)
+	if _, err := govkeeper.NewMsgServerImpl(e.contract.keeper.govKeeper).Vote(ctx, msgVote); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalGovEventsCount, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("vote", true)
+}
+
+func (e govCustomPrecompiledContractRwVote) Method4BytesSignatures() []byte {
+	return []byte{0x52, 0x87, 0x83, 0xd5}
+}
+
+func (e govCustomPrecompiledContractRwVote) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e govCustomPrecompiledContractRwVote) ReadOnly() bool {
+	return false
+}
+
+// voteWeighted(uint64,(uint8,string)[],string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwVoteWeighted{}
+
+type govCustomPrecompiledContractRwVoteWeighted struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("voteWeighted", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	voter := sdk.AccAddress(caller.Address().Bytes())
+	proposalId := ips[0].(uint64)
+	inputOptions, err := abi.GovWeightedVoteOptionsFromUnpacked(ips[1])
+	if err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "failed to parse vote options: %s", err)
+	}
+	metadata := ips[2].(string)
+
+	if len(inputOptions) == 0 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "vote options cannot be empty")
+	}
+
+	options := make(govv1.WeightedVoteOptions, len(inputOptions))
+	for i, inputOption := range inputOptions {
+		options[i] = &govv1.WeightedVoteOption{
+			Option: govv1.VoteOption(inputOption.Option),
+			Weight: inputOption.Weight,
+		}
+	}
+
+	originalGovEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+
+	msgVoteWeighted := govv1.NewMsgVoteWeighted(voter, proposalId, options, metadata)
+	if _, err := govkeeper.NewMsgServerImpl(e.contract.keeper.govKeeper).VoteWeighted(ctx, msgVoteWeighted); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalGovEventsCount, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("voteWeighted", true)
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) Method4BytesSignatures() []byte {
+	return []byte{0xc1, 0xcf, 0xfe, 0xf3}
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) RequireGas() uint64 {
+	return 150_000
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) ReadOnly() bool {
+	return false
+}
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	topic0SubmitProposal = "0xf49a3a8232aff8553333cfd734e3a7ef1ab4764cd0494eb145216773b64bf349"
+	topic0Deposit        = "0x685c54f1ed866ac5147f6f2eb395af5c2402e0c09df9227ef0b61e1b3f83083d"
+	topic0Vote           = "0x100a07a0172b248dc40ae6a6db63a5401e761f6e102caf81a1a2030de4e6cc9a"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployGovCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcGovFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployGovCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcGovFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcGovFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_GovCustomPrecompiledContract_Topic0() {
+	suite.Equal(common.HexToHash(topic0SubmitProposal), abi.GovCpcInfo.ABI.Events["SubmitProposal"].ID)
+	suite.Equal(common.HexToHash(topic0Deposit), abi.GovCpcInfo.ABI.Events["Deposit"].ID)
+	suite.Equal(common.HexToHash(topic0Vote), abi.GovCpcInfo.ABI.Events["Vote"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_GovCustomPrecompiledContract() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+
+	govParams, err := suite.App().GovKeeper().Params.Get(suite.Ctx())
+	suite.Require().NoError(err)
+	minDeposit := sdk.Coins(govParams.MinDeposit).AmountOf(suite.bondDenom(suite.Ctx()))
+	suite.Require().True(minDeposit.IsPositive())
+
+	suite.CITS.MintCoin(account1, sdk.NewCoin(suite.bondDenom(suite.Ctx()), minDeposit.MulRaw(2)))
+	suite.CITS.MintCoin(account2, sdk.NewCoin(suite.bondDenom(suite.Ctx()), minDeposit.MulRaw(2)))
+
+	callContract := func(from common.Address, method string, args ...any) (ret []byte, logs []*ethtypes.Log) {
+		input, err := abi.GovCpcInfo.ABI.Pack(method, args...)
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcGovFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		if !abi.GovCpcInfo.ABI.Methods[method].IsConstant() {
+			receipt := &ethtypes.Receipt{}
+			suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+			logs = receipt.Logs
+		}
+
+		return res.Ret, logs
+	}
+
+	initialDeposit := minDeposit.QuoRaw(2)
+
+	var proposalId uint64
+	suite.Run("pass - submit proposal", func() {
+		ret, logs := callContract(
+			account1.GetEthAddress(), "submitProposal",
+			"[]", "ipfs://metadata", "Title", "Summary", initialDeposit.BigInt(), false,
+		)
+		outputs, err := abi.GovCpcInfo.ABI.Methods["submitProposal"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		proposalId = outputs[0].(uint64)
+		suite.Require().NotZero(proposalId)
+
+		suite.Require().Len(logs, 2)
+
+		suite.Equal(topic0SubmitProposal, logs[0].Topics[0].String())
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(proposalId, logs[0].Topics[2].Big().Uint64())
+
+		suite.Equal(topic0Deposit, logs[1].Topics[0].String())
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(logs[1].Topics[1].Bytes()))
+		suite.Equal(proposalId, logs[1].Topics[2].Big().Uint64())
+		suite.Equal(initialDeposit.String(), new(big.Int).SetBytes(logs[1].Data).String())
+	})
+
+	suite.Run("pass - query proposal", func() {
+		ret, _ := callContract(account2.GetEthAddress(), "proposal", proposalId)
+		outputs, err := abi.GovCpcInfo.ABI.Methods["proposal"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+
+		proposal := *ethabi.ConvertType(outputs[0], new(abi.GovProposalInfo)).(*abi.GovProposalInfo)
+		suite.Equal(proposalId, proposal.Id)
+		suite.Equal(uint8(govv1.StatusDepositPeriod), proposal.Status)
+		suite.Equal(account1.GetEthAddress(), proposal.Proposer)
+		suite.Equal("Title", proposal.Title)
+		suite.Equal("Summary", proposal.Summary)
+		suite.Equal("ipfs://metadata", proposal.Metadata)
+		suite.False(proposal.Expedited)
+		suite.Equal(initialDeposit.String(), proposal.TotalDeposit.String())
+		suite.NotZero(proposal.SubmitTime)
+		suite.NotZero(proposal.DepositEndTime)
+		suite.Zero(proposal.VotingStartTime)
+	})
+
+	suite.Run("fail - deposit zero amount", func() {
+		input, err := abi.GovCpcInfo.ABI.Pack("deposit", proposalId, big.NewInt(0))
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), account2.GetEthAddressP(), cpctypes.CpcGovFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Contains(res.VmError, "deposit amount must be positive")
+	})
+
+	suite.Run("pass - deposit to activate voting period", func() {
+		ret, logs := callContract(account2.GetEthAddress(), "deposit", proposalId, minDeposit.BigInt())
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0Deposit, logs[0].Topics[0].String())
+		suite.Equal(account2.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(proposalId, logs[0].Topics[2].Big().Uint64())
+		suite.Equal(minDeposit.String(), new(big.Int).SetBytes(logs[0].Data).String())
+
+		ret, _ = callContract(account2.GetEthAddress(), "depositOf", proposalId, account2.GetEthAddress())
+		deposited, err := cpcutils.AbiDecodeUint256(ret)
+		suite.Require().NoError(err)
+		suite.Equal(minDeposit.String(), deposited.String())
+
+		proposal, err := suite.App().GovKeeper().Proposals.Get(suite.Ctx(), proposalId)
+		suite.Require().NoError(err)
+		suite.Equal(govv1.StatusVotingPeriod, proposal.Status)
+	})
+
+	suite.Run("pass - vote", func() {
+		ret, logs := callContract(account1.GetEthAddress(), "vote", proposalId, uint8(govv1.OptionYes), "")
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0Vote, logs[0].Topics[0].String())
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(proposalId, logs[0].Topics[2].Big().Uint64())
+		suite.Require().Len(logs[0].Data, 64)
+		suite.Equal(uint64(govv1.OptionYes), new(big.Int).SetBytes(logs[0].Data[:32]).Uint64())
+		suite.Equal(sdkmath.LegacyOneDec().BigInt().String(), new(big.Int).SetBytes(logs[0].Data[32:]).String())
+	})
+
+	suite.Run("fail - vote with invalid option", func() {
+		input, err := abi.GovCpcInfo.ABI.Pack("vote", proposalId, uint8(0), "")
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Contains(res.VmError, "invalid vote option")
+	})
+
+	suite.Run("pass - weighted vote", func() {
+		options := []abi.GovWeightedVoteOption{
+			{Option: uint8(govv1.OptionYes), Weight: "0.7"},
+			{Option: uint8(govv1.OptionNo), Weight: "0.3"},
+		}
+		ret, logs := callContract(account2.GetEthAddress(), "voteWeighted", proposalId, options, "")
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 2)
+		for i, option := range options {
+			suite.Equal(topic0Vote, logs[i].Topics[0].String())
+			suite.Equal(account2.GetEthAddress(), common.BytesToAddress(logs[i].Topics[1].Bytes()))
+			suite.Equal(uint64(option.Option), new(big.Int).SetBytes(logs[i].Data[:32]).Uint64())
+			suite.Equal(sdkmath.LegacyMustNewDecFromStr(option.Weight).BigInt().String(), new(big.Int).SetBytes(logs[i].Data[32:]).String())
+		}
+
+		ret, _ = callContract(account1.GetEthAddress(), "voteOf", proposalId, account2.GetEthAddress())
+		outputs, err := abi.GovCpcInfo.ABI.Methods["voteOf"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		gotOptions, err := abi.GovWeightedVoteOptionsFromUnpacked(outputs[0])
+		suite.Require().NoError(err)
+		suite.Require().Len(gotOptions, 2)
+		for i, option := range options {
+			suite.Equal(option.Option, gotOptions[i].Option)
+			suite.Equal(sdkmath.LegacyMustNewDecFromStr(option.Weight).String(), gotOptions[i].Weight)
+		}
+	})
+
+	suite.Run("pass - vote of non-voter is empty", func() {
+		ret, _ := callContract(account1.GetEthAddress(), "voteOf", proposalId, suite.CITS.WalletAccounts.Number(3).GetEthAddress())
+		outputs, err := abi.GovCpcInfo.ABI.Methods["voteOf"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		gotOptions, err := abi.GovWeightedVoteOptionsFromUnpacked(outputs[0])
+		suite.Require().NoError(err)
+		suite.Empty(gotOptions)
+	})
+
+	suite.Run("pass - tally result does not prune votes", func() {
+		ret, _ := callContract(account1.GetEthAddress(), "tallyResult", proposalId)
+		outputs, err := abi.GovCpcInfo.ABI.Methods["tallyResult"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+
+		tallyResult := *ethabi.ConvertType(outputs[0], new(abi.GovTallyResult)).(*abi.GovTallyResult)
+		suite.NotNil(tallyResult.Yes)
+		suite.NotNil(tallyResult.No)
+
+		ret, _ = callContract(account1.GetEthAddress(), "voteOf", proposalId, account1.GetEthAddress())
+		outputs, err = abi.GovCpcInfo.ABI.Methods["voteOf"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		gotOptions, err := abi.GovWeightedVoteOptionsFromUnpacked(outputs[0])
+		suite.Require().NoError(err)
+		suite.Len(gotOptions, 1)
+	})
+}
@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployIbcTransferCustomPrecompiledContract deploys a new IBC transfer custom precompiled contract.
+func (k Keeper) DeployIbcTransferCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcIbcTransferFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeIbcTransfer,
+		Name:                  "IBC Transfer - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &ibcTransferCustomPrecompiledContract{}
+
+// ibcTransferCustomPrecompiledContract allows EVM accounts to transfer tokens to other chains via ICS-20,
+// using the `transfer` port of the `ibc-go` transfer module.
+type ibcTransferCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewIbcTransferCustomPrecompiledContract creates a new IBC transfer custom precompiled contract.
+func NewIbcTransferCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &ibcTransferCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&ibcTransferCustomPrecompiledContractRoName{contract: contract},
+		&ibcTransferCustomPrecompiledContractRoDenomTrace{contract: contract},
+		&ibcTransferCustomPrecompiledContractRoDenomHash{contract: contract},
+		&ibcTransferCustomPrecompiledContractRwTransfer{contract: contract},
+	}
+
+	return contract
+}
+
+func (m ibcTransferCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m ibcTransferCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m ibcTransferCustomPrecompiledContract) emitsEventIBCTransfer(sender common.Address, msg *ibctransfertypes.MsgTransfer, sequence uint64, env cpcExecutorEnv) error {
+	data, err := abi.IbcTransferCpcInfo.ABI.Events["IBCTransfer"].Inputs.NonIndexed().Pack(
+		msg.Receiver,
+		msg.SourceChannel,
+		msg.Token.Denom,
+		msg.Token.Amount.BigInt(),
+		sequence,
+		msg.Memo,
+	)
+	if err != nil {
+		return err
+	}
+
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcIbcTransferFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x17c0d54bf76b848bca01c9495ada4f8b8e2324311645d1424141dccbbdb5a917"), // IBCTransfer(address,string,string,string,uint256,uint64,string)
+			common.BytesToHash(sender.Bytes()),
+		},
+		Data: data,
+	})
+
+	return nil
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRoName{}
+
+type ibcTransferCustomPrecompiledContractRoName struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.IbcTransferCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// denomTrace(string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRoDenomTrace{}
+
+type ibcTransferCustomPrecompiledContractRoDenomTrace struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.IbcTransferCpcInfo.UnpackMethodInput("denomTrace", input)
+	if err != nil {
+		return nil, err
+	}
+
+	resDenomTrace, err := e.contract.keeper.transferKeeper.DenomTrace(env.ctx, &ibctransfertypes.QueryDenomTraceRequest{
+		Hash: ips[0].(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("denomTrace", abi.IbcTransferDenomTrace{
+		Path:      resDenomTrace.DenomTrace.Path,
+		BaseDenom: resDenomTrace.DenomTrace.BaseDenom,
+	})
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) Method4BytesSignatures() []byte {
+	return []byte{0xa8, 0x15, 0xcd, 0xd9}
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) ReadOnly() bool {
+	return true
+}
+
+// denomHash(string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRoDenomHash{}
+
+type ibcTransferCustomPrecompiledContractRoDenomHash struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomHash) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.IbcTransferCpcInfo.UnpackMethodInput("denomHash", input)
+	if err != nil {
+		return nil, err
+	}
+
+	resDenomHash, err := e.contract.keeper.transferKeeper.DenomHash(env.ctx, &ibctransfertypes.QueryDenomHashRequest{
+		Trace: ips[0].(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("denomHash", resDenomHash.Hash)
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomHash) Method4BytesSignatures() []byte {
+	return []byte{0xb5, 0xcb, 0x6e, 0x7d}
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomHash) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomHash) ReadOnly() bool {
+	return true
+}
+
+// transfer(string,string,uint256,string,(uint64,uint64),uint64,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRwTransfer{}
+
+type ibcTransferCustomPrecompiledContractRwTransfer struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.IbcTransferCpcInfo.UnpackMethodInput("transfer", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	sourceChannel := ips[0].(string)
+	denom := ips[1].(string)
+	amount := ips[2].(*big.Int)
+	receiver := ips[3].(string)
+	timeoutHeight, err := abi.IbcTransferHeightFromUnpacked(ips[4])
+	if err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+	timeoutTimestamp := ips[5].(uint64)
+	memo := ips[6].(string)
+
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "transfer amount must be positive")
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+
+	msgTransfer := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID, // source port
+		sourceChannel,           // source channel
+		sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)), // token
+		sdk.AccAddress(caller.Address().Bytes()).String(),    // sender
+		receiver, // receiver
+		clienttypes.NewHeight(timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight), // timeout height
+		timeoutTimestamp, // timeout timestamp
+		memo,             // memo
+	)
+	if err := msgTransfer.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, err.Error())
+	}
+
+	resTransfer, err := e.contract.keeper.transferKeeper.Transfer(ctx, msgTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventIBCTransfer(caller.Address(), msgTransfer, resTransfer.Sequence, env); err != nil {
+		return nil, err
+	}
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("transfer", resTransfer.Sequence)
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) Method4BytesSignatures() []byte {
+	return []byte{0x29, 0x14, 0xcc, 0x14}
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) ReadOnly() bool {
+	return false
+}
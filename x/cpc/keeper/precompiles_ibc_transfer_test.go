@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	topic0IBCTransfer = "0x17c0d54bf76b848bca01c9495ada4f8b8e2324311645d1424141dccbbdb5a917"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployIbcTransferCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcIbcTransferFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployIbcTransferCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcIbcTransferFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcIbcTransferFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_IbcTransferCustomPrecompiledContract_Topic0() {
+	suite.Equal(common.HexToHash(topic0IBCTransfer), abi.IbcTransferCpcInfo.ABI.Events["IBCTransfer"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_IbcTransferCustomPrecompiledContract() {
+	account := suite.CITS.WalletAccounts.Number(1)
+
+	denomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	suite.App().IbcTransferKeeper().SetDenomTrace(suite.Ctx(), denomTrace)
+
+	callContract := func(input []byte) (ret []byte, vmErr string) {
+		from := account.GetEthAddress()
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcIbcTransferFixedAddress, input)
+		suite.Require().NoError(err)
+		return res.Ret, res.VmError
+	}
+
+	buildTransferInput := func(sourceChannel, denom string, amount *big.Int, receiver string, timeoutTimestamp uint64) []byte {
+		bz, err := abi.IbcTransferCpcInfo.ABI.Pack(
+			"transfer",
+			sourceChannel, denom, amount, receiver, abi.IbcTransferHeight{}, timeoutTimestamp, "memo",
+		)
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	suite.Run("pass - name()", func() {
+		ret, vmErr := callContract(simpleBuildContractInput(get4BytesSignature("name()")))
+		suite.Require().Empty(vmErr)
+
+		ops, err := abi.IbcTransferCpcInfo.ABI.Methods["name"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Equal("IBC Transfer - Precompiled Contract", ops[0].(string))
+	})
+
+	suite.Run("pass - denomTrace(string)", func() {
+		for _, hash := range []string{denomTrace.Hash().String(), denomTrace.IBCDenom()} {
+			input, err := abi.IbcTransferCpcInfo.ABI.Pack("denomTrace", hash)
+			suite.Require().NoError(err)
+
+			ret, vmErr := callContract(input)
+			suite.Require().Empty(vmErr)
+
+			ops, err := abi.IbcTransferCpcInfo.ABI.Methods["denomTrace"].Outputs.Unpack(ret)
+			suite.Require().NoError(err)
+
+			gotDenomTrace := *ethabi.ConvertType(ops[0], new(abi.IbcTransferDenomTrace)).(*abi.IbcTransferDenomTrace)
+			suite.Equal("transfer/channel-0", gotDenomTrace.Path)
+			suite.Equal("uatom", gotDenomTrace.BaseDenom)
+		}
+	})
+
+	suite.Run("fail - denomTrace(string) of non-existing trace", func() {
+		input, err := abi.IbcTransferCpcInfo.ABI.Pack("denomTrace", ibctransfertypes.ParseDenomTrace("transfer/channel-1/uatom").Hash().String())
+		suite.Require().NoError(err)
+
+		_, vmErr := callContract(input)
+		suite.Require().Contains(vmErr, "denomination trace not found")
+	})
+
+	suite.Run("pass - denomHash(string)", func() {
+		input, err := abi.IbcTransferCpcInfo.ABI.Pack("denomHash", "transfer/channel-0/uatom")
+		suite.Require().NoError(err)
+
+		ret, vmErr := callContract(input)
+		suite.Require().Empty(vmErr)
+
+		ops, err := abi.IbcTransferCpcInfo.ABI.Methods["denomHash"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Equal(denomTrace.Hash().String(), ops[0].(string))
+	})
+
+	suite.Run("fail - transfer zero amount", func() {
+		_, vmErr := callContract(buildTransferInput("channel-0", suite.CITS.ChainConstantsConfig.GetMinDenom(), big.NewInt(0), "receiver", 1))
+		suite.Require().Contains(vmErr, "transfer amount must be positive")
+	})
+
+	suite.Run("fail - transfer with invalid denom", func() {
+		_, vmErr := callContract(buildTransferInput("channel-0", "1", big.NewInt(1), "receiver", 1))
+		suite.Require().Contains(vmErr, "invalid denom")
+	})
+
+	suite.Run("fail - transfer with empty receiver", func() {
+		_, vmErr := callContract(buildTransferInput("channel-0", suite.CITS.ChainConstantsConfig.GetMinDenom(), big.NewInt(1), "", 1))
+		suite.Require().Contains(vmErr, "missing recipient address")
+	})
+
+	suite.Run("fail - transfer via non-existing channel", func() {
+		_, vmErr := callContract(buildTransferInput("channel-99", suite.CITS.ChainConstantsConfig.GetMinDenom(), big.NewInt(1), "receiver", 1))
+		suite.Require().Contains(vmErr, "channel not found")
+	})
+}
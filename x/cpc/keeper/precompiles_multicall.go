@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployMulticallCustomPrecompiledContract deploys a new multicall custom precompiled contract.
+func (k Keeper) DeployMulticallCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcMulticallFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeMulticall,
+		Name:                  "Multicall - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &multicallCustomPrecompiledContract{}
+
+// multicallCustomPrecompiledContract allows EVM accounts to execute multiple calls to the other custom precompiled contracts
+// in a single transaction, under the identity of the caller.
+type multicallCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewMulticallCustomPrecompiledContract creates a new multicall custom precompiled contract.
+func NewMulticallCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &multicallCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&multicallCustomPrecompiledContractRoName{contract: contract},
+		&multicallCustomPrecompiledContractRwMulticall{contract: contract},
+	}
+
+	return contract
+}
+
+func (m multicallCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m multicallCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &multicallCustomPrecompiledContractRoName{}
+
+type multicallCustomPrecompiledContractRoName struct {
+	contract *multicallCustomPrecompiledContract
+}
+
+func (e multicallCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.MulticallCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.MulticallCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e multicallCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e multicallCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e multicallCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// multicall((address,bytes,bool)[])
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &multicallCustomPrecompiledContractRwMulticall{}
+
+type multicallCustomPrecompiledContractRwMulticall struct {
+	contract *multicallCustomPrecompiledContract
+}
+
+func (e multicallCustomPrecompiledContractRwMulticall) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.MulticallCpcInfo.UnpackMethodInput("multicall", input)
+	if err != nil {
+		return nil, err
+	}
+
+	calls, err := abi.MulticallCallsFromUnpacked(ips[0])
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	// validate all targets before executing any call
+	targets := make([]cpctypes.CustomPrecompiledContractMeta, len(calls))
+	for i, call := range calls {
+		contractMeta := e.contract.keeper.GetCustomPrecompiledContractMeta(ctx, call.Target)
+		if contractMeta == nil {
+			return nil, fmt.Errorf("call %d: target is not a custom precompiled contract: %s", i, call.Target)
+		}
+		if contractMeta.CustomPrecompiledType == cpctypes.CpcTypeMulticall {
+			return nil, fmt.Errorf("call %d: not allowed to call multicall contract", i)
+		}
+		targets[i] = *contractMeta
+	}
+	if err := env.consumeIterationGas(len(calls)); err != nil {
+		return nil, err
+	}
+
+	results := make([]abi.MulticallResult, len(calls))
+	for i, call := range calls {
+		// Each call is provided exactly the gas it requires, and the gas used is charged as dynamic gas of the multicall.
+		// Each call runs in its own snapshot, which is reverted by the EVM when the call fails.
+		// The caller is passed as an account so the dynamic gas of the call is consumed from the gas of the multicall.
+		var gas uint64
+		if len(call.CallData) >= 4 {
+			gas = e.contract.keeper.getCustomPrecompiledContractMethodRequireGas(ctx, targets[i], call.CallData[:4])
+		}
+		if gas > env.remainingGas() {
+			// the remaining gas can not cover the call, the batch stops here regardless of allow failure
+			return nil, fmt.Errorf("call %d to %s failed: %w", i, call.Target, corevm.ErrOutOfGas)
+		}
+
+		ret, leftOverGas, err := env.evm.Call(corevm.AccountRef(caller.Address()), call.Target, call.CallData, gas, big.NewInt(0))
+		if err := env.useGas(gas - leftOverGas); err != nil {
+			return nil, err
+		}
+
+		if err != nil && !call.AllowFailure {
+			return nil, fmt.Errorf("call %d to %s failed: %s", i, call.Target, err.Error())
+		}
+
+		results[i] = abi.MulticallResult{
+			Success:    err == nil,
+			ReturnData: ret,
+		}
+		if results[i].ReturnData == nil {
+			results[i].ReturnData = []byte{}
+		}
+	}
+
+	return abi.MulticallCpcInfo.PackMethodOutput("multicall", results)
+}
+
+func (e multicallCustomPrecompiledContractRwMulticall) Method4BytesSignatures() []byte {
+	return []byte{0x19, 0xff, 0x3a, 0x43}
+}
+
+func (e multicallCustomPrecompiledContractRwMulticall) RequireGas() uint64 {
+	return 5_000
+}
+
+func (e multicallCustomPrecompiledContractRwMulticall) ReadOnly() bool {
+	return false
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployMulticallCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcMulticallFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployMulticallCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcMulticallFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcMulticallFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_MulticallCustomPrecompiledContract() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+	account3 := suite.CITS.WalletAccounts.Number(3)
+
+	buildInput := func(calls ...abi.MulticallCall) []byte {
+		input, err := abi.MulticallCpcInfo.ABI.Pack("multicall", calls)
+		suite.Require().NoError(err)
+		return input
+	}
+
+	decodeResults := func(ret []byte) []abi.MulticallResult {
+		ops, err := abi.MulticallCpcInfo.ABI.Methods["multicall"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(ops, 1)
+
+		var results []abi.MulticallResult
+		suite.Require().NoError(abi.MulticallCpcInfo.ABI.Methods["multicall"].Outputs.Copy(&results, ops))
+		return results
+	}
+
+	withdrawAddressOf := func(account common.Address) common.Address {
+		res, err := suite.EthCallApply(suite.Ctx(), &account, cpctypes.CpcDistributionFixedAddress, simpleBuildContractInput(get4BytesSignature("withdrawAddressOf(address)"), account))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		return common.BytesToAddress(res.Ret)
+	}
+
+	setWithdrawAddressCall := func(withdrawAddress common.Address, allowFailure bool) abi.MulticallCall {
+		return abi.MulticallCall{
+			Target:       cpctypes.CpcDistributionFixedAddress,
+			CallData:     simpleBuildContractInput(get4BytesSignature("setWithdrawAddress(address)"), withdrawAddress),
+			AllowFailure: allowFailure,
+		}
+	}
+
+	invalidCall := func(allowFailure bool) abi.MulticallCall {
+		return abi.MulticallCall{
+			Target:       cpctypes.CpcDistributionFixedAddress,
+			CallData:     []byte{0x01, 0x02, 0x03, 0x04},
+			AllowFailure: allowFailure,
+		}
+	}
+
+	suite.Run("pass - name()", func() {
+		from := account1.GetEthAddress()
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcMulticallFixedAddress, get4BytesSignature("name()"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		name, err := cpcutils.AbiDecodeString(res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal("Multicall - Precompiled Contract", name)
+	})
+
+	suite.Run("pass - executes all calls under identity of the caller", func() {
+		from := account1.GetEthAddress()
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcMulticallFixedAddress, buildInput(
+			setWithdrawAddressCall(account2.GetEthAddress(), false),
+			abi.MulticallCall{
+				Target:   cpctypes.CpcDistributionFixedAddress,
+				CallData: simpleBuildContractInput(get4BytesSignature("withdrawAddressOf(address)"), from),
+			},
+		))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		results := decodeResults(res.Ret)
+		suite.Require().Len(results, 2)
+		suite.True(results[0].Success)
+		success, err := cpcutils.AbiDecodeBool(results[0].ReturnData)
+		suite.Require().NoError(err)
+		suite.True(success)
+		suite.True(results[1].Success)
+		suite.Equal(account2.GetEthAddress(), common.BytesToAddress(results[1].ReturnData))
+
+		suite.Equal(account2.GetEthAddress(), withdrawAddressOf(from))
+	})
+
+	suite.Run("pass - failed call with allow failure is reverted alone", func() {
+		from := account1.GetEthAddress()
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcMulticallFixedAddress, buildInput(
+			invalidCall(true),
+			setWithdrawAddressCall(account3.GetEthAddress(), false),
+		))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		results := decodeResults(res.Ret)
+		suite.Require().Len(results, 2)
+		suite.False(results[0].Success)
+		suite.True(results[1].Success)
+
+		suite.Equal(account3.GetEthAddress(), withdrawAddressOf(from))
+	})
+
+	suite.Run("fail - failed call without allow failure reverts the whole batch", func() {
+		from := account1.GetEthAddress()
+		withdrawAddressBefore := withdrawAddressOf(from)
+		suite.Require().NotEqual(account2.GetEthAddress(), withdrawAddressBefore)
+
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcMulticallFixedAddress, buildInput(
+			setWithdrawAddressCall(account2.GetEthAddress(), false),
+			invalidCall(false),
+		))
+		suite.Require().NoError(err)
+		suite.Require().NotEmpty(res.VmError)
+
+		suite.Equal(withdrawAddressBefore, withdrawAddressOf(from), "changes of the successful call must be reverted")
+	})
+
+	suite.Run("fail - target must be a custom precompiled contract", func() {
+		from := account1.GetEthAddress()
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcMulticallFixedAddress, buildInput(
+			abi.MulticallCall{
+				Target:       account2.GetEthAddress(),
+				CallData:     []byte{},
+				AllowFailure: true,
+			},
+		))
+		suite.Require().NoError(err)
+		suite.Require().NotEmpty(res.VmError)
+	})
+
+	suite.Run("fail - not allowed to call multicall contract", func() {
+		from := account1.GetEthAddress()
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcMulticallFixedAddress, buildInput(
+			abi.MulticallCall{
+				Target:       cpctypes.CpcMulticallFixedAddress,
+				CallData:     get4BytesSignature("name()"),
+				AllowFailure: true,
+			},
+		))
+		suite.Require().NoError(err)
+		suite.Require().NotEmpty(res.VmError)
+	})
+
+	suite.Run("fail - batch stops when the remaining gas can not cover a call, even if allow failure", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		params := suite.App().CpcKeeper().GetParams(ctx)
+		params.GasSchedule = []cpctypes.CustomPrecompiledContractMethodGas{{
+			CustomPrecompiledType: cpctypes.CpcTypeDistribution,
+			MethodSelector:        "0x" + common.Bytes2Hex(get4BytesSignature("withdrawAddressOf(address)")),
+			BaseGas:               math.MaxUint64,
+		}}
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+
+		from := account1.GetEthAddress()
+		res, err := suite.EthCallApply(ctx, &from, cpctypes.CpcMulticallFixedAddress, buildInput(
+			abi.MulticallCall{
+				Target:       cpctypes.CpcDistributionFixedAddress,
+				CallData:     simpleBuildContractInput(get4BytesSignature("withdrawAddressOf(address)"), from),
+				AllowFailure: true,
+			},
+			setWithdrawAddressCall(account2.GetEthAddress(), true),
+		))
+		suite.Require().NoError(err)
+		suite.Equal(fmt.Sprintf("call 0 to %s failed: %s", cpctypes.CpcDistributionFixedAddress, vm.ErrOutOfGas), res.VmError)
+	})
+}
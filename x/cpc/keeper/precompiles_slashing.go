@@ -0,0 +1,406 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeploySlashingCustomPrecompiledContract deploys a new slashing custom precompiled contract.
+func (k Keeper) DeploySlashingCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcSlashingFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeSlashing,
+		Name:                  "Slashing - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &slashingCustomPrecompiledContract{}
+
+// slashingCustomPrecompiledContract allows EVM accounts to interact with the `x/slashing` module,
+// and allows validator operators, whose operator address is the caller, to manage their validator.
+type slashingCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewSlashingCustomPrecompiledContract creates a new slashing custom precompiled contract.
+func NewSlashingCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &slashingCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&slashingCustomPrecompiledContractRoName{contract: contract},
+		&slashingCustomPrecompiledContractRoSigningInfo{contract: contract},
+		&slashingCustomPrecompiledContractRoMissedBlocksCounter{contract: contract},
+		&slashingCustomPrecompiledContractRwUnjail{contract: contract},
+		&slashingCustomPrecompiledContractRwEditValidatorDescription{contract: contract},
+		&slashingCustomPrecompiledContractRwEditValidatorCommissionRate{contract: contract},
+	}
+
+	return contract
+}
+
+func (m slashingCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m slashingCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+// getSigningInfo returns the consensus address and the signing info of the validator.
+func (m slashingCustomPrecompiledContract) getSigningInfo(ctx sdk.Context, validatorAddr common.Address) (sdk.ConsAddress, slashingtypes.ValidatorSigningInfo, error) {
+	validator, err := m.keeper.stakingKeeper.GetValidator(ctx, validatorAddr.Bytes())
+	if err != nil {
+		return nil, slashingtypes.ValidatorSigningInfo{}, err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, slashingtypes.ValidatorSigningInfo{}, err
+	}
+
+	signingInfo, err := m.keeper.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return nil, slashingtypes.ValidatorSigningInfo{}, err
+	}
+
+	return consAddr, signingInfo, nil
+}
+
+// editValidator edits the validator operated by the operator.
+func (m slashingCustomPrecompiledContract) editValidator(
+	ctx sdk.Context,
+	operator common.Address,
+	description stakingtypes.Description,
+	newRate *sdkmath.LegacyDec,
+) error {
+	sk := m.keeper.stakingKeeper
+
+	valAddrStr, err := sk.ValidatorAddressCodec().BytesToString(operator.Bytes())
+	if err != nil {
+		return err
+	}
+
+	msgEditValidator := stakingtypes.NewMsgEditValidator(
+		valAddrStr,  // validator
+		description, // description
+		newRate,     // commission rate
+		nil,         // min self delegation, not changed
+	)
+	if _, err := stakingkeeper.NewMsgServerImpl(&sk).EditValidator(ctx, msgEditValidator); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m slashingCustomPrecompiledContract) emitsEventUnjail(validator common.Address, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcSlashingFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0xc3ef55ddda4bc9300706e15ab3aed03c762d8afd43a7d358a7b9503cb39f281b"), // Unjail(address)
+			common.BytesToHash(validator.Bytes()),
+		},
+	})
+}
+
+func (m slashingCustomPrecompiledContract) emitsEventEditValidator(validator common.Address, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcSlashingFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x6c5c0ac9d05624b73dab14b77b8df189a0637d9067d7875eac4ab64720d30876"), // EditValidator(address)
+			common.BytesToHash(validator.Bytes()),
+		},
+	})
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &slashingCustomPrecompiledContractRoName{}
+
+type slashingCustomPrecompiledContractRoName struct {
+	contract *slashingCustomPrecompiledContract
+}
+
+func (e slashingCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.SlashingCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.SlashingCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e slashingCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e slashingCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e slashingCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// signingInfo(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &slashingCustomPrecompiledContractRoSigningInfo{}
+
+type slashingCustomPrecompiledContractRoSigningInfo struct {
+	contract *slashingCustomPrecompiledContract
+}
+
+func (e slashingCustomPrecompiledContractRoSigningInfo) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.SlashingCpcInfo.UnpackMethodInput("signingInfo", input)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAddr := ips[0].(common.Address)
+
+	consAddr, signingInfo, err := e.contract.getSigningInfo(env.ctx, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var jailedUntil uint64
+	if unix := signingInfo.JailedUntil.Unix(); unix > 0 {
+		jailedUntil = uint64(unix)
+	}
+
+	return abi.SlashingCpcInfo.PackMethodOutput("signingInfo", abi.SlashingSigningInfo{
+		ConsensusAddress:    common.BytesToAddress(consAddr),
+		StartHeight:         signingInfo.StartHeight,
+		IndexOffset:         signingInfo.IndexOffset,
+		JailedUntil:         jailedUntil,
+		Tombstoned:          signingInfo.Tombstoned,
+		MissedBlocksCounter: signingInfo.MissedBlocksCounter,
+	})
+}
+
+func (e slashingCustomPrecompiledContractRoSigningInfo) Method4BytesSignatures() []byte {
+	return []byte{0x0f, 0xc4, 0x98, 0xb4}
+}
+
+func (e slashingCustomPrecompiledContractRoSigningInfo) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e slashingCustomPrecompiledContractRoSigningInfo) ReadOnly() bool {
+	return true
+}
+
+// missedBlocksCounter(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &slashingCustomPrecompiledContractRoMissedBlocksCounter{}
+
+type slashingCustomPrecompiledContractRoMissedBlocksCounter struct {
+	contract *slashingCustomPrecompiledContract
+}
+
+func (e slashingCustomPrecompiledContractRoMissedBlocksCounter) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.SlashingCpcInfo.UnpackMethodInput("missedBlocksCounter", input)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAddr := ips[0].(common.Address)
+
+	_, signingInfo, err := e.contract.getSigningInfo(env.ctx, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	signedBlocksWindow, err := e.contract.keeper.slashingKeeper.SignedBlocksWindow(env.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.SlashingCpcInfo.PackMethodOutput("missedBlocksCounter", signingInfo.MissedBlocksCounter, signedBlocksWindow)
+}
+
+func (e slashingCustomPrecompiledContractRoMissedBlocksCounter) Method4BytesSignatures() []byte {
+	return []byte{0x95, 0xdd, 0x5e, 0xe2}
+}
+
+func (e slashingCustomPrecompiledContractRoMissedBlocksCounter) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e slashingCustomPrecompiledContractRoMissedBlocksCounter) ReadOnly() bool {
+	return true
+}
+
+// unjail()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &slashingCustomPrecompiledContractRwUnjail{}
+
+type slashingCustomPrecompiledContractRwUnjail struct {
+	contract *slashingCustomPrecompiledContract
+}
+
+func (e slashingCustomPrecompiledContractRwUnjail) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.SlashingCpcInfo.UnpackMethodInput("unjail", input)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrStr, err := e.contract.keeper.stakingKeeper.ValidatorAddressCodec().BytesToString(caller.Address().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	msgUnjail := slashingtypes.NewMsgUnjail(valAddrStr)
+	if _, err := slashingkeeper.NewMsgServerImpl(e.contract.keeper.slashingKeeper).Unjail(env.ctx, msgUnjail); err != nil {
+		return nil, err
+	}
+
+	e.contract.emitsEventUnjail(caller.Address(), env)
+
+	return abi.SlashingCpcInfo.PackMethodOutput("unjail", true)
+}
+
+func (e slashingCustomPrecompiledContractRwUnjail) Method4BytesSignatures() []byte {
+	return []byte{0xf6, 0x79, 0xd3, 0x05}
+}
+
+func (e slashingCustomPrecompiledContractRwUnjail) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e slashingCustomPrecompiledContractRwUnjail) ReadOnly() bool {
+	return false
+}
+
+// editValidatorDescription(ValidatorDescription)
+// sig delivered from: editValidatorDescription((string,string,string,string,string))
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &slashingCustomPrecompiledContractRwEditValidatorDescription{}
+
+type slashingCustomPrecompiledContractRwEditValidatorDescription struct {
+	contract *slashingCustomPrecompiledContract
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorDescription) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.SlashingCpcInfo.UnpackMethodInput("editValidatorDescription", input)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := abi.SlashingValidatorDescriptionFromUnpacked(ips[0])
+	if err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "failed to parse validator description: %s", err.Error())
+	}
+
+	if err := e.contract.editValidator(
+		env.ctx,
+		caller.Address(),
+		stakingtypes.NewDescription(description.Moniker, description.Identity, description.Website, description.SecurityContact, description.Details),
+		nil, // commission rate, not changed
+	); err != nil {
+		return nil, err
+	}
+
+	e.contract.emitsEventEditValidator(caller.Address(), env)
+
+	return abi.SlashingCpcInfo.PackMethodOutput("editValidatorDescription", true)
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorDescription) Method4BytesSignatures() []byte {
+	return []byte{0x6b, 0xec, 0x4e, 0x8f}
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorDescription) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorDescription) ReadOnly() bool {
+	return false
+}
+
+// editValidatorCommissionRate(uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &slashingCustomPrecompiledContractRwEditValidatorCommissionRate{}
+
+type slashingCustomPrecompiledContractRwEditValidatorCommissionRate struct {
+	contract *slashingCustomPrecompiledContract
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorCommissionRate) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.SlashingCpcInfo.UnpackMethodInput("editValidatorCommissionRate", input)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionRate := ips[0].(*big.Int)
+	if commissionRate.Cmp(sdkmath.LegacyOneDec().BigInt()) > 0 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "commission rate cannot be greater than 100%")
+	}
+	newRate := sdkmath.LegacyNewDecFromBigIntWithPrec(commissionRate, sdkmath.LegacyPrecision)
+
+	doNotModifyDescription := stakingtypes.NewDescription(
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+	)
+
+	if err := e.contract.editValidator(env.ctx, caller.Address(), doNotModifyDescription, &newRate); err != nil {
+		return nil, err
+	}
+
+	e.contract.emitsEventEditValidator(caller.Address(), env)
+
+	return abi.SlashingCpcInfo.PackMethodOutput("editValidatorCommissionRate", true)
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorCommissionRate) Method4BytesSignatures() []byte {
+	return []byte{0x03, 0x86, 0xf5, 0x99}
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorCommissionRate) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e slashingCustomPrecompiledContractRwEditValidatorCommissionRate) ReadOnly() bool {
+	return false
+}
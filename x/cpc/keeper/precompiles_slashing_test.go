@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	topic0Unjail        = "0xc3ef55ddda4bc9300706e15ab3aed03c762d8afd43a7d358a7b9503cb39f281b"
+	topic0EditValidator = "0x6c5c0ac9d05624b73dab14b77b8df189a0637d9067d7875eac4ab64720d30876"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeploySlashingCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcSlashingFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeploySlashingCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcSlashingFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcSlashingFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_SlashingCustomPrecompiledContract_Topic0() {
+	suite.Equal(common.HexToHash(topic0Unjail), abi.SlashingCpcInfo.ABI.Events["Unjail"].ID)
+	suite.Equal(common.HexToHash(topic0EditValidator), abi.SlashingCpcInfo.ABI.Events["EditValidator"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_SlashingCustomPrecompiledContract() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	operator := suite.CITS.WalletAccounts.Number(3)
+
+	suite.createValidator(suite.Ctx(), operator, sdkmath.NewInt(1e9))
+	validator, err := suite.App().StakingKeeper().GetValidator(suite.Ctx(), operator.GetValidatorAddress())
+	suite.Require().NoError(err)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+
+	err = suite.App().SlashingKeeper().SetValidatorSigningInfo(suite.Ctx(), consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr,
+		suite.Ctx().BlockHeight(), // start height
+		3,                         // index offset
+		time.Unix(0, 0).UTC(),     // jailed until
+		false,                     // tombstoned
+		2,                         // missed blocks counter
+	))
+	suite.Require().NoError(err)
+
+	callContract := func(ctx sdk.Context, from common.Address, input []byte) (ret []byte, logs []*ethtypes.Log, vmErr string) {
+		res, err := suite.EthCallApply(ctx, &from, cpctypes.CpcSlashingFixedAddress, input)
+		suite.Require().NoError(err)
+
+		receipt := &ethtypes.Receipt{}
+		suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+
+		return res.Ret, receipt.Logs, res.VmError
+	}
+
+	suite.Run("pass - query signing info", func() {
+		ret, _, vmErr := callContract(suite.Ctx(), account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("signingInfo(address)"), operator.GetEthAddress()))
+		suite.Require().Empty(vmErr)
+
+		ops, err := abi.SlashingCpcInfo.ABI.Methods["signingInfo"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(ops, 1)
+
+		suite.Equal(fmt.Sprintf("%v", abi.SlashingSigningInfo{
+			ConsensusAddress:    common.BytesToAddress(consAddr),
+			StartHeight:         suite.Ctx().BlockHeight(),
+			IndexOffset:         3,
+			JailedUntil:         0,
+			Tombstoned:          false,
+			MissedBlocksCounter: 2,
+		}), fmt.Sprintf("%v", ops[0]))
+	})
+
+	suite.Run("pass - query missed blocks counter", func() {
+		ret, _, vmErr := callContract(suite.Ctx(), account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("missedBlocksCounter(address)"), operator.GetEthAddress()))
+		suite.Require().Empty(vmErr)
+
+		ops, err := abi.SlashingCpcInfo.ABI.Methods["missedBlocksCounter"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(ops, 2)
+
+		signedBlocksWindow, err := suite.App().SlashingKeeper().SignedBlocksWindow(suite.Ctx())
+		suite.Require().NoError(err)
+
+		suite.Equal(int64(2), ops[0].(int64))
+		suite.Equal(signedBlocksWindow, ops[1].(int64))
+	})
+
+	suite.Run("fail - query signing info of non-validator", func() {
+		_, _, vmErr := callContract(suite.Ctx(), account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("signingInfo(address)"), account1.GetEthAddress()))
+		suite.Require().NotEmpty(vmErr)
+	})
+
+	suite.Run("fail - unjail non-jailed validator", func() {
+		_, _, vmErr := callContract(suite.Ctx(), operator.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("unjail()")))
+		suite.Require().Contains(vmErr, "validator not jailed")
+	})
+
+	suite.Run("fail - unjail by non-validator", func() {
+		_, _, vmErr := callContract(suite.Ctx(), account1.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("unjail()")))
+		suite.Require().NotEmpty(vmErr)
+	})
+
+	suite.Run("pass - unjail", func() {
+		err := suite.App().StakingKeeper().Jail(suite.Ctx(), consAddr)
+		suite.Require().NoError(err)
+
+		ret, logs, vmErr := callContract(suite.Ctx(), operator.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("unjail()")))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0Unjail, logs[0].Topics[0].String())
+		suite.Equal(operator.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+
+		validator, err := suite.App().StakingKeeper().GetValidator(suite.Ctx(), operator.GetValidatorAddress())
+		suite.Require().NoError(err)
+		suite.False(validator.IsJailed())
+	})
+
+	suite.Run("pass - edit validator description", func() {
+		description := abi.SlashingValidatorDescription{
+			Moniker:         "new moniker",
+			Identity:        "[do-not-modify]",
+			Website:         "https://example.com",
+			SecurityContact: "[do-not-modify]",
+			Details:         "new details",
+		}
+		bz, err := abi.SlashingCpcInfo.ABI.Methods["editValidatorDescription"].Inputs.Pack(description)
+		suite.Require().NoError(err)
+
+		ret, logs, vmErr := callContract(suite.Ctx(), operator.GetEthAddress(), append(get4BytesSignature("editValidatorDescription((string,string,string,string,string))"), bz...))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0EditValidator, logs[0].Topics[0].String())
+		suite.Equal(operator.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+
+		validator, err := suite.App().StakingKeeper().GetValidator(suite.Ctx(), operator.GetValidatorAddress())
+		suite.Require().NoError(err)
+		suite.Equal("new moniker", validator.Description.Moniker)
+		suite.Equal("https://example.com", validator.Description.Website)
+		suite.Equal("new details", validator.Description.Details)
+	})
+
+	suite.Run("fail - edit commission rate within 24h since the last change", func() {
+		_, _, vmErr := callContract(suite.Ctx(), operator.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("editValidatorCommissionRate(uint256)"), sdkmath.LegacyNewDecWithPrec(4, 1).BigInt()))
+		suite.Require().Contains(vmErr, "commission cannot be changed more than once in 24h")
+	})
+
+	suite.Run("fail - edit commission rate greater than 100%", func() {
+		_, _, vmErr := callContract(suite.Ctx(), operator.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("editValidatorCommissionRate(uint256)"), new(big.Int).Add(sdkmath.LegacyOneDec().BigInt(), big.NewInt(1))))
+		suite.Require().Contains(vmErr, "commission rate cannot be greater than 100%")
+	})
+
+	suite.Run("pass - edit commission rate", func() {
+		ctx := suite.Ctx().WithBlockTime(suite.Ctx().BlockTime().Add(25 * time.Hour))
+
+		ret, logs, vmErr := callContract(ctx, operator.GetEthAddress(), simpleBuildContractInput(get4BytesSignature("editValidatorCommissionRate(uint256)"), sdkmath.LegacyNewDecWithPrec(4, 1).BigInt()))
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0EditValidator, logs[0].Topics[0].String())
+
+		validator, err := suite.App().StakingKeeper().GetValidator(ctx, operator.GetValidatorAddress())
+		suite.Require().NoError(err)
+		suite.Equal(sdkmath.LegacyNewDecWithPrec(4, 1).String(), validator.Commission.Rate.String())
+		suite.Equal(ctx.BlockTime().Unix(), validator.Commission.UpdateTime.Unix())
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/EscanBE/everlast/x/cpc/eip712"
@@ -24,6 +25,7 @@ import (
 	corevm "github.com/ethereum/go-ethereum/core/vm"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -85,6 +87,7 @@ func NewStakingCustomPrecompiledContract(
 	delegateME := stakingCustomPrecompiledContractRwDelegate{contract: contract}
 	undelegateME := stakingCustomPrecompiledContractRwUnDelegate{contract: contract}
 	redelegateME := stakingCustomPrecompiledContractRwReDelegate{contract: contract}
+	cancelUnbondingDelegationME := stakingCustomPrecompiledContractRwCancelUnbondingDelegation{contract: contract}
 	withdrawRewardME := stakingCustomPrecompiledContractRwWithdrawReward{contract: contract}
 	withdrawRewardsME := stakingCustomPrecompiledContractRwWithdrawRewards{
 		withdrawReward: withdrawRewardME,
@@ -99,9 +102,18 @@ func NewStakingCustomPrecompiledContract(
 		&stakingCustomPrecompiledContractRoTotalDelegationOf{contract: contract},
 		&stakingCustomPrecompiledContractRoRewardOf{contract: contract},
 		&rewardsOfME,
+		&stakingCustomPrecompiledContractRoUnbondingDelegationsOf{contract: contract},
+		&stakingCustomPrecompiledContractRoRedelegationsOf{contract: contract},
+		&stakingCustomPrecompiledContractRoValidatorInfo{contract: contract},
+		&stakingCustomPrecompiledContractRoValidators{contract: contract},
+		&stakingCustomPrecompiledContractRoBondedRatio{contract: contract},
 		&delegateME,
 		&undelegateME,
 		&redelegateME,
+		&cancelUnbondingDelegationME,
+		&stakingCustomPrecompiledContractRwCancelUnbondingDelegationByMessage{
+			cancelUnbondingDelegation: cancelUnbondingDelegationME,
+		},
 		&stakingCustomPrecompiledContractRwDelegateByActionMessage{
 			delegate:   delegateME,
 			undelegate: undelegateME,
@@ -148,6 +160,27 @@ func (m *stakingCustomPrecompiledContract) minimumRewardWithdrawalAmount() sdkma
 	return oneCoin.QuoRaw(1_000)
 }
 
+// toValidatorInfo converts the validator into the `ValidatorInfo` struct of the contract.
+func (m stakingCustomPrecompiledContract) toValidatorInfo(validator stakingtypes.Validator) (abi.StakingValidatorInfo, error) {
+	valAddr, err := m.keeper.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.OperatorAddress)
+	if err != nil {
+		return abi.StakingValidatorInfo{}, errorsmod.Wrapf(err, "failed to convert validator address: %s", validator.OperatorAddress)
+	}
+
+	return abi.StakingValidatorInfo{
+		Validator:               common.BytesToAddress(valAddr),
+		Moniker:                 validator.GetMoniker(),
+		Status:                  uint8(validator.GetStatus()),
+		Jailed:                  validator.IsJailed(),
+		Tokens:                  validator.Tokens.BigInt(),
+		DelegatorShares:         validator.DelegatorShares.BigInt(),
+		CommissionRate:          validator.Commission.Rate.BigInt(),
+		CommissionMaxRate:       validator.Commission.MaxRate.BigInt(),
+		CommissionMaxChangeRate: validator.Commission.MaxChangeRate.BigInt(),
+		MinSelfDelegation:       validator.MinSelfDelegation.BigInt(),
+	}, nil
+}
+
 func (m stakingCustomPrecompiledContract) emitsEventDelegate(delegator, validator common.Address, amount *big.Int, env cpcExecutorEnv) {
 	env.evm.StateDB.AddLog(&ethtypes.Log{
 		Address: cpctypes.CpcStakingFixedAddress,
@@ -184,7 +217,19 @@ func (m stakingCustomPrecompiledContract) emitsEventWithdrawReward(delegator, va
 	})
 }
 
-// autoEmitEventsFromSdkEvents emits Delegate/Undelegate/WithdrawReward events based on sdk events emitted by modules.
+func (m stakingCustomPrecompiledContract) emitsEventCancelUnbonding(delegator, validator common.Address, amount *big.Int, creationHeight int64, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcStakingFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0xee099b9add525aa7957ce7002da91ca20f755cfaf8f5c178b028b06a0b84b951"), // CancelUnbonding(address,address,uint256,int64)
+			common.BytesToHash(delegator.Bytes()),
+			common.BytesToHash(validator.Bytes()),
+		},
+		Data: append(common.BytesToHash(amount.Bytes()).Bytes(), common.BigToHash(big.NewInt(creationHeight)).Bytes()...),
+	})
+}
+
+// autoEmitEventsFromSdkEvents emits Delegate/Undelegate/WithdrawReward/CancelUnbonding events based on sdk events emitted by modules.
 func (m stakingCustomPrecompiledContract) autoEmitEventsFromSdkEvents(
 	em sdk.EventManagerI, originalEventCounts int, delegator sdk.AccAddress, env cpcExecutorEnv,
 ) error {
@@ -277,6 +322,21 @@ func (m stakingCustomPrecompiledContract) autoEmitEventsFromSdkEvents(
 				m.emitsEventUnDelegate(common.BytesToAddress(delegator), common.BytesToAddress(srcValidator), amount, env)
 				m.emitsEventDelegate(common.BytesToAddress(delegator), common.BytesToAddress(dstValidator), amount, env)
 			}
+		} else if event.Type == stakingtypes.EventTypeCancelUnbondingDelegation {
+			validator, delegator, amount, err := genericExtractValidatorDelegatorAmount()
+			if err != nil {
+				return err
+			}
+
+			avCreationHeight := event.Attributes[stakingtypes.AttributeKeyCreationHeight]
+			creationHeight, err := strconv.ParseInt(avCreationHeight, 10, 64)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to parse creation height: %s", avCreationHeight)
+			}
+
+			if amount.Sign() == 1 {
+				m.emitsEventCancelUnbonding(common.BytesToAddress(delegator), common.BytesToAddress(validator), amount, creationHeight, env)
+			}
 		} else if event.Type == disttypes.EventTypeWithdrawRewards {
 			validator, delegator, amount, err := genericExtractValidatorDelegatorAmount()
 			if err != nil {
@@ -328,6 +388,14 @@ func (m stakingCustomPrecompiledContract) getSdkEventsFromEventManager(em sdk.Ev
 			return newNormalizedEvent(
 				stakingtypes.AttributeKeySrcValidator, stakingtypes.AttributeKeyDstValidator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyCompletionTime,
 			).requireAttributesCountOrNil(wantAttributesCount)
+		case stakingtypes.EventTypeCancelUnbondingDelegation:
+			const wantAttributesCount = 4
+			if len(event.Attributes) != wantAttributesCount {
+				return nil
+			}
+			return newNormalizedEvent(
+				sdk.AttributeKeyAmount, stakingtypes.AttributeKeyValidator, stakingtypes.AttributeKeyDelegator, stakingtypes.AttributeKeyCreationHeight,
+			).requireAttributesCountOrNil(wantAttributesCount)
 		case disttypes.EventTypeWithdrawRewards:
 			const wantAttributesCount = 3
 			if len(event.Attributes) != wantAttributesCount {
@@ -451,6 +519,9 @@ func (e stakingCustomPrecompiledContractRoDelegatedValidators) Execute(_ corevm.
 	if err != nil {
 		return nil, err
 	}
+	if err := env.consumeIterationGas(len(delegations)); err != nil {
+		return nil, err
+	}
 
 	var validators []common.Address
 	for _, delegation := range delegations {
@@ -654,7 +725,7 @@ func (e stakingCustomPrecompiledContractRoRewardsOf) Execute(_ corevm.ContractRe
 	}
 
 	delegatorAddr := ips[0].(common.Address)
-	totalRewards, err := e.getTotalRewards(ctx, delegatorAddr, bondDenom)
+	totalRewards, err := e.getTotalRewards(env, delegatorAddr, bondDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -662,13 +733,16 @@ func (e stakingCustomPrecompiledContractRoRewardsOf) Execute(_ corevm.ContractRe
 	return abi.StakingCpcInfo.PackMethodOutput("rewardsOf", totalRewards.BigInt())
 }
 
-func (e stakingCustomPrecompiledContractRoRewardsOf) getTotalRewards(ctx sdk.Context, addr common.Address, bondDenom string) (sdkmath.Int, error) {
-	resRewards, err := distkeeper.NewQuerier(e.contract.keeper.distKeeper).DelegationTotalRewards(ctx, &disttypes.QueryDelegationTotalRewardsRequest{
+func (e stakingCustomPrecompiledContractRoRewardsOf) getTotalRewards(env cpcExecutorEnv, addr common.Address, bondDenom string) (sdkmath.Int, error) {
+	resRewards, err := distkeeper.NewQuerier(e.contract.keeper.distKeeper).DelegationTotalRewards(env.ctx, &disttypes.QueryDelegationTotalRewardsRequest{
 		DelegatorAddress: sdk.AccAddress(addr.Bytes()).String(),
 	})
 	if err != nil {
 		return sdkmath.ZeroInt(), err
 	}
+	if err := env.consumeIterationGas(len(resRewards.Rewards)); err != nil {
+		return sdkmath.ZeroInt(), err
+	}
 
 	return resRewards.Total.AmountOf(bondDenom).TruncateInt(), nil
 }
@@ -685,6 +759,278 @@ func (e stakingCustomPrecompiledContractRoRewardsOf) ReadOnly() bool {
 	return true
 }
 
+// unbondingDelegationsOf(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRoUnbondingDelegationsOf{}
+
+type stakingCustomPrecompiledContractRoUnbondingDelegationsOf struct {
+	contract *stakingCustomPrecompiledContract
+}
+
+func (e stakingCustomPrecompiledContractRoUnbondingDelegationsOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.StakingCpcInfo.UnpackMethodInput("unbondingDelegationsOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.contract.keeper.stakingKeeper
+
+	delegatorAddr := ips[0].(common.Address)
+	valAddrCodec := sk.ValidatorAddressCodec()
+
+	unbondingDelegations, err := sk.GetAllUnbondingDelegations(ctx, delegatorAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]abi.StakingUnbondingDelegationEntry, 0)
+	for _, unbondingDelegation := range unbondingDelegations {
+		valAddr, err := valAddrCodec.StringToBytes(unbondingDelegation.ValidatorAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert validator address: %s", unbondingDelegation.ValidatorAddress)
+		}
+
+		for _, entry := range unbondingDelegation.Entries {
+			entries = append(entries, abi.StakingUnbondingDelegationEntry{
+				Validator:      common.BytesToAddress(valAddr),
+				CreationHeight: entry.CreationHeight,
+				CompletionTime: uint64(entry.CompletionTime.Unix()),
+				InitialBalance: entry.InitialBalance.BigInt(),
+				Balance:        entry.Balance.BigInt(),
+			})
+		}
+	}
+	if err := env.consumeIterationGas(len(entries)); err != nil {
+		return nil, err
+	}
+
+	return abi.StakingCpcInfo.PackMethodOutput("unbondingDelegationsOf", entries)
+}
+
+func (e stakingCustomPrecompiledContractRoUnbondingDelegationsOf) Method4BytesSignatures() []byte {
+	return []byte{0x6b, 0xc4, 0x5c, 0xf2}
+}
+
+func (e stakingCustomPrecompiledContractRoUnbondingDelegationsOf) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e stakingCustomPrecompiledContractRoUnbondingDelegationsOf) ReadOnly() bool {
+	return true
+}
+
+// redelegationsOf(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRoRedelegationsOf{}
+
+type stakingCustomPrecompiledContractRoRedelegationsOf struct {
+	contract *stakingCustomPrecompiledContract
+}
+
+func (e stakingCustomPrecompiledContractRoRedelegationsOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.StakingCpcInfo.UnpackMethodInput("redelegationsOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.contract.keeper.stakingKeeper
+
+	delegatorAddr := ips[0].(common.Address)
+	valAddrCodec := sk.ValidatorAddressCodec()
+
+	redelegations, err := sk.GetAllRedelegations(ctx, delegatorAddr.Bytes(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]abi.StakingRedelegationEntry, 0)
+	for _, redelegation := range redelegations {
+		srcValAddr, err := valAddrCodec.StringToBytes(redelegation.ValidatorSrcAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert validator address: %s", redelegation.ValidatorSrcAddress)
+		}
+		dstValAddr, err := valAddrCodec.StringToBytes(redelegation.ValidatorDstAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert validator address: %s", redelegation.ValidatorDstAddress)
+		}
+
+		for _, entry := range redelegation.Entries {
+			entries = append(entries, abi.StakingRedelegationEntry{
+				SrcValidator:   common.BytesToAddress(srcValAddr),
+				DstValidator:   common.BytesToAddress(dstValAddr),
+				CreationHeight: entry.CreationHeight,
+				CompletionTime: uint64(entry.CompletionTime.Unix()),
+				InitialBalance: entry.InitialBalance.BigInt(),
+				SharesDst:      entry.SharesDst.BigInt(),
+			})
+		}
+	}
+	if err := env.consumeIterationGas(len(entries)); err != nil {
+		return nil, err
+	}
+
+	return abi.StakingCpcInfo.PackMethodOutput("redelegationsOf", entries)
+}
+
+func (e stakingCustomPrecompiledContractRoRedelegationsOf) Method4BytesSignatures() []byte {
+	return []byte{0x61, 0x79, 0xb2, 0x0f}
+}
+
+func (e stakingCustomPrecompiledContractRoRedelegationsOf) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e stakingCustomPrecompiledContractRoRedelegationsOf) ReadOnly() bool {
+	return true
+}
+
+// validatorInfo(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRoValidatorInfo{}
+
+type stakingCustomPrecompiledContractRoValidatorInfo struct {
+	contract *stakingCustomPrecompiledContract
+}
+
+func (e stakingCustomPrecompiledContractRoValidatorInfo) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.StakingCpcInfo.UnpackMethodInput("validatorInfo", input)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAddr := ips[0].(common.Address)
+
+	validator, err := e.contract.keeper.stakingKeeper.GetValidator(env.ctx, validatorAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	validatorInfo, err := e.contract.toValidatorInfo(validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.StakingCpcInfo.PackMethodOutput("validatorInfo", validatorInfo)
+}
+
+func (e stakingCustomPrecompiledContractRoValidatorInfo) Method4BytesSignatures() []byte {
+	return []byte{0x4f, 0x18, 0x11, 0xdd}
+}
+
+func (e stakingCustomPrecompiledContractRoValidatorInfo) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e stakingCustomPrecompiledContractRoValidatorInfo) ReadOnly() bool {
+	return true
+}
+
+// validators(uint256,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRoValidators{}
+
+type stakingCustomPrecompiledContractRoValidators struct {
+	contract *stakingCustomPrecompiledContract
+}
+
+// maxValidatorsPageSize is the maximum number of validators returned by a single call of `validators(uint256,uint256)`.
+const maxValidatorsPageSize = 100
+
+func (e stakingCustomPrecompiledContractRoValidators) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.StakingCpcInfo.UnpackMethodInput("validators", input)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := ips[0].(*big.Int)
+	limit := ips[1].(*big.Int)
+
+	if !offset.IsUint64() {
+		return nil, errors.New("offset is too large")
+	}
+	if limit.Sign() < 1 {
+		return nil, errors.New("limit must be positive")
+	}
+	pageSize := uint64(maxValidatorsPageSize)
+	if limit.Cmp(big.NewInt(maxValidatorsPageSize)) < 0 {
+		pageSize = limit.Uint64()
+	}
+
+	sk := e.contract.keeper.stakingKeeper
+	res, err := stakingkeeper.NewQuerier(&sk).Validators(env.ctx, &stakingtypes.QueryValidatorsRequest{
+		Pagination: &query.PageRequest{
+			Offset:     offset.Uint64(),
+			Limit:      pageSize,
+			CountTotal: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := env.consumeIterationGas(len(res.Validators)); err != nil {
+		return nil, err
+	}
+
+	validatorsInfo := make([]abi.StakingValidatorInfo, 0, len(res.Validators))
+	for _, validator := range res.Validators {
+		validatorInfo, err := e.contract.toValidatorInfo(validator)
+		if err != nil {
+			return nil, err
+		}
+		validatorsInfo = append(validatorsInfo, validatorInfo)
+	}
+
+	return abi.StakingCpcInfo.PackMethodOutput("validators", validatorsInfo, new(big.Int).SetUint64(res.Pagination.Total))
+}
+
+func (e stakingCustomPrecompiledContractRoValidators) Method4BytesSignatures() []byte {
+	return []byte{0xdc, 0xf2, 0x79, 0x3a}
+}
+
+func (e stakingCustomPrecompiledContractRoValidators) RequireGas() uint64 {
+	return 20_000
+}
+
+func (e stakingCustomPrecompiledContractRoValidators) ReadOnly() bool {
+	return true
+}
+
+// bondedRatio()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRoBondedRatio{}
+
+type stakingCustomPrecompiledContractRoBondedRatio struct {
+	contract *stakingCustomPrecompiledContract
+}
+
+func (e stakingCustomPrecompiledContractRoBondedRatio) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.StakingCpcInfo.UnpackMethodInput("bondedRatio", input)
+	if err != nil {
+		return nil, err
+	}
+
+	bondedRatio, err := e.contract.keeper.stakingKeeper.BondedRatio(env.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.StakingCpcInfo.PackMethodOutput("bondedRatio", bondedRatio.BigInt())
+}
+
+func (e stakingCustomPrecompiledContractRoBondedRatio) Method4BytesSignatures() []byte {
+	return []byte{0x04, 0x1d, 0x97, 0xa3}
+}
+
+func (e stakingCustomPrecompiledContractRoBondedRatio) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e stakingCustomPrecompiledContractRoBondedRatio) ReadOnly() bool {
+	return true
+}
+
 // delegate(address,uint256)
 
 var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRwDelegate{}
@@ -941,6 +1287,177 @@ func (e stakingCustomPrecompiledContractRwReDelegate) ReadOnly() bool {
 	return false
 }
 
+// cancelUnbondingDelegation(address,uint256,int64)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRwCancelUnbondingDelegation{}
+
+type stakingCustomPrecompiledContractRwCancelUnbondingDelegation struct {
+	contract *stakingCustomPrecompiledContract
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegation) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.StakingCpcInfo.UnpackMethodInput("cancelUnbondingDelegation", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.contract.keeper.stakingKeeper
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := sdk.AccAddress(caller.Address().Bytes())
+	valAddr := ips[0].(common.Address)
+	amount := ips[1].(*big.Int)
+	creationHeight := ips[2].(int64)
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "cancel unbonding amount must be positive")
+	}
+	if creationHeight < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "creation height must be positive")
+	}
+
+	originalStakingEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+
+	if err := e.cancelUnbondingDelegation(
+		ctx,
+		delegator, valAddr.Bytes(),
+		sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount)),
+		creationHeight,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.StakingCpcInfo.PackMethodOutput("cancelUnbondingDelegation", true)
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegation) cancelUnbondingDelegation(
+	ctx sdk.Context,
+	delegator sdk.AccAddress, validator sdk.ValAddress,
+	amount sdk.Coin,
+	creationHeight int64,
+) error {
+	sk := e.contract.keeper.stakingKeeper
+
+	valAddrCodec := sk.ValidatorAddressCodec()
+	valAddrStr, err := valAddrCodec.BytesToString(validator.Bytes())
+	if err != nil {
+		return err
+	}
+
+	msgCancelUnbondingDelegation := stakingtypes.NewMsgCancelUnbondingDelegation(
+		delegator.String(), // delegator
+		valAddrStr,         // validator
+		creationHeight,     // creation height of the unbonding delegation entry
+		amount,             // cancel amount
+	)
+	if _, err := stakingkeeper.NewMsgServerImpl(&sk).CancelUnbondingDelegation(ctx, msgCancelUnbondingDelegation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegation) Method4BytesSignatures() []byte {
+	return []byte{0x69, 0xa2, 0xf5, 0x36}
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegation) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegation) ReadOnly() bool {
+	return false
+}
+
+// cancelUnbondingDelegationByMessage(CancelUnbondingMessage,bytes32,bytes32,uint8)
+// sig delivered from: cancelUnbondingDelegationByMessage((address,string,uint256,string,int64),bytes32,bytes32,uint8)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &stakingCustomPrecompiledContractRwCancelUnbondingDelegationByMessage{}
+
+type stakingCustomPrecompiledContractRwCancelUnbondingDelegationByMessage struct {
+	cancelUnbondingDelegation stakingCustomPrecompiledContractRwCancelUnbondingDelegation
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegationByMessage) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.StakingCpcInfo.UnpackMethodInput("cancelUnbondingDelegationByMessage", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	sk := e.cancelUnbondingDelegation.contract.keeper.stakingKeeper
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelUnbondingMessage := &abi.CancelUnbondingMessage{}
+	if err := cancelUnbondingMessage.FromUnpackedStruct(ips[0]); err != nil {
+		return nil, fmt.Errorf("failed to parse cancel unbonding message: %s", err.Error())
+	} else if err := cancelUnbondingMessage.Validate(sk.ValidatorAddressCodec(), bondDenom); err != nil {
+		return nil, err
+	}
+	r := ips[1].([32]byte)
+	s := ips[2].([32]byte)
+	v := ips[3].(uint8)
+
+	if caller.Address() != cancelUnbondingMessage.Delegator {
+		return nil, fmt.Errorf("not the caller: %s", cancelUnbondingMessage.Delegator)
+	}
+
+	delegator := cancelUnbondingMessage.Delegator
+	valAddrBz, err := sk.ValidatorAddressCodec().StringToBytes(cancelUnbondingMessage.Validator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid validator: %s", cancelUnbondingMessage.Validator)
+	}
+
+	match, recoveredAddr, err := eip712.VerifySignature(delegator, cancelUnbondingMessage, r, s, v, env.evm.ChainConfig().ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify signature: %s", err.Error())
+	}
+	if !match {
+		return nil, fmt.Errorf("signature does not match, got: %s", recoveredAddr.String())
+	}
+
+	originalStakingEventsCount := len(e.cancelUnbondingDelegation.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+
+	if err := e.cancelUnbondingDelegation.cancelUnbondingDelegation(
+		ctx,
+		delegator.Bytes(), valAddrBz,
+		sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(cancelUnbondingMessage.Amount)),
+		cancelUnbondingMessage.CreationHeight,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := e.cancelUnbondingDelegation.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator.Bytes(), env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.StakingCpcInfo.PackMethodOutput("cancelUnbondingDelegationByMessage", true)
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegationByMessage) Method4BytesSignatures() []byte {
+	return []byte{0x30, 0xb8, 0xd7, 0xdc}
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegationByMessage) RequireGas() uint64 {
+	return e.cancelUnbondingDelegation.RequireGas() + cpctypes.GasVerifyEIP712
+}
+
+func (e stakingCustomPrecompiledContractRwCancelUnbondingDelegationByMessage) ReadOnly() bool {
+	return false
+}
+
 // delegateByActionMessage(StakingMessage,bytes32,bytes32,uint8)
 // sig delivered from: delegateByActionMessage((string,address,string,uint256,string,string),bytes32,bytes32,uint8)
 
@@ -1139,7 +1656,7 @@ func (e stakingCustomPrecompiledContractRwWithdrawRewards) Execute(caller corevm
 
 	originalStakingEventsCount := len(e.withdrawReward.contract.getSdkEventsFromEventManager(ctx.EventManager()))
 
-	any, err := e.withdrawRewards(ctx, delegator)
+	any, err := e.withdrawRewards(env, delegator)
 	if err != nil {
 		return nil, err
 	}
@@ -1151,7 +1668,8 @@ func (e stakingCustomPrecompiledContractRwWithdrawRewards) Execute(caller corevm
 	return abi.StakingCpcInfo.PackMethodOutput("withdrawRewards", any)
 }
 
-func (e stakingCustomPrecompiledContractRwWithdrawRewards) withdrawRewards(ctx sdk.Context, delegator sdk.AccAddress) (any bool, err error) {
+func (e stakingCustomPrecompiledContractRwWithdrawRewards) withdrawRewards(env cpcExecutorEnv, delegator sdk.AccAddress) (any bool, err error) {
+	ctx := env.ctx
 	sk := e.withdrawReward.contract.keeper.stakingKeeper
 	dk := e.withdrawReward.contract.keeper.distKeeper
 
@@ -1168,6 +1686,9 @@ func (e stakingCustomPrecompiledContractRwWithdrawRewards) withdrawRewards(ctx s
 	if err != nil {
 		return false, err
 	}
+	if err := env.consumeIterationGas(len(allRewards.Rewards)); err != nil {
+		return false, err
+	}
 	if len(allRewards.Rewards) < 1 || allRewards.Total.IsZero() {
 		return false, nil
 	}
@@ -1255,7 +1776,7 @@ func (e stakingCustomPrecompiledContractRwWithdrawRewardsByMessage) Execute(call
 	var any bool
 
 	if withdrawRewardMessage.FromValidator == abi.WithdrawRewardMessageActionWithdrawFromAllValidators {
-		any, err = e.withdrawRewards.withdrawRewards(ctx, delegator.Bytes())
+		any, err = e.withdrawRewards.withdrawRewards(env, delegator.Bytes())
 		if err != nil {
 			return nil, err
 		}
@@ -1307,7 +1828,7 @@ func (e stakingCustomPrecompiledContractRoBalanceOf) Execute(_ corevm.ContractRe
 	}
 
 	delegatorAddr := ips[0].(common.Address)
-	totalRewards, err := e.rewardsOf.getTotalRewards(ctx, delegatorAddr, bondDenom)
+	totalRewards, err := e.rewardsOf.getTotalRewards(env, delegatorAddr, bondDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -1373,7 +1894,7 @@ func (e stakingCustomPrecompiledContractRwTransfer) Execute(caller corevm.Contra
 
 	originalStakingEventsCount := len(e.delegate.contract.getSdkEventsFromEventManager(ctx.EventManager()))
 	// withdraw rewards
-	if _, err := e.withdrawRewards.withdrawRewards(ctx, caller.Address().Bytes()); err != nil {
+	if _, err := e.withdrawRewards.withdrawRewards(env, caller.Address().Bytes()); err != nil {
 		return nil, err
 	}
 
@@ -1392,6 +1913,12 @@ func (e stakingCustomPrecompiledContractRwTransfer) Execute(caller corevm.Contra
 		- Case 3: If delegated into many validators, the lowest power validator will receive delegation.
 	*/
 	delegations, err := sk.GetAllDelegatorDelegations(ctx, from.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if err := env.consumeIterationGas(len(delegations)); err != nil {
+		return nil, err
+	}
 	var delegatedBondedValidators []stakingtypes.ValidatorI
 	for _, delegation := range delegations {
 		valAddr, err := valAddrCodec.StringToBytes(delegation.ValidatorAddress)
@@ -1432,6 +1959,9 @@ func (e stakingCustomPrecompiledContractRwTransfer) Execute(caller corevm.Contra
 		if err != nil {
 			return nil, err
 		}
+		if err := env.consumeIterationGas(len(bondedValidators)); err != nil {
+			return nil, err
+		}
 
 		if len(bondedValidators) > 0 {
 			slices.SortFunc(bondedValidators, validatorSortFunc)
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"time"
 
 	"github.com/EscanBE/everlast/integration_test_util"
 	"github.com/EscanBE/everlast/x/cpc/abi"
@@ -16,6 +18,7 @@ import (
 	itutiltypes "github.com/EscanBE/everlast/integration_test_util/types"
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	evmtypes "github.com/EscanBE/everlast/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -25,6 +28,8 @@ const (
 	topic0Delegate   = "0x510b11bb3f3c799b11307c01ab7db0d335683ef5b2da98f7697de744f465eacc"
 	topic0Undelegate = "0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827"
 	topic0Withdraw   = "0xad71f93891cecc86a28a627d5495c28fabbd31cdd2e93851b16ce3421fdab2e5"
+
+	topic0CancelUnbonding = "0xee099b9add525aa7957ce7002da91ca20f755cfaf8f5c178b028b06a0b84b951"
 )
 
 func (suite *CpcTestSuite) TestKeeper_DeployStakingCustomPrecompiledContract() {
@@ -1357,6 +1362,7 @@ func (suite *CpcTestSuite) TestKeeper_Topic0() {
 	suite.Equal(common.HexToHash(topic0Delegate), abi.StakingCpcInfo.ABI.Events["Delegate"].ID)
 	suite.Equal(common.HexToHash(topic0Undelegate), abi.StakingCpcInfo.ABI.Events["Undelegate"].ID)
 	suite.Equal(common.HexToHash(topic0Withdraw), abi.StakingCpcInfo.ABI.Events["WithdrawReward"].ID)
+	suite.Equal(common.HexToHash(topic0CancelUnbonding), abi.StakingCpcInfo.ABI.Events["CancelUnbonding"].ID)
 }
 
 func (suite *CpcTestSuite) TestKeeper_StakingCustomPrecompiledContract_transfer() {
@@ -1667,3 +1673,293 @@ func (suite *CpcTestSuite) requireEventsWithdrawReward(bzMarshalledReceipt []byt
 
 	suite.Require().Equalf(wantCount, gotWithdrawRewardCount, "expect %d WithdrawReward events but got %d", wantCount, gotWithdrawRewardCount)
 }
+
+func (suite *CpcTestSuite) TestKeeper_StakingCustomPrecompiledContract_views() {
+	suite.SetupStakingCPC()
+
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	validator1 := suite.CITS.ValidatorAccounts.Number(1)
+	validator2 := suite.CITS.WalletAccounts.Number(2)
+
+	ctx := suite.Ctx()
+	sk := suite.App().StakingKeeper()
+
+	suite.createValidator(ctx, validator2, sdkmath.NewInt(1))
+
+	val1, err := sk.GetValidator(ctx, validator1.GetValidatorAddress())
+	suite.Require().NoError(err)
+	_, err = sk.Delegate(ctx, account1.GetCosmosAddress(), sdkmath.NewInt(3e9), stakingtypes.Unbonded, val1, true)
+	suite.Require().NoError(err)
+
+	undelegateCompletionTime, _, err := sk.Undelegate(ctx, account1.GetCosmosAddress(), validator1.GetValidatorAddress(), sdkmath.LegacyNewDec(1e9))
+	suite.Require().NoError(err)
+
+	// validators are not bonded in test context so redelegation completes immediately, insert the entry directly
+	redelegateCompletionTime := ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(sk.SetRedelegation(ctx, stakingtypes.NewRedelegation(
+		account1.GetCosmosAddress(), validator1.GetValidatorAddress(), validator2.GetValidatorAddress(),
+		ctx.BlockHeight(), redelegateCompletionTime, sdkmath.NewInt(1e9), sdkmath.LegacyNewDec(1e9), 1,
+		sk.ValidatorAddressCodec(), suite.App().AccountKeeper().AddressCodec(),
+	)))
+
+	callContract := func(methodName string, args ...any) []any {
+		input, err := abi.StakingCpcInfo.ABI.Pack(methodName, args...)
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(ctx, nil, cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		ops, err := abi.StakingCpcInfo.ABI.Methods[methodName].Outputs.Unpack(res.Ret)
+		suite.Require().NoError(err)
+		return ops
+	}
+
+	decodeTuple := func(op any, dst any) {
+		bz, err := json.Marshal(op)
+		suite.Require().NoError(err)
+		suite.Require().NoError(json.Unmarshal(bz, dst))
+	}
+
+	suite.Run("unbondingDelegationsOf(address)", func() {
+		ops := callContract("unbondingDelegationsOf", account1.GetEthAddress())
+
+		var entries []abi.StakingUnbondingDelegationEntry
+		decodeTuple(ops[0], &entries)
+		suite.Require().Len(entries, 1)
+		suite.Equal(validator1.GetEthAddress(), entries[0].Validator)
+		suite.Equal(ctx.BlockHeight(), entries[0].CreationHeight)
+		suite.Equal(uint64(undelegateCompletionTime.Unix()), entries[0].CompletionTime)
+		suite.Equal(int64(1e9), entries[0].InitialBalance.Int64())
+		suite.Equal(int64(1e9), entries[0].Balance.Int64())
+
+		ops = callContract("unbondingDelegationsOf", validator2.GetEthAddress())
+		decodeTuple(ops[0], &entries)
+		suite.Empty(entries)
+	})
+
+	suite.Run("redelegationsOf(address)", func() {
+		ops := callContract("redelegationsOf", account1.GetEthAddress())
+
+		var entries []abi.StakingRedelegationEntry
+		decodeTuple(ops[0], &entries)
+		suite.Require().Len(entries, 1)
+		suite.Equal(validator1.GetEthAddress(), entries[0].SrcValidator)
+		suite.Equal(validator2.GetEthAddress(), entries[0].DstValidator)
+		suite.Equal(ctx.BlockHeight(), entries[0].CreationHeight)
+		suite.Equal(uint64(redelegateCompletionTime.Unix()), entries[0].CompletionTime)
+		suite.Equal(int64(1e9), entries[0].InitialBalance.Int64())
+		suite.Equal(sdkmath.LegacyNewDec(1e9).BigInt(), entries[0].SharesDst)
+	})
+
+	suite.Run("validatorInfo(address)", func() {
+		ops := callContract("validatorInfo", validator2.GetEthAddress())
+
+		var info abi.StakingValidatorInfo
+		decodeTuple(ops[0], &info)
+
+		val2, err := sk.GetValidator(ctx, validator2.GetValidatorAddress())
+		suite.Require().NoError(err)
+		suite.Equal(validator2.GetEthAddress(), info.Validator)
+		suite.Equal(val2.GetMoniker(), info.Moniker)
+		suite.Equal(uint8(val2.GetStatus()), info.Status)
+		suite.Equal(val2.IsJailed(), info.Jailed)
+		suite.Equal(val2.Tokens.BigInt(), info.Tokens)
+		suite.Equal(val2.DelegatorShares.BigInt(), info.DelegatorShares)
+		suite.Equal(sdkmath.LegacyNewDecWithPrec(5, 1).BigInt(), info.CommissionRate)
+		suite.Equal(sdkmath.LegacyNewDecWithPrec(5, 1).BigInt(), info.CommissionMaxRate)
+		suite.Equal(big.NewInt(0), info.CommissionMaxChangeRate)
+		suite.Equal(big.NewInt(1), info.MinSelfDelegation)
+	})
+
+	suite.Run("validatorInfo(address) of non-existing validator", func() {
+		input := simpleBuildContractInput(get4BytesSignature("validatorInfo(address)"), account1.GetEthAddress())
+		res, err := suite.EthCallApply(ctx, nil, cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.NotEmpty(res.VmError)
+	})
+
+	suite.Run("validators(uint256,uint256)", func() {
+		allValidators, err := sk.GetAllValidators(ctx)
+		suite.Require().NoError(err)
+		suite.Require().Greater(len(allValidators), 1)
+
+		var collected []abi.StakingValidatorInfo
+		for offset := 0; offset < len(allValidators); offset++ {
+			ops := callContract("validators", big.NewInt(int64(offset)), big.NewInt(1))
+			suite.Require().Len(ops, 2)
+
+			var page []abi.StakingValidatorInfo
+			decodeTuple(ops[0], &page)
+			suite.Require().Len(page, 1)
+			suite.Equal(int64(len(allValidators)), ops[1].(*big.Int).Int64())
+			collected = append(collected, page...)
+		}
+
+		for i, validator := range allValidators {
+			valAddr, err := sk.ValidatorAddressCodec().StringToBytes(validator.OperatorAddress)
+			suite.Require().NoError(err)
+			suite.Equal(common.BytesToAddress(valAddr), collected[i].Validator)
+		}
+
+		ops := callContract("validators", big.NewInt(int64(len(allValidators))), big.NewInt(math.MaxInt64))
+		suite.Empty(ops[0])
+		suite.Equal(int64(len(allValidators)), ops[1].(*big.Int).Int64())
+	})
+
+	suite.Run("validators(uint256,uint256) with zero limit", func() {
+		input, err := abi.StakingCpcInfo.ABI.Pack("validators", big.NewInt(0), big.NewInt(0))
+		suite.Require().NoError(err)
+		res, err := suite.EthCallApply(ctx, nil, cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "limit must be positive")
+	})
+
+	suite.Run("bondedRatio()", func() {
+		ops := callContract("bondedRatio")
+
+		bondedRatio, err := sk.BondedRatio(ctx)
+		suite.Require().NoError(err)
+		suite.Require().True(bondedRatio.IsPositive())
+		suite.Equal(bondedRatio.BigInt(), ops[0].(*big.Int))
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_StakingCustomPrecompiledContract_cancelUnbondingDelegation() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	validator1 := suite.CITS.ValidatorAccounts.Number(1)
+
+	const (
+		delegateAmount   = 3e9
+		undelegateAmount = 2e9
+		cancelAmount     = 5e8
+	)
+
+	setup := func() (creationHeight int64) {
+		suite.SetupTest()
+		suite.SetupStakingCPC()
+
+		ctx := suite.Ctx()
+		sk := suite.App().StakingKeeper()
+
+		val1, err := sk.GetValidator(ctx, validator1.GetValidatorAddress())
+		suite.Require().NoError(err)
+		_, err = sk.Delegate(ctx, account1.GetCosmosAddress(), sdkmath.NewInt(delegateAmount), stakingtypes.Unbonded, val1, true)
+		suite.Require().NoError(err)
+
+		_, _, err = sk.Undelegate(ctx, account1.GetCosmosAddress(), validator1.GetValidatorAddress(), sdkmath.LegacyNewDec(undelegateAmount))
+		suite.Require().NoError(err)
+
+		return ctx.BlockHeight()
+	}
+
+	requireCancelled := func(res *evmtypes.MsgEthereumTxResponse, creationHeight int64) {
+		suite.Require().Empty(res.VmError)
+
+		gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+		suite.Require().NoError(err)
+		suite.Require().True(gotSuccess)
+
+		receipt := &ethtypes.Receipt{}
+		suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+		suite.Require().Len(receipt.Logs, 1)
+		log := receipt.Logs[0]
+		suite.Equal(topic0CancelUnbonding, log.Topics[0].String())
+		suite.Require().Len(log.Topics, 3)
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(log.Topics[1].Bytes()))
+		suite.Equal(validator1.GetEthAddress(), common.BytesToAddress(log.Topics[2].Bytes()))
+		ops, err := abi.StakingCpcInfo.ABI.Events["CancelUnbonding"].Inputs.NonIndexed().Unpack(log.Data)
+		suite.Require().NoError(err)
+		suite.Require().Len(ops, 2)
+		suite.Equal(big.NewInt(cancelAmount).String(), ops[0].(*big.Int).String())
+		suite.Equal(creationHeight, ops[1].(int64))
+
+		delegation, err := suite.App().StakingKeeper().GetDelegation(suite.Ctx(), account1.GetCosmosAddress(), validator1.GetValidatorAddress())
+		suite.Require().NoError(err)
+		suite.Equal(sdkmath.LegacyNewDec(delegateAmount-undelegateAmount+cancelAmount).String(), delegation.Shares.String())
+
+		ubd, err := suite.App().StakingKeeper().GetUnbondingDelegation(suite.Ctx(), account1.GetCosmosAddress(), validator1.GetValidatorAddress())
+		suite.Require().NoError(err)
+		suite.Require().Len(ubd.Entries, 1)
+		suite.Equal(int64(undelegateAmount-cancelAmount), ubd.Entries[0].Balance.Int64())
+	}
+
+	suite.Run("pass - cancel unbonding delegation", func() {
+		creationHeight := setup()
+
+		input := simpleBuildContractInput(get4BytesSignature("cancelUnbondingDelegation(address,uint256,int64)"), validator1.GetEthAddress(), big.NewInt(cancelAmount), big.NewInt(creationHeight))
+		res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		requireCancelled(res, creationHeight)
+	})
+
+	suite.Run("pass - cancel unbonding delegation using EIP-712", func() {
+		creationHeight := setup()
+
+		msg := abi.CancelUnbondingMessage{
+			Delegator:      account1.GetEthAddress(),
+			Validator:      validator1.GetValidatorAddress().String(),
+			Amount:         big.NewInt(cancelAmount),
+			Denom:          suite.bondDenom(suite.Ctx()),
+			CreationHeight: creationHeight,
+		}
+
+		r, s, v := suite.hashEip712Message(msg, account1)
+		input, err := abi.StakingCpcInfo.ABI.Methods["cancelUnbondingDelegationByMessage"].Inputs.Pack(msg, r, s, v)
+		suite.Require().NoError(err)
+		input = append(get4BytesSignature("cancelUnbondingDelegationByMessage((address,string,uint256,string,int64),bytes32,bytes32,uint8)"), input...)
+
+		res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		requireCancelled(res, creationHeight)
+	})
+
+	suite.Run("fail - EIP-712 signature does not cover the creation height", func() {
+		creationHeight := setup()
+
+		msg := abi.CancelUnbondingMessage{
+			Delegator:      account1.GetEthAddress(),
+			Validator:      validator1.GetValidatorAddress().String(),
+			Amount:         big.NewInt(cancelAmount),
+			Denom:          suite.bondDenom(suite.Ctx()),
+			CreationHeight: creationHeight + 1,
+		}
+
+		r, s, v := suite.hashEip712Message(msg, account1)
+		msg.CreationHeight = creationHeight
+		input, err := abi.StakingCpcInfo.ABI.Methods["cancelUnbondingDelegationByMessage"].Inputs.Pack(msg, r, s, v)
+		suite.Require().NoError(err)
+		input = append(get4BytesSignature("cancelUnbondingDelegationByMessage((address,string,uint256,string,int64),bytes32,bytes32,uint8)"), input...)
+
+		res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "signature does not match")
+	})
+
+	suite.Run("fail - unbonding delegation entry not found at creation height", func() {
+		creationHeight := setup()
+
+		input := simpleBuildContractInput(get4BytesSignature("cancelUnbondingDelegation(address,uint256,int64)"), validator1.GetEthAddress(), big.NewInt(cancelAmount), big.NewInt(creationHeight+1))
+		res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "unbonding delegation entry is not found")
+	})
+
+	suite.Run("fail - amount greater than the unbonding delegation entry balance", func() {
+		creationHeight := setup()
+
+		input := simpleBuildContractInput(get4BytesSignature("cancelUnbondingDelegation(address,uint256,int64)"), validator1.GetEthAddress(), big.NewInt(undelegateAmount+1), big.NewInt(creationHeight))
+		res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "amount is greater than the unbonding delegation entry balance")
+	})
+
+	suite.Run("fail - zero amount", func() {
+		creationHeight := setup()
+
+		input := simpleBuildContractInput(get4BytesSignature("cancelUnbondingDelegation(address,uint256,int64)"), validator1.GetEthAddress(), big.NewInt(0), big.NewInt(creationHeight))
+		res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcStakingFixedAddress, input)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "cancel unbonding amount must be positive")
+	})
+}
@@ -8,7 +8,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/EscanBE/everlast/constants"
+	cpckeeper "github.com/EscanBE/everlast/x/cpc/keeper"
 	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -91,9 +95,8 @@ func (suite *CpcTestSuite) TestKeeper_GetSetHasCustomPrecompiledContractMeta() {
 		copied := meta
 		copied.CustomPrecompiledType = cpctypes.CpcTypeStaking
 
-		suite.Require().Panics(func() {
-			_ = suite.App().CpcKeeper().SetCustomPrecompiledContractMeta(suite.Ctx(), copied, false)
-		})
+		err := suite.App().CpcKeeper().SetCustomPrecompiledContractMeta(suite.Ctx(), copied, false)
+		suite.Require().ErrorContains(err, "not allowed to change type of the precompiled contract")
 	})
 
 	suite.Run("fail - (set) when NOT new deployment, reject if contract address does not exists", func() {
@@ -110,6 +113,120 @@ func (suite *CpcTestSuite) TestKeeper_GetSetHasCustomPrecompiledContractMeta() {
 	})
 }
 
+func (suite *CpcTestSuite) TestKeeper_UpdateCustomPrecompiledContractMeta() {
+	erc20Meta := cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   constants.DisplayDenom,
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: constants.BaseDenom,
+	}
+
+	contractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), constants.DisplayDenom, erc20Meta)
+	suite.Require().NoError(err)
+
+	getMeta := func() cpctypes.CustomPrecompiledContractMeta {
+		got := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), contractAddr)
+		suite.Require().NotNil(got)
+		return *got
+	}
+
+	suite.Run("pass - keep name and typed meta when empty, update disabled flag", func() {
+		before := getMeta()
+
+		err := suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), contractAddr, "", "", true)
+		suite.Require().NoError(err)
+
+		after := getMeta()
+		suite.Equal(before.Name, after.Name)
+		suite.Equal(before.TypedMeta, after.TypedMeta)
+		suite.True(after.Disabled)
+
+		err = suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), contractAddr, "", "", false)
+		suite.Require().NoError(err)
+		suite.False(getMeta().Disabled)
+	})
+
+	suite.Run("pass - update name and typed meta", func() {
+		newErc20Meta := erc20Meta
+		newErc20Meta.Symbol = "NEW"
+
+		bz, err := json.Marshal(newErc20Meta)
+		suite.Require().NoError(err)
+
+		err = suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), contractAddr, "new name", string(bz), false)
+		suite.Require().NoError(err)
+
+		after := getMeta()
+		suite.Equal("new name", after.Name)
+		suite.JSONEq(string(bz), after.TypedMeta)
+	})
+
+	suite.Run("fail - reject changing min denom of ERC20 contract", func() {
+		newErc20Meta := erc20Meta
+		newErc20Meta.MinDenom = "uatom"
+
+		bz, err := json.Marshal(newErc20Meta)
+		suite.Require().NoError(err)
+
+		err = suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), contractAddr, "", string(bz), false)
+		suite.Require().ErrorContains(err, "not allowed to change min denom of the ERC20 contract")
+	})
+
+	suite.Run("fail - reject invalid typed meta", func() {
+		err := suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), contractAddr, "", `{"symbol":"","decimals":18,"min_denom":"`+constants.BaseDenom+`"}`, false)
+		suite.Require().ErrorContains(err, "symbol cannot be empty")
+	})
+
+	suite.Run("fail - reject non-existing contract", func() {
+		err := suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), common.BytesToAddress([]byte("non-exists")), "", "", true)
+		suite.Require().ErrorContains(err, "contract does not exist by address")
+	})
+}
+
+func (suite *CpcTestSuite) TestMsgServer_UpdateCustomPrecompiledContract() {
+	msgServer := cpckeeper.NewMsgServerImpl(*suite.App().CpcKeeper())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	from := suite.CITS.WalletAccounts.Number(1).GetEthAddress()
+
+	callBech32 := func() (vmErr string) {
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcBech32FixedAddress, get4BytesSignature("bech32AccountAddrPrefix()"))
+		suite.Require().NoError(err)
+		return res.VmError
+	}
+
+	suite.Run("fail - reject non-authority", func() {
+		_, err := msgServer.UpdateCustomPrecompiledContract(suite.Ctx(), &cpctypes.MsgUpdateCustomPrecompiledContract{
+			Authority:       suite.CITS.WalletAccounts.Number(1).GetCosmosAddress().String(),
+			ContractAddress: cpctypes.CpcBech32FixedAddress.Hex(),
+			Disabled:        true,
+		})
+		suite.Require().ErrorContains(err, "invalid authority")
+	})
+
+	suite.Run("pass - disabled contract can not be called", func() {
+		suite.Require().Empty(callBech32())
+
+		_, err := msgServer.UpdateCustomPrecompiledContract(suite.Ctx(), &cpctypes.MsgUpdateCustomPrecompiledContract{
+			Authority:       authority,
+			ContractAddress: cpctypes.CpcBech32FixedAddress.Hex(),
+			Disabled:        true,
+		})
+		suite.Require().NoError(err)
+
+		suite.Contains(callBech32(), "the precompile contract is disabled")
+	})
+
+	suite.Run("pass - re-enabled contract can be called", func() {
+		_, err := msgServer.UpdateCustomPrecompiledContract(suite.Ctx(), &cpctypes.MsgUpdateCustomPrecompiledContract{
+			Authority:       authority,
+			ContractAddress: cpctypes.CpcBech32FixedAddress.Hex(),
+			Disabled:        false,
+		})
+		suite.Require().NoError(err)
+
+		suite.Empty(callBech32())
+	})
+}
+
 func (suite *CpcTestSuite) TestKeeper_GetAllCustomPrecompiledContracts() {
 	genesisDeployedContractAddrs := suite.getGenesisDeployedCPCs(suite.Ctx())
 
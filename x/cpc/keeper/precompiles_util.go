@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -42,3 +44,19 @@ func findEvents(em sdk.EventManagerI, filter func(sdk.Event) *normalizedEvent) [
 	}
 	return events
 }
+
+// mulGas returns the product of the given gas values, capped at max uint64 instead of overflow.
+func mulGas(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+	return a * b
+}
+
+// subGas returns the difference of the given gas values, floored at zero instead of underflow.
+func subGas(a, b uint64) uint64 {
+	if a < b {
+		return 0
+	}
+	return a - b
+}
@@ -0,0 +1,337 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/EscanBE/everlast/x/cpc/abi"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployVestingCustomPrecompiledContract deploys a new vesting custom precompiled contract.
+func (k Keeper) DeployVestingCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcVestingFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeVesting,
+		Name:                  "Vesting - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &vestingCustomPrecompiledContract{}
+
+// vestingCustomPrecompiledContract allows EVM accounts to create vesting accounts, funded with staking coin.
+// Same as the Cosmos-lane ante handler, the vesting account must have proven to be an external owned account (EOA) via `x/vauth`.
+type vestingCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewVestingCustomPrecompiledContract creates a new vesting custom precompiled contract.
+func NewVestingCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &vestingCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&vestingCustomPrecompiledContractRoName{contract: contract},
+		&vestingCustomPrecompiledContractRoVestingInfo{contract: contract},
+		&vestingCustomPrecompiledContractRwCreateVestingAccount{contract: contract},
+		&vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount{contract: contract},
+	}
+
+	return contract
+}
+
+func (m vestingCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m vestingCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+// requireProofExternalOwnedAccount returns error if the account has not proven to be an external owned account (EOA) via `x/vauth`.
+func (m vestingCustomPrecompiledContract) requireProofExternalOwnedAccount(ctx sdk.Context, account common.Address) error {
+	if m.keeper.vAuthKeeper.HasProofExternalOwnedAccount(ctx, account.Bytes()) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		sdkerrors.ErrUnauthorized,
+		"must prove account is external owned account (EOA) via `x/vauth` module before able to create vesting account: %s", sdk.AccAddress(account.Bytes()),
+	)
+}
+
+func (m vestingCustomPrecompiledContract) emitsEventCreateVestingAccount(funder, account common.Address, amount *big.Int, env cpcExecutorEnv) {
+	env.evm.StateDB.AddLog(&ethtypes.Log{
+		Address: cpctypes.CpcVestingFixedAddress,
+		Topics: []common.Hash{
+			common.HexToHash("0x9e2cc999e4e2a0aefcd91f9551a96790fc2541d5860dd95fe322f4036c296843"), // CreateVestingAccount(address,address,uint256)
+			common.BytesToHash(funder.Bytes()),
+			common.BytesToHash(account.Bytes()),
+		},
+		Data: common.BytesToHash(amount.Bytes()).Bytes(),
+	})
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRoName{}
+
+type vestingCustomPrecompiledContractRoName struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.VestingCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.VestingCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e vestingCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e vestingCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e vestingCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// vestingInfo(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRoVestingInfo{}
+
+type vestingCustomPrecompiledContractRoVestingInfo struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRoVestingInfo) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("vestingInfo", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	account := ips[0].(common.Address)
+
+	locked := big.NewInt(0)
+	vested := big.NewInt(0)
+	delegatedVesting := big.NewInt(0)
+
+	if vestingAccount, ok := e.contract.keeper.accountKeeper.GetAccount(ctx, account.Bytes()).(vestingexported.VestingAccount); ok {
+		bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		locked = vestingAccount.LockedCoins(ctx.BlockTime()).AmountOf(bondDenom).BigInt()
+		vested = vestingAccount.GetVestedCoins(ctx.BlockTime()).AmountOf(bondDenom).BigInt()
+		delegatedVesting = vestingAccount.GetDelegatedVesting().AmountOf(bondDenom).BigInt()
+	}
+
+	return abi.VestingCpcInfo.PackMethodOutput("vestingInfo", locked, vested, delegatedVesting)
+}
+
+func (e vestingCustomPrecompiledContractRoVestingInfo) Method4BytesSignatures() []byte {
+	return []byte{0xf7, 0x8e, 0x63, 0x3d}
+}
+
+func (e vestingCustomPrecompiledContractRoVestingInfo) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e vestingCustomPrecompiledContractRoVestingInfo) ReadOnly() bool {
+	return true
+}
+
+// createVestingAccount(address,uint256,uint64,bool)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRwCreateVestingAccount{}
+
+type vestingCustomPrecompiledContractRwCreateVestingAccount struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("createVestingAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	account := ips[0].(common.Address)
+	amount := ips[1].(*big.Int)
+	endTime := ips[2].(uint64)
+	delayed := ips[3].(bool)
+
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "vesting amount must be positive")
+	}
+	if endTime > math.MaxInt64 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "end time is too large")
+	}
+
+	if err := e.contract.requireProofExternalOwnedAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgCreateVestingAccount := vestingtypes.NewMsgCreateVestingAccount(
+		caller.Address().Bytes(), // from
+		account.Bytes(),          // to
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount))), // amount
+		int64(endTime), // end time
+		delayed,        // delayed
+	)
+	if _, err := vesting.NewMsgServerImpl(e.contract.keeper.accountKeeper, e.contract.keeper.bankKeeper).CreateVestingAccount(ctx, msgCreateVestingAccount); err != nil {
+		return nil, err
+	}
+
+	e.contract.emitsEventCreateVestingAccount(caller.Address(), account, amount, env)
+
+	return abi.VestingCpcInfo.PackMethodOutput("createVestingAccount", true)
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) Method4BytesSignatures() []byte {
+	return []byte{0xd5, 0x68, 0x0e, 0x5b}
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) ReadOnly() bool {
+	return false
+}
+
+// createPeriodicVestingAccount(address,uint64,VestingPeriod[])
+// sig delivered from: createPeriodicVestingAccount(address,uint64,(uint64,uint256)[])
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount{}
+
+type vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("createPeriodicVestingAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	account := ips[0].(common.Address)
+	startTime := ips[1].(uint64)
+	periods, err := abi.VestingPeriodsFromUnpacked(ips[2])
+	if err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "failed to parse vesting periods: %s", err.Error())
+	}
+
+	if startTime > math.MaxInt64 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "start time is too large")
+	}
+	if len(periods) < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "vesting periods cannot be empty")
+	}
+
+	if err := env.consumeIterationGas(len(periods)); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.requireProofExternalOwnedAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalAmount := new(big.Int)
+	vestingPeriods := make([]vestingtypes.Period, len(periods))
+	for i, period := range periods {
+		if period.Length > math.MaxInt64 {
+			return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "period %d: length is too large", i)
+		}
+		if period.Amount == nil || period.Amount.Sign() < 1 {
+			return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "period %d: amount must be positive", i)
+		}
+
+		totalAmount.Add(totalAmount, period.Amount)
+		vestingPeriods[i] = vestingtypes.Period{
+			Length: int64(period.Length),
+			Amount: sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(period.Amount))),
+		}
+	}
+
+	msgCreatePeriodicVestingAccount := vestingtypes.NewMsgCreatePeriodicVestingAccount(
+		caller.Address().Bytes(), // from
+		account.Bytes(),          // to
+		int64(startTime),         // start time
+		vestingPeriods,           // periods
+	)
+	if _, err := vesting.NewMsgServerImpl(e.contract.keeper.accountKeeper, e.contract.keeper.bankKeeper).CreatePeriodicVestingAccount(ctx, msgCreatePeriodicVestingAccount); err != nil {
+		return nil, err
+	}
+
+	e.contract.emitsEventCreateVestingAccount(caller.Address(), account, totalAmount, env)
+
+	return abi.VestingCpcInfo.PackMethodOutput("createPeriodicVestingAccount", true)
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) Method4BytesSignatures() []byte {
+	return []byte{0xc0, 0x67, 0x93, 0x64}
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) RequireGas() uint64 {
+	return 300_000
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) ReadOnly() bool {
+	return false
+}
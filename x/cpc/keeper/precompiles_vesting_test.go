@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/everlast/integration_test_util"
+	itutiltypes "github.com/EscanBE/everlast/integration_test_util/types"
+	"github.com/EscanBE/everlast/x/cpc/abi"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	topic0CreateVestingAccount = "0x9e2cc999e4e2a0aefcd91f9551a96790fc2541d5860dd95fe322f4036c296843"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployVestingCustomPrecompiledContract() {
+	if suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcVestingFixedAddress) != nil {
+		suite.T().Skip("skipping test; contract already deployed successfully")
+	}
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployVestingCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcVestingFixedAddress, addr)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcVestingFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_VestingCustomPrecompiledContract_Topic0() {
+	suite.Equal(common.HexToHash(topic0CreateVestingAccount), abi.VestingCpcInfo.ABI.Events["CreateVestingAccount"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_VestingCustomPrecompiledContract() {
+	funder := suite.CITS.WalletAccounts.Number(1)
+
+	bondDenom := suite.bondDenom(suite.Ctx())
+	suite.CITS.MintCoin(funder, sdk.NewCoin(bondDenom, sdkmath.NewInt(1e18)))
+
+	saveProofExternalOwnedAccount := func(account *itutiltypes.TestAccount) {
+		privateKey, err := account.PrivateKey.ToECDSA()
+		suite.Require().NoError(err)
+		signature, err := crypto.Sign(crypto.Keccak256([]byte(vauthtypes.MessageToSign)), privateKey)
+		suite.Require().NoError(err)
+
+		err = suite.App().VAuthKeeper().SaveProofExternalOwnedAccount(suite.Ctx(), vauthtypes.ProofExternalOwnedAccount{
+			Account:   account.GetCosmosAddress().String(),
+			Hash:      "0x" + hex.EncodeToString(crypto.Keccak256([]byte(vauthtypes.MessageToSign))),
+			Signature: "0x" + hex.EncodeToString(signature),
+		})
+		suite.Require().NoError(err)
+	}
+
+	callContract := func(from common.Address, method string, args ...any) (ret []byte, logs []*ethtypes.Log, vmErr string) {
+		input, err := abi.VestingCpcInfo.ABI.Pack(method, args...)
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), &from, cpctypes.CpcVestingFixedAddress, input)
+		suite.Require().NoError(err)
+
+		receipt := &ethtypes.Receipt{}
+		suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+
+		return res.Ret, receipt.Logs, res.VmError
+	}
+
+	getVestingInfo := func(account common.Address) (locked, vested, delegatedVesting *big.Int) {
+		ret, _, vmErr := callContract(funder.GetEthAddress(), "vestingInfo", account)
+		suite.Require().Empty(vmErr)
+
+		outputs, err := abi.VestingCpcInfo.ABI.Methods["vestingInfo"].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(outputs, 3)
+
+		return outputs[0].(*big.Int), outputs[1].(*big.Int), outputs[2].(*big.Int)
+	}
+
+	suite.Run("fail - create vesting account without proof of EOA", func() {
+		account := integration_test_util.NewTestAccount(suite.T(), nil)
+
+		_, _, vmErr := callContract(
+			funder.GetEthAddress(), "createVestingAccount",
+			account.GetEthAddress(), big.NewInt(1e9), uint64(suite.Ctx().BlockTime().Unix()+3600), false,
+		)
+		suite.Require().Contains(vmErr, "must prove account is external owned account (EOA)")
+	})
+
+	suite.Run("pass - create continuous vesting account", func() {
+		account := integration_test_util.NewTestAccount(suite.T(), nil)
+		saveProofExternalOwnedAccount(account)
+
+		const amount = 1e9
+		ret, logs, vmErr := callContract(
+			funder.GetEthAddress(), "createVestingAccount",
+			account.GetEthAddress(), big.NewInt(amount), uint64(suite.Ctx().BlockTime().Unix()+3600), false,
+		)
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0CreateVestingAccount, logs[0].Topics[0].String())
+		suite.Equal(funder.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+		suite.Equal(account.GetEthAddress(), common.BytesToAddress(logs[0].Topics[2].Bytes()))
+		suite.Equal(int64(amount), new(big.Int).SetBytes(logs[0].Data).Int64())
+
+		acc := suite.App().AccountKeeper().GetAccount(suite.Ctx(), account.GetCosmosAddress())
+		_, ok := acc.(*vestingtypes.ContinuousVestingAccount)
+		suite.Require().True(ok)
+
+		locked, vested, delegatedVesting := getVestingInfo(account.GetEthAddress())
+		suite.Equal(int64(amount), locked.Int64())
+		suite.Zero(vested.Sign())
+		suite.Zero(delegatedVesting.Sign())
+
+		suite.Run("fail - can not create vesting account for existing account", func() {
+			_, _, vmErr := callContract(
+				funder.GetEthAddress(), "createVestingAccount",
+				account.GetEthAddress(), big.NewInt(amount), uint64(suite.Ctx().BlockTime().Unix()+3600), false,
+			)
+			suite.Require().Contains(vmErr, "already exists")
+		})
+	})
+
+	suite.Run("pass - create delayed vesting account", func() {
+		account := integration_test_util.NewTestAccount(suite.T(), nil)
+		saveProofExternalOwnedAccount(account)
+
+		_, _, vmErr := callContract(
+			funder.GetEthAddress(), "createVestingAccount",
+			account.GetEthAddress(), big.NewInt(1e9), uint64(suite.Ctx().BlockTime().Unix()+3600), true,
+		)
+		suite.Require().Empty(vmErr)
+
+		acc := suite.App().AccountKeeper().GetAccount(suite.Ctx(), account.GetCosmosAddress())
+		_, ok := acc.(*vestingtypes.DelayedVestingAccount)
+		suite.Require().True(ok)
+	})
+
+	suite.Run("fail - create vesting account with zero amount", func() {
+		account := integration_test_util.NewTestAccount(suite.T(), nil)
+		saveProofExternalOwnedAccount(account)
+
+		_, _, vmErr := callContract(
+			funder.GetEthAddress(), "createVestingAccount",
+			account.GetEthAddress(), big.NewInt(0), uint64(suite.Ctx().BlockTime().Unix()+3600), false,
+		)
+		suite.Require().Contains(vmErr, "vesting amount must be positive")
+	})
+
+	suite.Run("pass - create periodic vesting account", func() {
+		account := integration_test_util.NewTestAccount(suite.T(), nil)
+		saveProofExternalOwnedAccount(account)
+
+		periods := []abi.VestingPeriod{
+			{Length: 3600, Amount: big.NewInt(1e9)},
+			{Length: 3600, Amount: big.NewInt(2e9)},
+		}
+
+		// first period already passed
+		ret, logs, vmErr := callContract(
+			funder.GetEthAddress(), "createPeriodicVestingAccount",
+			account.GetEthAddress(), uint64(suite.Ctx().BlockTime().Unix()-3600), periods,
+		)
+		suite.Require().Empty(vmErr)
+		success, err := cpcutils.AbiDecodeBool(ret)
+		suite.Require().NoError(err)
+		suite.True(success)
+
+		suite.Require().Len(logs, 1)
+		suite.Equal(topic0CreateVestingAccount, logs[0].Topics[0].String())
+		suite.Equal(int64(3e9), new(big.Int).SetBytes(logs[0].Data).Int64())
+
+		acc := suite.App().AccountKeeper().GetAccount(suite.Ctx(), account.GetCosmosAddress())
+		vestingAccount, ok := acc.(vestingexported.VestingAccount)
+		suite.Require().True(ok)
+		suite.Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(3e9))).String(), vestingAccount.GetOriginalVesting().String())
+
+		locked, vested, delegatedVesting := getVestingInfo(account.GetEthAddress())
+		suite.Equal(int64(2e9), locked.Int64())
+		suite.Equal(int64(1e9), vested.Int64())
+		suite.Zero(delegatedVesting.Sign())
+	})
+
+	suite.Run("fail - create periodic vesting account with empty periods", func() {
+		account := integration_test_util.NewTestAccount(suite.T(), nil)
+		saveProofExternalOwnedAccount(account)
+
+		_, _, vmErr := callContract(
+			funder.GetEthAddress(), "createPeriodicVestingAccount",
+			account.GetEthAddress(), uint64(suite.Ctx().BlockTime().Unix()), []abi.VestingPeriod{},
+		)
+		suite.Require().Contains(vmErr, "vesting periods cannot be empty")
+	})
+
+	suite.Run("pass - create periodic vesting account charges gas per period", func() {
+		account := integration_test_util.NewTestAccount(suite.T(), nil)
+		saveProofExternalOwnedAccount(account)
+
+		periods := []abi.VestingPeriod{
+			{Length: 3600, Amount: big.NewInt(1e9)},
+			{Length: 3600, Amount: big.NewInt(2e9)},
+			{Length: 3600, Amount: big.NewInt(3e9)},
+		}
+		input, err := abi.VestingCpcInfo.ABI.Pack(
+			"createPeriodicVestingAccount",
+			account.GetEthAddress(), uint64(suite.Ctx().BlockTime().Unix()), periods,
+		)
+		suite.Require().NoError(err)
+
+		callWithGasPerIteration := func(gasPerIteration uint64) (gasUsed uint64, vmErr string, ctx sdk.Context) {
+			ctx, _ = suite.Ctx().CacheContext()
+
+			params := suite.App().CpcKeeper().GetParams(ctx)
+			params.GasSchedule = []cpctypes.CustomPrecompiledContractMethodGas{{
+				CustomPrecompiledType: cpctypes.CpcTypeVesting,
+				MethodSelector:        "0x" + hex.EncodeToString(abi.VestingCpcInfo.ABI.Methods["createPeriodicVestingAccount"].ID),
+				GasPerIteration:       gasPerIteration,
+			}}
+			suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+
+			from := funder.GetEthAddress()
+			res, err := suite.EthCallApply(ctx, &from, cpctypes.CpcVestingFixedAddress, input)
+			suite.Require().NoError(err)
+			return res.GasUsed, res.VmError, ctx
+		}
+
+		gasUsedWithoutIterationGas, vmErr, _ := callWithGasPerIteration(0)
+		suite.Require().Empty(vmErr)
+
+		gasUsed, vmErr, _ := callWithGasPerIteration(7_000)
+		suite.Require().Empty(vmErr)
+		suite.Equal(gasUsedWithoutIterationGas+7_000*uint64(len(periods)), gasUsed)
+
+		_, vmErr, ctx := callWithGasPerIteration(math.MaxUint64)
+		suite.Require().Contains(vmErr, "out of gas")
+		suite.Nil(suite.App().AccountKeeper().GetAccount(ctx, account.GetCosmosAddress()), "account must not be created")
+	})
+
+	suite.Run("pass - vesting info of non-vesting account is empty", func() {
+		locked, vested, delegatedVesting := getVestingInfo(funder.GetEthAddress())
+		suite.Zero(locked.Sign())
+		suite.Zero(vested.Sign())
+		suite.Zero(delegatedVesting.Sign())
+	})
+}
@@ -102,6 +102,11 @@ func (suite *CpcTestSuite) SetupStakingCPC() {
 }
 
 func (suite *CpcTestSuite) EthCallApply(ctx sdk.Context, from *common.Address, contractAddress common.Address, input []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	return suite.EthCallApplyWithGas(ctx, from, contractAddress, input, 0)
+}
+
+// EthCallApplyWithGas is the same as EthCallApply but with the given gas limit, zero means default.
+func (suite *CpcTestSuite) EthCallApplyWithGas(ctx sdk.Context, from *common.Address, contractAddress common.Address, input []byte, gas uint64) (*evmtypes.MsgEthereumTxResponse, error) {
 	baseFee := suite.App().EvmKeeper().GetBaseFee(ctx).BigInt()
 	args := evmtypes.TransactionArgs{
 		From:     from,
@@ -109,6 +114,9 @@ func (suite *CpcTestSuite) EthCallApply(ctx sdk.Context, from *common.Address, c
 		Data:     (*hexutil.Bytes)(&input),
 		GasPrice: (*hexutil.Big)(baseFee),
 	}
+	if gas > 0 {
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
 
 	msg, err := args.ToMessage(0, baseFee)
 	suite.Require().NoError(err)
@@ -217,6 +225,14 @@ func (suite *CpcTestSuite) hashEip712Message(msg eip712.TypedMessage, account *i
 func (suite *CpcTestSuite) getGenesisDeployedCPCs(ctx sdk.Context) []common.Address {
 	genesisDeployedContractAddrs := []common.Address{
 		cpctypes.CpcBech32FixedAddress,
+		cpctypes.CpcGovFixedAddress,
+		cpctypes.CpcDistributionFixedAddress,
+		cpctypes.CpcIbcTransferFixedAddress,
+		cpctypes.CpcMulticallFixedAddress,
+		cpctypes.CpcSlashingFixedAddress,
+		cpctypes.CpcAuthzFixedAddress,
+		cpctypes.CpcVestingFixedAddress,
+		cpctypes.CpcBankFixedAddress,
 	}
 
 	for _, genesisDeployedContractAddr := range genesisDeployedContractAddrs {
@@ -3,6 +3,7 @@ package cpc
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	cpccli "github.com/EscanBE/everlast/x/cpc/client/cli"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -112,6 +113,11 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	cpctypes.RegisterMsgServer(cfg.MsgServer(), cpckeeper.NewMsgServerImpl(am.keeper))
 	cpctypes.RegisterQueryServer(cfg.QueryServer(), cpckeeper.NewQueryServerImpl(am.keeper))
+
+	m := cpckeeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(cpctypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to migrate %s: %w", cpctypes.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
@@ -134,7 +140,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 func (am AppModule) IsOnePerModuleType() {
 }
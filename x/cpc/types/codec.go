@@ -11,6 +11,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "everlast/cpc/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgDeployErc20ContractRequest{}, "everlast/cpc/MsgDeployErc20ContractRequest", nil)
 	cdc.RegisterConcrete(&MsgDeployStakingContractRequest{}, "everlast/cpc/MsgDeployStakingContractRequest", nil)
+	cdc.RegisterConcrete(&MsgCreateManagedErc20ContractRequest{}, "everlast/cpc/MsgCreateManagedErc20ContractRequest", nil)
+	cdc.RegisterConcrete(&MsgUpdateCustomPrecompiledContract{}, "everlast/cpc/MsgUpdateCustomPrecompiledContract", nil)
 }
 
 // RegisterInterfaces registers implementations by its interface, for the module
@@ -20,6 +22,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgDeployErc20ContractRequest{},
 		&MsgDeployStakingContractRequest{},
+		&MsgCreateManagedErc20ContractRequest{},
+		&MsgUpdateCustomPrecompiledContract{},
 	)
 }
 
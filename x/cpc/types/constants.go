@@ -1,6 +1,11 @@
 package types
 
-import "encoding/hex"
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 const (
 	GasVerifyEIP712 = 200_000
@@ -11,6 +16,9 @@ const (
 // while precompiled contracts do not have code.
 var PseudoCodePrecompiled []byte
 
+// PseudoCodePrecompiledHash is the keccak256 hash of the PseudoCodePrecompiled.
+var PseudoCodePrecompiledHash common.Hash
+
 func init() {
 	var err error
 	PseudoCodePrecompiled, err = hex.DecodeString(
@@ -20,4 +28,5 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	PseudoCodePrecompiledHash = crypto.Keccak256Hash(PseudoCodePrecompiled)
 }
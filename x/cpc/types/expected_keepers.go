@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VAuthKeeper defines the expected x/vauth keeper interface
+type VAuthKeeper interface {
+	HasProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAddress) bool
+}
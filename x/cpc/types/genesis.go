@@ -1,5 +1,18 @@
 package types
 
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evertypes "github.com/EscanBE/everlast/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -16,5 +29,107 @@ func (m GenesisState) Validate() error {
 		return err
 	}
 
+	protocolVersion := ProtocolCpc(m.Params.ProtocolVersion)
+
+	uniqueContracts := make(map[common.Address]struct{})
+	uniqueErc20MinDenoms := make(map[string]struct{})
+	erc20Contracts := make(map[common.Address]struct{})
+	for _, contract := range m.DeployedContracts {
+		if !isSupportedCustomPrecompiledType(contract.CustomPrecompiledType) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported custom precompiled type %d of deployed contract: %s", contract.CustomPrecompiledType, common.BytesToAddress(contract.Address))
+		}
+		if err := contract.Validate(protocolVersion); err != nil {
+			return errorsmod.Wrapf(err, "invalid deployed contract: %s", common.BytesToAddress(contract.Address))
+		}
+
+		contractAddress := common.BytesToAddress(contract.Address)
+		if _, exists := uniqueContracts[contractAddress]; exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate deployed contract: %s", contractAddress)
+		}
+		uniqueContracts[contractAddress] = struct{}{}
+
+		if contract.CustomPrecompiledType == CpcTypeErc20 {
+			var erc20Meta Erc20CustomPrecompiledContractMeta
+			if err := json.Unmarshal([]byte(contract.TypedMeta), &erc20Meta); err != nil {
+				return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "invalid ERC20 metadata of deployed contract: %s", contractAddress)
+			}
+			if _, exists := uniqueErc20MinDenoms[erc20Meta.MinDenom]; exists {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate ERC20 contract for %s", erc20Meta.MinDenom)
+			}
+			uniqueErc20MinDenoms[erc20Meta.MinDenom] = struct{}{}
+			erc20Contracts[contractAddress] = struct{}{}
+		}
+	}
+
+	uniqueAllowances := make(map[string]struct{})
+	for _, allowance := range m.Erc20Allowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := erc20Contracts[common.HexToAddress(allowance.ContractAddress)]; !found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowance of non-deployed ERC20 contract: %s", allowance.ContractAddress)
+		}
+
+		key := strings.ToLower(allowance.ContractAddress + "/" + allowance.Owner + "/" + allowance.Spender)
+		if _, exists := uniqueAllowances[key]; exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate ERC20 allowance of owner %s for spender %s, contract %s", allowance.Owner, allowance.Spender, allowance.ContractAddress)
+		}
+		uniqueAllowances[key] = struct{}{}
+	}
+
+	uniquePermitNonces := make(map[string]struct{})
+	for _, permitNonce := range m.Erc20PermitNonces {
+		if err := permitNonce.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := erc20Contracts[common.HexToAddress(permitNonce.ContractAddress)]; !found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "permit nonce of non-deployed ERC20 contract: %s", permitNonce.ContractAddress)
+		}
+
+		key := strings.ToLower(permitNonce.ContractAddress + "/" + permitNonce.Owner)
+		if _, exists := uniquePermitNonces[key]; exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate permit nonce of owner %s for contract %s", permitNonce.Owner, permitNonce.ContractAddress)
+		}
+		uniquePermitNonces[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func (m GenesisErc20Allowance) Validate() error {
+	if err := evertypes.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid ERC20 allowance contract address")
+	}
+
+	if err := evertypes.ValidateNonZeroAddress(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid ERC20 allowance owner")
+	}
+
+	if err := evertypes.ValidateNonZeroAddress(m.Spender); err != nil {
+		return errorsmod.Wrap(err, "invalid ERC20 allowance spender")
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "ERC20 allowance amount must be positive, owner %s, spender %s, contract %s", m.Owner, m.Spender, m.ContractAddress)
+	}
+
+	return nil
+}
+
+func (m GenesisErc20PermitNonce) Validate() error {
+	if err := evertypes.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid permit nonce contract address")
+	}
+
+	if err := evertypes.ValidateNonZeroAddress(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid permit nonce owner")
+	}
+
+	if m.Nonce == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "permit nonce must be positive, owner %s, contract %s", m.Owner, m.ContractAddress)
+	}
+
 	return nil
 }
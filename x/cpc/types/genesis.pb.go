@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +33,12 @@ type GenesisState struct {
 	DeployErc20Native bool `protobuf:"varint,2,opt,name=deploy_erc20_native,json=deployErc20Native,proto3" json:"deploy_erc20_native,omitempty"`
 	// deploy_staking_contract defines if the module should deploy the staking contract.
 	DeployStakingContract bool `protobuf:"varint,3,opt,name=deploy_staking_contract,json=deployStakingContract,proto3" json:"deploy_staking_contract,omitempty"`
+	// deployed_contracts is the list of the deployed custom precompiled contracts, to be restored at the same addresses.
+	DeployedContracts []CustomPrecompiledContractMeta `protobuf:"bytes,4,rep,name=deployed_contracts,json=deployedContracts,proto3" json:"deployed_contracts"`
+	// erc20_allowances is the list of the allowances of the ERC20 custom precompiled contracts.
+	Erc20Allowances []GenesisErc20Allowance `protobuf:"bytes,5,rep,name=erc20_allowances,json=erc20Allowances,proto3" json:"erc20_allowances"`
+	// erc20_permit_nonces is the list of the EIP-2612 permit nonces of the ERC20 custom precompiled contracts.
+	Erc20PermitNonces []GenesisErc20PermitNonce `protobuf:"bytes,6,rep,name=erc20_permit_nonces,json=erc20PermitNonces,proto3" json:"erc20_permit_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,19 +95,179 @@ func (m *GenesisState) GetDeployStakingContract() bool {
 	return false
 }
 
+func (m *GenesisState) GetDeployedContracts() []CustomPrecompiledContractMeta {
+	if m != nil {
+		return m.DeployedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Allowances() []GenesisErc20Allowance {
+	if m != nil {
+		return m.Erc20Allowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20PermitNonces() []GenesisErc20PermitNonce {
+	if m != nil {
+		return m.Erc20PermitNonces
+	}
+	return nil
+}
+
+// GenesisErc20Allowance defines an allowance of the spender over the owner's tokens, for an ERC20 custom precompiled contract.
+type GenesisErc20Allowance struct {
+	// owner is the hex address of the token owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the hex address of the spender
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// amount is the allowance amount
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// contract_address is the hex address of the ERC20 custom precompiled contract
+	ContractAddress string `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *GenesisErc20Allowance) Reset()         { *m = GenesisErc20Allowance{} }
+func (m *GenesisErc20Allowance) String() string { return proto.CompactTextString(m) }
+func (*GenesisErc20Allowance) ProtoMessage()    {}
+func (*GenesisErc20Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eabce093aaa4a14, []int{1}
+}
+func (m *GenesisErc20Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisErc20Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisErc20Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisErc20Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisErc20Allowance.Merge(m, src)
+}
+func (m *GenesisErc20Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisErc20Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisErc20Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisErc20Allowance proto.InternalMessageInfo
+
+func (m *GenesisErc20Allowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *GenesisErc20Allowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *GenesisErc20Allowance) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// GenesisErc20PermitNonce defines the EIP-2612 permit nonce of an owner, for an ERC20 custom precompiled contract.
+type GenesisErc20PermitNonce struct {
+	// contract_address is the hex address of the ERC20 custom precompiled contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// owner is the hex address of the token owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// nonce is the current permit nonce of the owner
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *GenesisErc20PermitNonce) Reset()         { *m = GenesisErc20PermitNonce{} }
+func (m *GenesisErc20PermitNonce) String() string { return proto.CompactTextString(m) }
+func (*GenesisErc20PermitNonce) ProtoMessage()    {}
+func (*GenesisErc20PermitNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eabce093aaa4a14, []int{2}
+}
+func (m *GenesisErc20PermitNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisErc20PermitNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisErc20PermitNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisErc20PermitNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisErc20PermitNonce.Merge(m, src)
+}
+func (m *GenesisErc20PermitNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisErc20PermitNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisErc20PermitNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisErc20PermitNonce proto.InternalMessageInfo
+
+func (m *GenesisErc20PermitNonce) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *GenesisErc20PermitNonce) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *GenesisErc20PermitNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // Params defines the cpc module params
 type Params struct {
 	// protocol_version is the protocol version of the cpc module
 	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	// whitelisted_deployers is the address of the accounts permitted to deploy the Custom Precompiled Contracts
 	WhitelistedDeployers []string `protobuf:"bytes,2,rep,name=whitelisted_deployers,json=whitelistedDeployers,proto3" json:"whitelisted_deployers,omitempty"`
+	// auto_deploy_erc20_for_ibc_denoms defines if the module should automatically deploy the ERC20 contract
+	// for IBC voucher denoms, when the denom is received for the first time and has bank metadata.
+	AutoDeployErc20ForIbcDenoms bool `protobuf:"varint,3,opt,name=auto_deploy_erc20_for_ibc_denoms,json=autoDeployErc20ForIbcDenoms,proto3" json:"auto_deploy_erc20_for_ibc_denoms,omitempty"`
+	// gas_schedule overrides the gas cost of the Custom Precompiled Contract methods.
+	// Methods that are not listed here use the default gas cost.
+	GasSchedule []CustomPrecompiledContractMethodGas `protobuf:"bytes,4,rep,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+	// auto_deploy_erc20_ibc_denoms provides the decimals of the automatically deployed ERC20 contract of IBC voucher denoms.
+	// Denoms that are not listed here use the exponent of the display unit of the bank metadata, or zero if not found.
+	AutoDeployErc20IbcDenoms []AutoDeployErc20IbcDenom `protobuf:"bytes,5,rep,name=auto_deploy_erc20_ibc_denoms,json=autoDeployErc20IbcDenoms,proto3" json:"auto_deploy_erc20_ibc_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8eabce093aaa4a14, []int{1}
+	return fileDescriptor_8eabce093aaa4a14, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,36 +310,229 @@ func (m *Params) GetWhitelistedDeployers() []string {
 	return nil
 }
 
+func (m *Params) GetAutoDeployErc20ForIbcDenoms() bool {
+	if m != nil {
+		return m.AutoDeployErc20ForIbcDenoms
+	}
+	return false
+}
+
+func (m *Params) GetGasSchedule() []CustomPrecompiledContractMethodGas {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetAutoDeployErc20IbcDenoms() []AutoDeployErc20IbcDenom {
+	if m != nil {
+		return m.AutoDeployErc20IbcDenoms
+	}
+	return nil
+}
+
+// AutoDeployErc20IbcDenom defines the decimals of the automatically deployed ERC20 contract of an IBC voucher denom.
+type AutoDeployErc20IbcDenom struct {
+	// full_denom_path is the full path of the denom trace on this chain, e.g. transfer/channel-0/uatom
+	FullDenomPath string `protobuf:"bytes,1,opt,name=full_denom_path,json=fullDenomPath,proto3" json:"full_denom_path,omitempty"`
+	// decimals is the exponent of the display unit on the source chain, used as decimals of the ERC20 contract.
+	// The bank metadata of IBC vouchers does not carry it.
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *AutoDeployErc20IbcDenom) Reset()         { *m = AutoDeployErc20IbcDenom{} }
+func (m *AutoDeployErc20IbcDenom) String() string { return proto.CompactTextString(m) }
+func (*AutoDeployErc20IbcDenom) ProtoMessage()    {}
+func (*AutoDeployErc20IbcDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eabce093aaa4a14, []int{4}
+}
+func (m *AutoDeployErc20IbcDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoDeployErc20IbcDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoDeployErc20IbcDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoDeployErc20IbcDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoDeployErc20IbcDenom.Merge(m, src)
+}
+func (m *AutoDeployErc20IbcDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoDeployErc20IbcDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoDeployErc20IbcDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoDeployErc20IbcDenom proto.InternalMessageInfo
+
+func (m *AutoDeployErc20IbcDenom) GetFullDenomPath() string {
+	if m != nil {
+		return m.FullDenomPath
+	}
+	return ""
+}
+
+func (m *AutoDeployErc20IbcDenom) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// CustomPrecompiledContractMethodGas defines the gas cost of a method of a Custom Precompiled Contract type.
+type CustomPrecompiledContractMethodGas struct {
+	// custom_precompiled_type is the type of the Custom Precompiled Contract
+	CustomPrecompiledType uint32 `protobuf:"varint,1,opt,name=custom_precompiled_type,json=customPrecompiledType,proto3" json:"custom_precompiled_type,omitempty"`
+	// method_selector is the hex-encoded 4-bytes selector of the method, e.g. 0x70a08231
+	MethodSelector string `protobuf:"bytes,2,opt,name=method_selector,json=methodSelector,proto3" json:"method_selector,omitempty"`
+	// base_gas is the fixed gas cost of the method, when zero, the default gas cost of the method will be used.
+	BaseGas uint64 `protobuf:"varint,3,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	// gas_per_byte is the gas cost charged per byte of the call input.
+	GasPerByte uint64 `protobuf:"varint,4,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty"`
+	// gas_per_iteration is the gas cost charged per iteration,
+	// for the methods that loop over a dynamic number of records like delegatedValidators, rewardsOf and withdrawRewards.
+	GasPerIteration uint64 `protobuf:"varint,5,opt,name=gas_per_iteration,json=gasPerIteration,proto3" json:"gas_per_iteration,omitempty"`
+}
+
+func (m *CustomPrecompiledContractMethodGas) Reset()         { *m = CustomPrecompiledContractMethodGas{} }
+func (m *CustomPrecompiledContractMethodGas) String() string { return proto.CompactTextString(m) }
+func (*CustomPrecompiledContractMethodGas) ProtoMessage()    {}
+func (*CustomPrecompiledContractMethodGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eabce093aaa4a14, []int{5}
+}
+func (m *CustomPrecompiledContractMethodGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomPrecompiledContractMethodGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomPrecompiledContractMethodGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustomPrecompiledContractMethodGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomPrecompiledContractMethodGas.Merge(m, src)
+}
+func (m *CustomPrecompiledContractMethodGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomPrecompiledContractMethodGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomPrecompiledContractMethodGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomPrecompiledContractMethodGas proto.InternalMessageInfo
+
+func (m *CustomPrecompiledContractMethodGas) GetCustomPrecompiledType() uint32 {
+	if m != nil {
+		return m.CustomPrecompiledType
+	}
+	return 0
+}
+
+func (m *CustomPrecompiledContractMethodGas) GetMethodSelector() string {
+	if m != nil {
+		return m.MethodSelector
+	}
+	return ""
+}
+
+func (m *CustomPrecompiledContractMethodGas) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+func (m *CustomPrecompiledContractMethodGas) GetGasPerByte() uint64 {
+	if m != nil {
+		return m.GasPerByte
+	}
+	return 0
+}
+
+func (m *CustomPrecompiledContractMethodGas) GetGasPerIteration() uint64 {
+	if m != nil {
+		return m.GasPerIteration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "everlast.cpc.v1.GenesisState")
+	proto.RegisterType((*GenesisErc20Allowance)(nil), "everlast.cpc.v1.GenesisErc20Allowance")
+	proto.RegisterType((*GenesisErc20PermitNonce)(nil), "everlast.cpc.v1.GenesisErc20PermitNonce")
 	proto.RegisterType((*Params)(nil), "everlast.cpc.v1.Params")
+	proto.RegisterType((*AutoDeployErc20IbcDenom)(nil), "everlast.cpc.v1.AutoDeployErc20IbcDenom")
+	proto.RegisterType((*CustomPrecompiledContractMethodGas)(nil), "everlast.cpc.v1.CustomPrecompiledContractMethodGas")
 }
 
 func init() { proto.RegisterFile("everlast/cpc/v1/genesis.proto", fileDescriptor_8eabce093aaa4a14) }
 
 var fileDescriptor_8eabce093aaa4a14 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xd1, 0x41, 0x4f, 0xc2, 0x30,
-	0x14, 0x07, 0xf0, 0x15, 0x0c, 0xd1, 0xaa, 0x41, 0x27, 0x84, 0xc5, 0xc4, 0x49, 0xb8, 0x88, 0x97,
-	0x4e, 0x20, 0x7a, 0x17, 0x25, 0xde, 0x8c, 0x19, 0x89, 0x07, 0x2f, 0x4b, 0x29, 0x2f, 0xa3, 0x71,
-	0xac, 0x4b, 0x5b, 0xa7, 0x7c, 0x0b, 0xbf, 0x88, 0xdf, 0x83, 0x23, 0x47, 0x4f, 0xc6, 0xc0, 0x17,
-	0x31, 0xb4, 0xcc, 0x18, 0x6f, 0xcb, 0xfb, 0xfd, 0xdf, 0xdb, 0x6b, 0x1e, 0x3e, 0x81, 0x1c, 0x64,
-	0x42, 0x95, 0x0e, 0x58, 0xc6, 0x82, 0xbc, 0x13, 0xc4, 0x90, 0x82, 0xe2, 0x8a, 0x64, 0x52, 0x68,
-	0xe1, 0x56, 0x0b, 0x26, 0x2c, 0x63, 0x24, 0xef, 0x1c, 0xd7, 0x62, 0x11, 0x0b, 0x63, 0xc1, 0xfa,
-	0xcb, 0xc6, 0x5a, 0x1f, 0x08, 0xef, 0xdd, 0xd9, 0xc6, 0xa1, 0xa6, 0x1a, 0xdc, 0x4b, 0x5c, 0xc9,
-	0xa8, 0xa4, 0x53, 0xe5, 0xa1, 0x26, 0x6a, 0xef, 0x76, 0x1b, 0xe4, 0xdf, 0x20, 0xf2, 0x60, 0xb8,
-	0xbf, 0x35, 0xff, 0x3a, 0x75, 0xc2, 0x4d, 0xd8, 0x25, 0xf8, 0x68, 0x0c, 0x59, 0x22, 0x66, 0x11,
-	0x48, 0xd6, 0xbd, 0x88, 0x52, 0xaa, 0x79, 0x0e, 0x5e, 0xa9, 0x89, 0xda, 0xdb, 0xe1, 0xa1, 0xa5,
-	0xc1, 0x5a, 0xee, 0x0d, 0xb8, 0x57, 0xb8, 0xb1, 0xc9, 0x2b, 0x4d, 0x9f, 0x79, 0x1a, 0x47, 0x4c,
-	0xa4, 0x5a, 0x52, 0xa6, 0xbd, 0xb2, 0xe9, 0xa9, 0x5b, 0x1e, 0x5a, 0xbd, 0xd9, 0x60, 0x6b, 0x82,
-	0x2b, 0xf6, 0xff, 0xee, 0x39, 0x3e, 0x30, 0x4f, 0x60, 0x22, 0x89, 0x72, 0x90, 0x8a, 0x8b, 0xd4,
-	0xac, 0xbc, 0x1f, 0x56, 0x8b, 0xfa, 0xa3, 0x2d, 0xbb, 0x3d, 0x5c, 0x7f, 0x9d, 0x70, 0x0d, 0x09,
-	0x57, 0x1a, 0xc6, 0x91, 0x9d, 0x0c, 0x52, 0x79, 0xa5, 0x66, 0xb9, 0xbd, 0x13, 0xd6, 0xfe, 0xe0,
-	0x6d, 0x61, 0xfd, 0xeb, 0xf9, 0xd2, 0x47, 0x8b, 0xa5, 0x8f, 0xbe, 0x97, 0x3e, 0x7a, 0x5f, 0xf9,
-	0xce, 0x62, 0xe5, 0x3b, 0x9f, 0x2b, 0xdf, 0x79, 0x3a, 0x8b, 0xb9, 0x9e, 0xbc, 0x8c, 0x08, 0x13,
-	0xd3, 0x60, 0xa0, 0x18, 0x4d, 0xfb, 0x83, 0xe0, 0xf7, 0x18, 0x6f, 0xe6, 0x1c, 0x7a, 0x96, 0x81,
-	0x1a, 0x55, 0xcc, 0x22, 0xbd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xa8, 0x32, 0xf6, 0xab,
-	0x01, 0x00, 0x00,
+	// 797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x7f, 0x1a, 0x4f, 0x1a, 0x5c, 0x4f, 0x6d, 0x79, 0x09, 0xd4, 0x35, 0x3e, 0xb4,
+	0x86, 0xc3, 0x9a, 0x26, 0x82, 0xbb, 0xdd, 0x98, 0x2a, 0x07, 0x2a, 0x6b, 0x8d, 0x40, 0x42, 0xc0,
+	0x68, 0x3c, 0xfb, 0xba, 0x5e, 0x75, 0x77, 0x67, 0x35, 0x33, 0x76, 0xf0, 0xb7, 0xe0, 0x6b, 0xf0,
+	0x4d, 0x2a, 0x71, 0xe9, 0x11, 0x71, 0x88, 0x50, 0x72, 0xe7, 0x1b, 0x20, 0xa1, 0x9d, 0x99, 0xb5,
+	0x13, 0xc7, 0x01, 0x71, 0xf3, 0x7b, 0xbf, 0xdf, 0xfc, 0xde, 0x7b, 0xf3, 0x7e, 0xe3, 0x45, 0x4f,
+	0x60, 0x09, 0x22, 0xa6, 0x52, 0x0d, 0x58, 0xc6, 0x06, 0xcb, 0x17, 0x83, 0x10, 0x52, 0x90, 0x91,
+	0xf4, 0x32, 0xc1, 0x15, 0xc7, 0xf5, 0x02, 0xf6, 0x58, 0xc6, 0xbc, 0xe5, 0x8b, 0xe3, 0x66, 0xc8,
+	0x43, 0xae, 0xb1, 0x41, 0xfe, 0xcb, 0xd0, 0x8e, 0x3f, 0xd9, 0x56, 0xc9, 0x04, 0x30, 0x9e, 0x64,
+	0x51, 0x0c, 0x56, 0xa9, 0xf7, 0x5b, 0x09, 0x3d, 0x7c, 0x65, 0xb4, 0xa7, 0x8a, 0x2a, 0xc0, 0x5f,
+	0xa0, 0x6a, 0x46, 0x05, 0x4d, 0xa4, 0xeb, 0x74, 0x9d, 0xfe, 0xe1, 0x49, 0xdb, 0xdb, 0xaa, 0xe5,
+	0x4d, 0x34, 0x3c, 0x2a, 0xbf, 0xbb, 0x7c, 0xba, 0xe7, 0x5b, 0x32, 0xf6, 0xd0, 0xe3, 0x00, 0xb2,
+	0x98, 0xaf, 0x08, 0x08, 0x76, 0xf2, 0x39, 0x49, 0xa9, 0x8a, 0x96, 0xe0, 0xee, 0x77, 0x9d, 0xfe,
+	0x81, 0xdf, 0x30, 0xd0, 0x38, 0x47, 0x5e, 0x6b, 0x00, 0x7f, 0x89, 0xda, 0x96, 0x2f, 0x15, 0x7d,
+	0x1b, 0xa5, 0x21, 0x61, 0x3c, 0x55, 0x82, 0x32, 0xe5, 0x96, 0xf4, 0x99, 0x96, 0x81, 0xa7, 0x06,
+	0x7d, 0x69, 0x41, 0xcc, 0x10, 0x36, 0x00, 0x04, 0xeb, 0x13, 0xd2, 0x2d, 0x77, 0x4b, 0xfd, 0xc3,
+	0x13, 0xef, 0x4e, 0xab, 0x2f, 0x17, 0x52, 0xf1, 0x64, 0xb2, 0x9e, 0x3a, 0x28, 0x74, 0xbe, 0x06,
+	0x45, 0xed, 0x04, 0x8d, 0x42, 0xaf, 0xc0, 0x24, 0xfe, 0x0e, 0x3d, 0x32, 0x53, 0xd0, 0x38, 0xe6,
+	0x17, 0x34, 0x65, 0x20, 0xdd, 0x8a, 0x2e, 0xf1, 0xec, 0x4e, 0x09, 0x7b, 0x79, 0x7a, 0xb6, 0x61,
+	0x41, 0xb7, 0xd2, 0x75, 0xb8, 0x95, 0x95, 0xf8, 0x27, 0xf4, 0xd8, 0x08, 0x67, 0x20, 0x92, 0x48,
+	0x91, 0x94, 0x6b, 0xed, 0xaa, 0xd6, 0xee, 0xff, 0xab, 0xf6, 0x44, 0x9f, 0x78, 0xcd, 0x37, 0xea,
+	0x0d, 0xd8, 0xca, 0xcb, 0xde, 0xaf, 0x0e, 0x6a, 0xed, 0x6c, 0x08, 0x37, 0x51, 0x85, 0x5f, 0xa4,
+	0x20, 0xf4, 0x56, 0x6b, 0xbe, 0x09, 0xb0, 0x8b, 0x1e, 0xc8, 0x0c, 0xd2, 0x00, 0x84, 0xde, 0x54,
+	0xcd, 0x2f, 0xc2, 0xdc, 0x06, 0x34, 0xe1, 0x8b, 0xd4, 0xac, 0xa3, 0x36, 0x7a, 0x92, 0x97, 0xfc,
+	0xe3, 0xf2, 0x69, 0x8b, 0x71, 0x99, 0x70, 0x29, 0x83, 0xb7, 0x5e, 0xc4, 0x07, 0x09, 0x55, 0x73,
+	0xef, 0x3c, 0x55, 0xbe, 0x25, 0xe3, 0x4f, 0xd1, 0xa3, 0x62, 0x2b, 0x84, 0x06, 0x81, 0x00, 0x99,
+	0x2f, 0x27, 0x57, 0xae, 0x17, 0xf9, 0xa1, 0x49, 0xf7, 0x32, 0xd4, 0xbe, 0x67, 0xbe, 0x9d, 0x2a,
+	0xce, 0x4e, 0x95, 0xcd, 0x5c, 0xfb, 0x37, 0xe7, 0x6a, 0xa2, 0x8a, 0xbe, 0x5a, 0xdd, 0x7c, 0xd9,
+	0x37, 0x41, 0xef, 0xef, 0x7d, 0x54, 0x35, 0xe6, 0xcd, 0x2b, 0x68, 0xff, 0x33, 0x1e, 0x93, 0x25,
+	0x08, 0x19, 0xf1, 0x54, 0x57, 0x38, 0xf2, 0xeb, 0x45, 0xfe, 0x5b, 0x93, 0xc6, 0xa7, 0xa8, 0x75,
+	0x31, 0x8f, 0x14, 0xc4, 0x91, 0x54, 0x10, 0x10, 0xeb, 0x16, 0x21, 0xdd, 0xfd, 0x6e, 0xa9, 0x5f,
+	0xf3, 0x9b, 0x37, 0xc0, 0xb3, 0x02, 0xc3, 0x63, 0xd4, 0xa5, 0x0b, 0xc5, 0xc9, 0xad, 0x37, 0xf1,
+	0x86, 0x0b, 0x12, 0xcd, 0x18, 0x09, 0x20, 0xe5, 0x89, 0xb4, 0x3e, 0xff, 0x28, 0xe7, 0x9d, 0x6d,
+	0xde, 0xc7, 0x57, 0x5c, 0x9c, 0xcf, 0xd8, 0x99, 0xa6, 0xe0, 0x1f, 0xd0, 0xc3, 0x90, 0x4a, 0x22,
+	0xd9, 0x1c, 0x82, 0x45, 0x0c, 0xd6, 0xe7, 0xa7, 0xff, 0xcb, 0xe7, 0x73, 0x1e, 0xbc, 0xa2, 0xc5,
+	0x73, 0x3d, 0x0c, 0xa9, 0x9c, 0x5a, 0x35, 0x9c, 0xa2, 0x8f, 0xef, 0x36, 0x79, 0xa3, 0xc1, 0xca,
+	0x3d, 0xb6, 0x1c, 0xde, 0xee, 0xb8, 0x68, 0xd7, 0x96, 0x70, 0xe9, 0x6e, 0x58, 0xf6, 0x7e, 0x44,
+	0xed, 0x7b, 0x8e, 0xe2, 0x67, 0xa8, 0xfe, 0x66, 0x11, 0xc7, 0xa6, 0x32, 0xc9, 0xa8, 0x9a, 0xdb,
+	0x85, 0x1f, 0xe5, 0x69, 0xcd, 0x99, 0x50, 0x35, 0xc7, 0xc7, 0xe8, 0x20, 0x00, 0x16, 0x25, 0x34,
+	0x96, 0x7a, 0xe3, 0x47, 0xfe, 0x3a, 0xee, 0xfd, 0xe5, 0xa0, 0xde, 0x7f, 0x5f, 0x44, 0xfe, 0xcf,
+	0xc3, 0x34, 0x8b, 0x6c, 0xfe, 0x0d, 0x03, 0xa2, 0x56, 0x19, 0x58, 0x07, 0xb4, 0xd8, 0xb6, 0xc8,
+	0x37, 0xab, 0x0c, 0xf0, 0x73, 0x54, 0x4f, 0xb4, 0x08, 0x91, 0x10, 0x03, 0x53, 0xbc, 0xf0, 0xdc,
+	0x07, 0x26, 0x3d, 0xb5, 0x59, 0xfc, 0x21, 0x3a, 0x98, 0x51, 0x09, 0x24, 0xa4, 0xd2, 0xfa, 0xef,
+	0x41, 0x1e, 0xe7, 0xb5, 0xbb, 0x66, 0x9f, 0x19, 0x08, 0x32, 0x5b, 0x29, 0xd0, 0x4f, 0xa3, 0xec,
+	0xa3, 0x90, 0xca, 0x09, 0x88, 0xd1, 0x4a, 0x01, 0xfe, 0x0c, 0x35, 0x0a, 0x46, 0xa4, 0x40, 0x50,
+	0x95, 0x3b, 0xb3, 0xa2, 0x69, 0x75, 0x43, 0x3b, 0x2f, 0xd2, 0xa3, 0xe1, 0xbb, 0xab, 0x8e, 0xf3,
+	0xfe, 0xaa, 0xe3, 0xfc, 0x79, 0xd5, 0x71, 0x7e, 0xb9, 0xee, 0xec, 0xbd, 0xbf, 0xee, 0xec, 0xfd,
+	0x7e, 0xdd, 0xd9, 0xfb, 0xfe, 0x79, 0x18, 0xa9, 0xf9, 0x62, 0xe6, 0x31, 0x9e, 0x0c, 0xc6, 0x92,
+	0xd1, 0x74, 0x34, 0x1e, 0xac, 0xbf, 0x05, 0x3f, 0xeb, 0xaf, 0x41, 0x3e, 0xb0, 0x9c, 0x55, 0xb5,
+	0xdb, 0x4f, 0xff, 0x19, 0x00, 0xba, 0x8f, 0x1c, 0x94, 0x70, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +555,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Erc20PermitNonces) > 0 {
+		for iNdEx := len(m.Erc20PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Erc20Allowances) > 0 {
+		for iNdEx := len(m.Erc20Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DeployedContracts) > 0 {
+		for iNdEx := len(m.DeployedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeployedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.DeployStakingContract {
 		i--
 		if m.DeployStakingContract {
@@ -228,7 +630,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *GenesisErc20Allowance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -238,67 +640,347 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *GenesisErc20Allowance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GenesisErc20Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WhitelistedDeployers) > 0 {
-		for iNdEx := len(m.WhitelistedDeployers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WhitelistedDeployers[iNdEx])
-			copy(dAtA[i:], m.WhitelistedDeployers[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WhitelistedDeployers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.ProtocolVersion != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ProtocolVersion))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Spender)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisErc20PermitNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GenesisErc20PermitNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisErc20PermitNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.DeployErc20Native {
-		n += 2
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.DeployStakingContract {
-		n += 2
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoDeployErc20IbcDenoms) > 0 {
+		for iNdEx := len(m.AutoDeployErc20IbcDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoDeployErc20IbcDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GasSchedule) > 0 {
+		for iNdEx := len(m.GasSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AutoDeployErc20ForIbcDenoms {
+		i--
+		if m.AutoDeployErc20ForIbcDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WhitelistedDeployers) > 0 {
+		for iNdEx := len(m.WhitelistedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistedDeployers[iNdEx])
+			copy(dAtA[i:], m.WhitelistedDeployers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WhitelistedDeployers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoDeployErc20IbcDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoDeployErc20IbcDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoDeployErc20IbcDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FullDenomPath) > 0 {
+		i -= len(m.FullDenomPath)
+		copy(dAtA[i:], m.FullDenomPath)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FullDenomPath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomPrecompiledContractMethodGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomPrecompiledContractMethodGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomPrecompiledContractMethodGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPerIteration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerIteration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasPerByte != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MethodSelector) > 0 {
+		i -= len(m.MethodSelector)
+		copy(dAtA[i:], m.MethodSelector)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MethodSelector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CustomPrecompiledType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CustomPrecompiledType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DeployErc20Native {
+		n += 2
+	}
+	if m.DeployStakingContract {
+		n += 2
+	}
+	if len(m.DeployedContracts) > 0 {
+		for _, e := range m.DeployedContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Allowances) > 0 {
+		for _, e := range m.Erc20Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20PermitNonces) > 0 {
+		for _, e := range m.Erc20PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisErc20Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisErc20PermitNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.ProtocolVersion != 0 {
 		n += 1 + sovGenesis(uint64(m.ProtocolVersion))
 	}
@@ -308,16 +990,610 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if m.AutoDeployErc20ForIbcDenoms {
+		n += 2
+	}
+	if len(m.GasSchedule) > 0 {
+		for _, e := range m.GasSchedule {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoDeployErc20IbcDenoms) > 0 {
+		for _, e := range m.AutoDeployErc20IbcDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AutoDeployErc20IbcDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FullDenomPath)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *CustomPrecompiledContractMethodGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CustomPrecompiledType != 0 {
+		n += 1 + sovGenesis(uint64(m.CustomPrecompiledType))
+	}
+	l = len(m.MethodSelector)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BaseGas != 0 {
+		n += 1 + sovGenesis(uint64(m.BaseGas))
+	}
+	if m.GasPerByte != 0 {
+		n += 1 + sovGenesis(uint64(m.GasPerByte))
+	}
+	if m.GasPerIteration != 0 {
+		n += 1 + sovGenesis(uint64(m.GasPerIteration))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployErc20Native", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeployErc20Native = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStakingContract", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeployStakingContract = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployedContracts = append(m.DeployedContracts, CustomPrecompiledContractMeta{})
+			if err := m.DeployedContracts[len(m.DeployedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Allowances = append(m.Erc20Allowances, GenesisErc20Allowance{})
+			if err := m.Erc20Allowances[len(m.Erc20Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20PermitNonces = append(m.Erc20PermitNonces, GenesisErc20PermitNonce{})
+			if err := m.Erc20PermitNonces[len(m.Erc20PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisErc20Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisErc20Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisErc20Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisErc20PermitNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisErc20PermitNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisErc20PermitNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -340,15 +1616,86 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedDeployers = append(m.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeployErc20ForIbcDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDeployErc20ForIbcDenoms = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -375,15 +1722,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasSchedule = append(m.GasSchedule, CustomPrecompiledContractMethodGas{})
+			if err := m.GasSchedule[len(m.GasSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployErc20Native", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeployErc20IbcDenoms", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -393,17 +1741,113 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeployErc20Native = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoDeployErc20IbcDenoms = append(m.AutoDeployErc20IbcDenoms, AutoDeployErc20IbcDenom{})
+			if err := m.AutoDeployErc20IbcDenoms[len(m.AutoDeployErc20IbcDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoDeployErc20IbcDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoDeployErc20IbcDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoDeployErc20IbcDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullDenomPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FullDenomPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployStakingContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var v int
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -413,12 +1857,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Decimals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeployStakingContract = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -440,7 +1883,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CustomPrecompiledContractMethodGas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -463,17 +1906,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomPrecompiledContractMethodGas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomPrecompiledContractMethodGas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomPrecompiledType", wireType)
 			}
-			m.ProtocolVersion = 0
+			m.CustomPrecompiledType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -483,14 +1926,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				m.CustomPrecompiledType |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -518,8 +1961,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedDeployers = append(m.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+			m.MethodSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+			}
+			m.BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerByte", wireType)
+			}
+			m.GasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerIteration", wireType)
+			}
+			m.GasPerIteration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerIteration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cpcutils "github.com/EscanBE/everlast/x/cpc/utils"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	erc20Addr := common.BytesToAddress([]byte("erc20"))
+	owner := common.BytesToAddress([]byte("owner"))
+	spender := common.BytesToAddress([]byte("spender"))
+
+	erc20Contract := func(addr common.Address, minDenom string) CustomPrecompiledContractMeta {
+		return CustomPrecompiledContractMeta{
+			Address:               addr.Bytes(),
+			CustomPrecompiledType: CpcTypeErc20,
+			Name:                  "Token",
+			TypedMeta: string(cpcutils.MustMarshalJson(Erc20CustomPrecompiledContractMeta{
+				Symbol:   "TKN",
+				Decimals: 18,
+				MinDenom: minDenom,
+			})),
+		}
+	}
+
+	validGenesis := func() GenesisState {
+		return GenesisState{
+			Params:            DefaultParams(),
+			DeployedContracts: []CustomPrecompiledContractMeta{erc20Contract(erc20Addr, "utkn")},
+			Erc20Allowances: []GenesisErc20Allowance{
+				{ContractAddress: erc20Addr.Hex(), Owner: owner.Hex(), Spender: spender.Hex(), Amount: sdkmath.NewInt(1000)},
+			},
+			Erc20PermitNonces: []GenesisErc20PermitNonce{
+				{ContractAddress: erc20Addr.Hex(), Owner: owner.Hex(), Nonce: 1},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		modifier        func(gs *GenesisState)
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:     "pass - default",
+			modifier: func(gs *GenesisState) { *gs = *DefaultGenesis() },
+			wantErr:  false,
+		},
+		{
+			name:     "pass - valid",
+			modifier: func(_ *GenesisState) {},
+			wantErr:  false,
+		},
+		{
+			name: "fail - duplicate deployed contract",
+			modifier: func(gs *GenesisState) {
+				gs.DeployedContracts = append(gs.DeployedContracts, erc20Contract(erc20Addr, "uother"))
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate deployed contract",
+		},
+		{
+			name: "fail - duplicate ERC20 min denom",
+			modifier: func(gs *GenesisState) {
+				gs.DeployedContracts = append(gs.DeployedContracts, erc20Contract(common.BytesToAddress([]byte("other")), "utkn"))
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate ERC20 contract for utkn",
+		},
+		{
+			name: "fail - unsupported type",
+			modifier: func(gs *GenesisState) {
+				gs.DeployedContracts[0].CustomPrecompiledType = 99
+			},
+			wantErr:         true,
+			wantErrContains: "unsupported custom precompiled type",
+		},
+		{
+			name: "fail - invalid typed meta",
+			modifier: func(gs *GenesisState) {
+				gs.DeployedContracts[0].TypedMeta = "{}"
+			},
+			wantErr:         true,
+			wantErrContains: "invalid deployed contract",
+		},
+		{
+			name: "fail - duplicate allowance",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20Allowances = append(gs.Erc20Allowances, gs.Erc20Allowances[0])
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate ERC20 allowance",
+		},
+		{
+			name: "pass - same owner and spender on different contracts",
+			modifier: func(gs *GenesisState) {
+				otherErc20Addr := common.BytesToAddress([]byte("other"))
+				gs.DeployedContracts = append(gs.DeployedContracts, erc20Contract(otherErc20Addr, "uother"))
+
+				allowance := gs.Erc20Allowances[0]
+				allowance.ContractAddress = otherErc20Addr.Hex()
+				gs.Erc20Allowances = append(gs.Erc20Allowances, allowance)
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - missing allowance contract address",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20Allowances[0].ContractAddress = ""
+			},
+			wantErr:         true,
+			wantErrContains: "invalid ERC20 allowance contract address",
+		},
+		{
+			name: "fail - allowance of non-deployed contract",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20Allowances[0].ContractAddress = spender.Hex()
+			},
+			wantErr:         true,
+			wantErrContains: "allowance of non-deployed ERC20 contract",
+		},
+		{
+			name: "fail - zero allowance",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20Allowances[0].Amount = sdkmath.ZeroInt()
+			},
+			wantErr:         true,
+			wantErrContains: "must be positive",
+		},
+		{
+			name: "fail - invalid allowance owner",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20Allowances[0].Owner = "invalid"
+			},
+			wantErr:         true,
+			wantErrContains: "invalid ERC20 allowance owner",
+		},
+		{
+			name: "fail - zero permit nonce",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20PermitNonces[0].Nonce = 0
+			},
+			wantErr:         true,
+			wantErrContains: "permit nonce must be positive",
+		},
+		{
+			name: "fail - permit nonce of non-deployed contract",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20PermitNonces[0].ContractAddress = spender.Hex()
+			},
+			wantErr:         true,
+			wantErrContains: "permit nonce of non-deployed ERC20 contract",
+		},
+		{
+			name: "fail - duplicate permit nonce",
+			modifier: func(gs *GenesisState) {
+				gs.Erc20PermitNonces = append(gs.Erc20PermitNonces, gs.Erc20PermitNonces[0])
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate permit nonce",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := validGenesis()
+			tt.modifier(&gs)
+
+			err := gs.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
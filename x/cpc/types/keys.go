@@ -29,6 +29,7 @@ const (
 	prefixCustomPrecompiledContractMeta
 	prefixErc20CpcDenomToAddress
 	prefixErc20CpcAllowance
+	prefixErc20CpcPermitNonce
 )
 
 // KVStore key prefixes
@@ -37,6 +38,7 @@ var (
 	KeyPrefixCustomPrecompiledContractMeta = []byte{prefixCustomPrecompiledContractMeta}
 	KeyPrefixErc20CpcDenomToAddress        = []byte{prefixErc20CpcDenomToAddress}
 	KeyPrefixErc20CpcAllowance             = []byte{prefixErc20CpcAllowance}
+	KeyPrefixErc20CpcPermitNonce           = []byte{prefixErc20CpcPermitNonce}
 )
 
 func CustomPrecompiledContractMetaKey(contractAddr common.Address) []byte {
@@ -47,10 +49,19 @@ func Erc20CustomPrecompiledContractMinDenomToAddressKey(minDenom string) []byte
 	return append(KeyPrefixErc20CpcDenomToAddress, []byte(minDenom)...)
 }
 
-func Erc20CustomPrecompiledContractAllowanceKey(owner, spender common.Address) []byte {
-	key := make([]byte, 0, len(KeyPrefixErc20CpcAllowance)+40)
+func Erc20CustomPrecompiledContractAllowanceKey(contractAddr, owner, spender common.Address) []byte {
+	key := make([]byte, 0, len(KeyPrefixErc20CpcAllowance)+60)
 	key = append(key, KeyPrefixErc20CpcAllowance...)
+	key = append(key, contractAddr.Bytes()...)
 	key = append(key, owner.Bytes()...)
 	key = append(key, spender.Bytes()...)
 	return key
 }
+
+func Erc20CustomPrecompiledContractPermitNonceKey(contractAddr, owner common.Address) []byte {
+	key := make([]byte, 0, len(KeyPrefixErc20CpcPermitNonce)+40)
+	key = append(key, KeyPrefixErc20CpcPermitNonce...)
+	key = append(key, contractAddr.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	return key
+}
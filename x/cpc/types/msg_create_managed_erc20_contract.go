@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (m MsgCreateManagedErc20ContractRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidAddress, err), "invalid creator address: %s", m.Creator)
+	}
+
+	denom, err := GetFactoryDenom(m.Creator, m.Subdenom)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(m.Name) != m.Name {
+		return sdkerrors.ErrInvalidRequest.Wrapf("name cannot have leading/trailing white spaces")
+	} else if m.Name == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("name cannot be empty")
+	}
+
+	if strings.TrimSpace(m.Symbol) != m.Symbol {
+		return sdkerrors.ErrInvalidRequest.Wrapf("symbol cannot have leading/trailing white spaces")
+	} else if m.Symbol == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("symbol cannot be empty")
+	}
+
+	if m.Decimals < 1 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("decimals must be greater than 0")
+	} else if m.Decimals > 18 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("decimals must be less than or equal to 18")
+	}
+
+	bankDenomMetadata := NewManagedErc20BankDenomMetadata(denom, m.Name, m.Symbol, uint8(m.Decimals))
+	if err := bankDenomMetadata.Validate(); err != nil {
+		return errorsmod.Wrap(errors.Join(sdkerrors.ErrInvalidRequest, err), "does not satisfy bank denom metadata validation")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (m MsgUpdateCustomPrecompiledContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidAddress, err), "invalid authority address: %s", m.Authority)
+	}
+
+	if !common.IsHexAddress(m.ContractAddress) {
+		return sdkerrors.ErrInvalidAddress.Wrapf("contract address must be a valid hex address: %s", m.ContractAddress)
+	} else if common.HexToAddress(m.ContractAddress) == (common.Address{}) {
+		return sdkerrors.ErrInvalidAddress.Wrapf("contract address cannot be zero address")
+	}
+
+	if strings.TrimSpace(m.Name) != m.Name {
+		return sdkerrors.ErrInvalidRequest.Wrapf("name cannot have leading/trailing white spaces")
+	}
+
+	if m.TypedMeta != "" && !json.Valid([]byte(m.TypedMeta)) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("typed meta must be a valid json")
+	}
+
+	return nil
+}
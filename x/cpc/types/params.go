@@ -1,13 +1,17 @@
 package types
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
 func DefaultParams() Params {
@@ -37,5 +41,87 @@ func (m Params) Validate() error {
 		uniqueDeployers[deployer] = struct{}{}
 	}
 
+	uniqueMethods := make(map[string]struct{})
+	for _, methodGas := range m.GasSchedule {
+		if err := methodGas.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s", methodGas.CustomPrecompiledType, methodGas.MethodSelector)
+		if _, exists := uniqueMethods[key]; exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate gas schedule for method %s of type %d", methodGas.MethodSelector, methodGas.CustomPrecompiledType)
+		}
+		uniqueMethods[key] = struct{}{}
+	}
+
+	uniqueIbcDenoms := make(map[string]struct{})
+	for _, ibcDenom := range m.AutoDeployErc20IbcDenoms {
+		if err := ibcDenom.Validate(); err != nil {
+			return err
+		}
+		if _, exists := uniqueIbcDenoms[ibcDenom.FullDenomPath]; exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate auto deploy ERC20 IBC denom: %s", ibcDenom.FullDenomPath)
+		}
+		uniqueIbcDenoms[ibcDenom.FullDenomPath] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetAutoDeployErc20IbcDenom returns the decimals entry of the given IBC denom (ibc/{hash}), if any.
+func (m Params) GetAutoDeployErc20IbcDenom(ibcDenom string) *AutoDeployErc20IbcDenom {
+	for _, allowed := range m.AutoDeployErc20IbcDenoms {
+		if transfertypes.ParseDenomTrace(allowed.FullDenomPath).IBCDenom() == ibcDenom {
+			allowed := allowed
+			return &allowed
+		}
+	}
+	return nil
+}
+
+// GetMethodGas returns the gas schedule of the method of the given Custom Precompiled Contract type, if any.
+func (m Params) GetMethodGas(cpcType uint32, method4BytesSignature []byte) *CustomPrecompiledContractMethodGas {
+	methodSelector := "0x" + hex.EncodeToString(method4BytesSignature)
+	for _, methodGas := range m.GasSchedule {
+		if methodGas.CustomPrecompiledType == cpcType && methodGas.MethodSelector == methodSelector {
+			methodGas := methodGas
+			return &methodGas
+		}
+	}
+	return nil
+}
+
+func (m CustomPrecompiledContractMethodGas) Validate() error {
+	if !isSupportedCustomPrecompiledType(m.CustomPrecompiledType) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid custom precompiled type in gas schedule: %d", m.CustomPrecompiledType)
+	}
+
+	if strings.ToLower(m.MethodSelector) != m.MethodSelector {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "method selector must be lowercase")
+	}
+	if !strings.HasPrefix(m.MethodSelector, "0x") {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "method selector must be 0x-prefixed: %s", m.MethodSelector)
+	}
+	if bz, err := hex.DecodeString(m.MethodSelector[2:]); err != nil || len(bz) != 4 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "method selector must be 4 bytes: %s", m.MethodSelector)
+	}
+
+	return nil
+}
+
+func (m AutoDeployErc20IbcDenom) Validate() error {
+	denomTrace := transfertypes.ParseDenomTrace(m.FullDenomPath)
+	if err := denomTrace.Validate(); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "invalid auto deploy ERC20 IBC denom: %s", m.FullDenomPath)
+	}
+	if denomTrace.IsNativeDenom() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "auto deploy ERC20 IBC denom must be an IBC voucher denom path: %s", m.FullDenomPath)
+	} else if denomTrace.GetFullDenomPath() != m.FullDenomPath {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "auto deploy ERC20 IBC denom must be a full denom path: %s", m.FullDenomPath)
+	}
+
+	if m.Decimals > 18 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "decimals of auto deploy ERC20 IBC denom must be less than or equal to 18: %s", m.FullDenomPath)
+	}
+
 	return nil
 }
@@ -0,0 +1,218 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	validMethodGas := func() CustomPrecompiledContractMethodGas {
+		return CustomPrecompiledContractMethodGas{
+			CustomPrecompiledType: CpcTypeStaking,
+			MethodSelector:        "0x479ba7ae",
+			BaseGas:               20_000,
+			GasPerIteration:       5_000,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		modifier        func(params *Params)
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:     "pass - default",
+			modifier: func(_ *Params) {},
+			wantErr:  false,
+		},
+		{
+			name: "fail - zero protocol version",
+			modifier: func(params *Params) {
+				params.ProtocolVersion = 0
+			},
+			wantErr:         true,
+			wantErrContains: "protocol version cannot be zero",
+		},
+		{
+			name: "pass - with gas schedule",
+			modifier: func(params *Params) {
+				erc20BalanceOf := validMethodGas()
+				erc20BalanceOf.CustomPrecompiledType = CpcTypeErc20
+				erc20BalanceOf.MethodSelector = "0x70a08231"
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{validMethodGas(), erc20BalanceOf}
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - same selector of different types",
+			modifier: func(params *Params) {
+				other := validMethodGas()
+				other.CustomPrecompiledType = CpcTypeErc20
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{validMethodGas(), other}
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - duplicate gas schedule",
+			modifier: func(params *Params) {
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{validMethodGas(), validMethodGas()}
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate gas schedule",
+		},
+		{
+			name: "fail - invalid custom precompiled type",
+			modifier: func(params *Params) {
+				methodGas := validMethodGas()
+				methodGas.CustomPrecompiledType = 0
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{methodGas}
+			},
+			wantErr:         true,
+			wantErrContains: "invalid custom precompiled type",
+		},
+		{
+			name: "fail - upper case selector",
+			modifier: func(params *Params) {
+				methodGas := validMethodGas()
+				methodGas.MethodSelector = "0x479BA7AE"
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{methodGas}
+			},
+			wantErr:         true,
+			wantErrContains: "method selector must be lowercase",
+		},
+		{
+			name: "fail - selector without 0x prefix",
+			modifier: func(params *Params) {
+				methodGas := validMethodGas()
+				methodGas.MethodSelector = "479ba7ae"
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{methodGas}
+			},
+			wantErr:         true,
+			wantErrContains: "method selector must be 0x-prefixed",
+		},
+		{
+			name: "fail - selector is not 4 bytes",
+			modifier: func(params *Params) {
+				methodGas := validMethodGas()
+				methodGas.MethodSelector = "0x479ba7"
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{methodGas}
+			},
+			wantErr:         true,
+			wantErrContains: "method selector must be 4 bytes",
+		},
+		{
+			name: "fail - selector is not hex",
+			modifier: func(params *Params) {
+				methodGas := validMethodGas()
+				methodGas.MethodSelector = "0x479ba7zz"
+				params.GasSchedule = []CustomPrecompiledContractMethodGas{methodGas}
+			},
+			wantErr:         true,
+			wantErrContains: "method selector must be 4 bytes",
+		},
+		{
+			name: "pass - with auto deploy ERC20 IBC denoms",
+			modifier: func(params *Params) {
+				params.AutoDeployErc20IbcDenoms = []AutoDeployErc20IbcDenom{
+					{FullDenomPath: "transfer/channel-0/uatom", Decimals: 6},
+					{FullDenomPath: "transfer/channel-1/transfer/channel-2/aevmos", Decimals: 18},
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - duplicate auto deploy ERC20 IBC denom",
+			modifier: func(params *Params) {
+				params.AutoDeployErc20IbcDenoms = []AutoDeployErc20IbcDenom{
+					{FullDenomPath: "transfer/channel-0/uatom", Decimals: 6},
+					{FullDenomPath: "transfer/channel-0/uatom", Decimals: 18},
+				}
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate auto deploy ERC20 IBC denom",
+		},
+		{
+			name: "fail - auto deploy ERC20 IBC denom is not a voucher",
+			modifier: func(params *Params) {
+				params.AutoDeployErc20IbcDenoms = []AutoDeployErc20IbcDenom{{FullDenomPath: "uatom", Decimals: 6}}
+			},
+			wantErr:         true,
+			wantErrContains: "must be an IBC voucher denom path",
+		},
+		{
+			name: "fail - auto deploy ERC20 IBC denom is the IBC denom instead of full path",
+			modifier: func(params *Params) {
+				params.AutoDeployErc20IbcDenoms = []AutoDeployErc20IbcDenom{{FullDenomPath: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Decimals: 6}}
+			},
+			wantErr:         true,
+			wantErrContains: "must be an IBC voucher denom path",
+		},
+		{
+			name: "pass - auto deploy ERC20 IBC denom with zero decimals",
+			modifier: func(params *Params) {
+				params.AutoDeployErc20IbcDenoms = []AutoDeployErc20IbcDenom{{FullDenomPath: "transfer/channel-0/uatom", Decimals: 0}}
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - auto deploy ERC20 IBC denom with decimals greater than 18",
+			modifier: func(params *Params) {
+				params.AutoDeployErc20IbcDenoms = []AutoDeployErc20IbcDenom{{FullDenomPath: "transfer/channel-0/uatom", Decimals: 19}}
+			},
+			wantErr:         true,
+			wantErrContains: "must be less than or equal to 18",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			tt.modifier(&params)
+
+			err := params.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParams_GetMethodGas(t *testing.T) {
+	params := DefaultParams()
+	params.GasSchedule = []CustomPrecompiledContractMethodGas{
+		{
+			CustomPrecompiledType: CpcTypeStaking,
+			MethodSelector:        "0x479ba7ae",
+			BaseGas:               20_000,
+			GasPerIteration:       5_000,
+		},
+	}
+
+	methodGas := params.GetMethodGas(CpcTypeStaking, []byte{0x47, 0x9b, 0xa7, 0xae})
+	require.NotNil(t, methodGas)
+	require.Equal(t, uint64(20_000), methodGas.BaseGas)
+	require.Equal(t, uint64(5_000), methodGas.GasPerIteration)
+
+	require.Nil(t, params.GetMethodGas(CpcTypeErc20, []byte{0x47, 0x9b, 0xa7, 0xae}))
+	require.Nil(t, params.GetMethodGas(CpcTypeStaking, []byte{0x70, 0xa0, 0x82, 0x31}))
+}
+
+func TestParams_GetAutoDeployErc20IbcDenom(t *testing.T) {
+	params := DefaultParams()
+	params.AutoDeployErc20IbcDenoms = []AutoDeployErc20IbcDenom{
+		{FullDenomPath: "transfer/channel-0/uatom", Decimals: 6},
+	}
+
+	// ibc/{hash} of transfer/channel-0/uatom
+	allowed := params.GetAutoDeployErc20IbcDenom("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+	require.NotNil(t, allowed)
+	require.Equal(t, uint32(6), allowed.Decimals)
+
+	require.Nil(t, params.GetAutoDeployErc20IbcDenom("uatom"))
+	require.Nil(t, params.GetAutoDeployErc20IbcDenom("ibc/0000000000000000000000000000000000000000000000000000000000000000"))
+}
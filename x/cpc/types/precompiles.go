@@ -15,29 +15,98 @@ type ProtocolCpc uint32
 
 const (
 	ProtocolCpcV1 ProtocolCpc = 1
+	// ProtocolCpcV2 makes the enabled custom precompiled contracts have pseudocode inside the EVM,
+	// so `EXTCODESIZE` and `EXTCODEHASH` treat them as contracts.
+	// It also introduces the Gov, Distribution, IBC Transfer, Multicall, Slashing, Authz, Vesting and Bank
+	// custom precompiled contracts.
+	ProtocolCpcV2 ProtocolCpc = 2
 
-	LatestProtocolCpc = ProtocolCpcV1
+	LatestProtocolCpc = ProtocolCpcV2
 )
 
 const (
 	CpcTypeErc20 uint32 = iota + 1
 	CpcTypeStaking
 	CpcTypeBech32
+	CpcTypeGov
+	CpcTypeDistribution
+	CpcTypeIbcTransfer
+	CpcTypeMulticall
+	CpcTypeSlashing
+	CpcTypeAuthz
+	CpcTypeVesting
+	CpcTypeBank
 )
 
 const (
 	cpcAddrNonceStaking byte = iota + 1
 	cpcAddrNonceBech32
+	cpcAddrNonceGov
+	cpcAddrNonceDistribution
+	cpcAddrNonceIbcTransfer
+	cpcAddrNonceMulticall
+	cpcAddrNonceSlashing
+	cpcAddrNonceAuthz
+	cpcAddrNonceVesting
+	cpcAddrNonceBank
 )
 
 const EmptyTypedMeta = "{}"
 
+// isSupportedCustomPrecompiledType returns true if the given custom precompiled type is supported.
+func isSupportedCustomPrecompiledType(cpcType uint32) bool {
+	switch cpcType {
+	case CpcTypeErc20, CpcTypeStaking, CpcTypeBech32, CpcTypeGov, CpcTypeDistribution, CpcTypeIbcTransfer, CpcTypeMulticall, CpcTypeSlashing, CpcTypeAuthz, CpcTypeVesting, CpcTypeBank:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSupportedCustomPrecompiledType returns true if the given custom precompiled type is available in this protocol version.
+func (p ProtocolCpc) IsSupportedCustomPrecompiledType(cpcType uint32) bool {
+	if !isSupportedCustomPrecompiledType(cpcType) {
+		return false
+	}
+
+	switch cpcType {
+	case CpcTypeErc20, CpcTypeStaking, CpcTypeBech32:
+		return p >= ProtocolCpcV1
+	default:
+		return p >= ProtocolCpcV2
+	}
+}
+
 var (
 	// CpcStakingFixedAddress is the address of the staking custom precompiled contract.
 	CpcStakingFixedAddress common.Address
 
 	// CpcBech32FixedAddress is the address of the bech32 custom precompiled contract.
 	CpcBech32FixedAddress common.Address
+
+	// CpcGovFixedAddress is the address of the gov custom precompiled contract.
+	CpcGovFixedAddress common.Address
+
+	// CpcDistributionFixedAddress is the address of the distribution custom precompiled contract.
+	CpcDistributionFixedAddress common.Address
+
+	// CpcIbcTransferFixedAddress is the address of the IBC transfer custom precompiled contract.
+	CpcIbcTransferFixedAddress common.Address
+
+	// CpcMulticallFixedAddress is the address of the multicall custom precompiled contract.
+	CpcMulticallFixedAddress common.Address
+
+	// CpcSlashingFixedAddress is the address of the slashing custom precompiled contract.
+	CpcSlashingFixedAddress common.Address
+
+	// CpcAuthzFixedAddress is the address of the authz custom precompiled contract.
+	CpcAuthzFixedAddress common.Address
+
+	// CpcVestingFixedAddress is the address of the vesting custom precompiled contract.
+	CpcVestingFixedAddress common.Address
+
+	// CpcBankFixedAddress is the address of the bank custom precompiled contract.
+	CpcBankFixedAddress common.Address
 )
 
 func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
@@ -52,15 +121,8 @@ func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "custom precompiled type cannot be zero")
 		}
 
-		switch m.CustomPrecompiledType {
-		case CpcTypeErc20:
-			// valid
-		case CpcTypeStaking:
-			// valid
-		case CpcTypeBech32:
-			// valid
-		default:
-			panic(fmt.Sprintf("unsupported custom precompiled type %d", m.CustomPrecompiledType))
+		if !isSupportedCustomPrecompiledType(m.CustomPrecompiledType) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported custom precompiled type %d", m.CustomPrecompiledType)
 		}
 
 		if m.Name == "" {
@@ -76,11 +138,15 @@ func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
 		}
 
 		switch cpcV {
-		case ProtocolCpcV1:
+		case ProtocolCpcV1, ProtocolCpcV2:
 			// valid
 		default:
 			panic(fmt.Sprintf("unsupported protocol version %d", cpcV))
 		}
+
+		if !cpcV.IsSupportedCustomPrecompiledType(m.CustomPrecompiledType) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "custom precompiled type %d is not supported by protocol version %d", m.CustomPrecompiledType, cpcV)
+		}
 	}
 
 	getErrInvalidMetadata := func(err error) error {
@@ -109,12 +175,12 @@ func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
 			return getErrInvalidMetadata(err)
 		}
 		break
-	case CpcTypeBech32:
+	case CpcTypeBech32, CpcTypeGov, CpcTypeDistribution, CpcTypeIbcTransfer, CpcTypeMulticall, CpcTypeSlashing, CpcTypeAuthz, CpcTypeVesting, CpcTypeBank:
 		if m.TypedMeta != EmptyTypedMeta {
 			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
 		}
 	default:
-		panic(fmt.Sprintf("unimplemented validation for custom precompile type: %d", m.CustomPrecompiledType))
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported custom precompiled type %d", m.CustomPrecompiledType)
 	}
 
 	return nil
@@ -131,6 +197,22 @@ func WrapCustomPrecompiledContractMeta(meta CustomPrecompiledContractMeta) Wrapp
 				return "Staking"
 			case CpcTypeBech32:
 				return "Bech32"
+			case CpcTypeGov:
+				return "Gov"
+			case CpcTypeDistribution:
+				return "Distribution"
+			case CpcTypeIbcTransfer:
+				return "IbcTransfer"
+			case CpcTypeMulticall:
+				return "Multicall"
+			case CpcTypeSlashing:
+				return "Slashing"
+			case CpcTypeAuthz:
+				return "Authz"
+			case CpcTypeVesting:
+				return "Vesting"
+			case CpcTypeBank:
+				return "Bank"
 			default:
 				return "Unknown"
 			}
@@ -163,4 +245,12 @@ func init() {
 
 	CpcStakingFixedAddress = generateCpcAddress(cpcAddrNonceStaking)
 	CpcBech32FixedAddress = generateCpcAddress(cpcAddrNonceBech32)
+	CpcGovFixedAddress = generateCpcAddress(cpcAddrNonceGov)
+	CpcDistributionFixedAddress = generateCpcAddress(cpcAddrNonceDistribution)
+	CpcIbcTransferFixedAddress = generateCpcAddress(cpcAddrNonceIbcTransfer)
+	CpcMulticallFixedAddress = generateCpcAddress(cpcAddrNonceMulticall)
+	CpcSlashingFixedAddress = generateCpcAddress(cpcAddrNonceSlashing)
+	CpcAuthzFixedAddress = generateCpcAddress(cpcAddrNonceAuthz)
+	CpcVestingFixedAddress = generateCpcAddress(cpcAddrNonceVesting)
+	CpcBankFixedAddress = generateCpcAddress(cpcAddrNonceBank)
 }
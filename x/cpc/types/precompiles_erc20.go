@@ -1,10 +1,19 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+// FactoryDenomPrefix is the prefix of the denoms created for the managed ERC20 custom precompiled contracts.
+const FactoryDenomPrefix = "factory"
+
 // Erc20CustomPrecompiledContractMeta is the metadata for the ERC20 custom precompiled contract.
 // ERC20 custom precompiled contract is a contract that can be used to interact with `x/bank` module to directly manage the assets using ERC20 interface.
 type Erc20CustomPrecompiledContractMeta struct {
@@ -14,6 +23,9 @@ type Erc20CustomPrecompiledContractMeta struct {
 	Decimals uint8 `json:"decimals"`
 	// MinDenom is the minimum denomination of the ERC20 token, present the corresponding denom in the `x/bank` module.
 	MinDenom string `json:"min_denom"`
+	// Owner is the hex address of the owner of the managed ERC20 token, empty for non-managed tokens.
+	// Owner can mint new tokens, transfer the ownership and update the metadata.
+	Owner string `json:"owner,omitempty"`
 }
 
 func (m Erc20CustomPrecompiledContractMeta) Validate(_ ProtocolCpc) error {
@@ -33,5 +45,69 @@ func (m Erc20CustomPrecompiledContractMeta) Validate(_ ProtocolCpc) error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "symbol and min denom cannot be the same")
 	}
 
+	if m.Owner != "" {
+		if !common.IsHexAddress(m.Owner) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid owner address: %s", m.Owner)
+		}
+
+		if common.HexToAddress(m.Owner) == (common.Address{}) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "owner cannot be zero address, use empty instead")
+		}
+	}
+
 	return nil
 }
+
+// IsManaged returns true if the ERC20 token is managed by an owner.
+func (m Erc20CustomPrecompiledContractMeta) IsManaged() bool {
+	return m.Owner != ""
+}
+
+// GetOwner returns the owner of the managed ERC20 token, zero address for non-managed tokens.
+func (m Erc20CustomPrecompiledContractMeta) GetOwner() common.Address {
+	if m.Owner == "" {
+		return common.Address{}
+	}
+	return common.HexToAddress(m.Owner)
+}
+
+// GetFactoryDenom returns the denom of the managed ERC20 token, created by the creator with the given sub-denom.
+// Format: factory/{creator}/{subdenom}
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sub-denom cannot be empty")
+	}
+
+	if strings.Contains(subdenom, "/") {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sub-denom cannot contain '/'")
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid factory denom %s: %s", denom, err)
+	}
+
+	return denom, nil
+}
+
+// NewManagedErc20BankDenomMetadata builds the bank denom metadata of the managed ERC20 token.
+// The symbol is used as the display unit.
+func NewManagedErc20BankDenomMetadata(denom, name, symbol string, decimals uint8) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("Managed ERC20 token %s", name),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+			},
+			{
+				Denom:    symbol,
+				Exponent: uint32(decimals),
+			},
+		},
+		Base:    denom,
+		Display: symbol,
+		Name:    name,
+		Symbol:  symbol,
+	}
+}
@@ -15,6 +15,7 @@ func TestErc20CustomPrecompiledContractMeta_Validate(t *testing.T) {
 		symbol          string
 		decimals        uint8
 		minDenom        string
+		owner           string
 		wantErr         bool
 		wantErrContains string
 	}{
@@ -64,6 +65,32 @@ func TestErc20CustomPrecompiledContractMeta_Validate(t *testing.T) {
 			wantErr:         true,
 			wantErrContains: "symbol and min denom cannot be the same",
 		},
+		{
+			name:     "pass - managed token with owner",
+			symbol:   constants.DisplayDenom,
+			decimals: constants.BaseDenomExponent,
+			minDenom: constants.BaseDenom,
+			owner:    "0xcc01000000000000000000000000000000000001",
+			wantErr:  false,
+		},
+		{
+			name:            "fail - owner must be a valid hex address",
+			symbol:          constants.DisplayDenom,
+			decimals:        constants.BaseDenomExponent,
+			minDenom:        constants.BaseDenom,
+			owner:           "evl1invalid",
+			wantErr:         true,
+			wantErrContains: "invalid owner address",
+		},
+		{
+			name:            "fail - owner cannot be zero address",
+			symbol:          constants.DisplayDenom,
+			decimals:        constants.BaseDenomExponent,
+			minDenom:        constants.BaseDenom,
+			owner:           "0x0000000000000000000000000000000000000000",
+			wantErr:         true,
+			wantErrContains: "owner cannot be zero address",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -73,6 +100,7 @@ func TestErc20CustomPrecompiledContractMeta_Validate(t *testing.T) {
 						Symbol:   tt.symbol,
 						Decimals: tt.decimals,
 						MinDenom: tt.minDenom,
+						Owner:    tt.owner,
 					}
 
 					err := m.Validate(protocolVersion)
@@ -88,3 +116,47 @@ func TestErc20CustomPrecompiledContractMeta_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFactoryDenom(t *testing.T) {
+	const creator = "evl1qqqqhe5pnaq5qq39wqkn957aydnrm45sywg476"
+
+	tests := []struct {
+		name            string
+		subdenom        string
+		wantDenom       string
+		wantErrContains string
+	}{
+		{
+			name:      "pass - valid",
+			subdenom:  "mytoken",
+			wantDenom: "factory/" + creator + "/mytoken",
+		},
+		{
+			name:            "fail - empty sub-denom",
+			subdenom:        "",
+			wantErrContains: "sub-denom cannot be empty",
+		},
+		{
+			name:            "fail - sub-denom contains slash",
+			subdenom:        "my/token",
+			wantErrContains: "sub-denom cannot contain '/'",
+		},
+		{
+			name:            "fail - invalid denom",
+			subdenom:        "my token",
+			wantErrContains: "invalid factory denom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			denom, err := GetFactoryDenom(creator, tt.subdenom)
+			if tt.wantErrContains != "" {
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDenom, denom)
+		})
+	}
+}
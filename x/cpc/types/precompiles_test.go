@@ -32,7 +32,6 @@ func Test_CustomPrecompiledContractMeta_ERC20_Validate(t *testing.T) {
 		meta            CustomPrecompiledContractMeta
 		filterCpc       func(ProtocolCpc) bool
 		wantErr         bool
-		wantPanic       bool
 		wantErrContains string
 	}{
 		{
@@ -95,7 +94,7 @@ func Test_CustomPrecompiledContractMeta_ERC20_Validate(t *testing.T) {
 			wantErrContains: "custom precompiled type cannot be zero",
 		},
 		{
-			name: "fail - panic if unsupported type",
+			name: "fail - reject unsupported type",
 			meta: CustomPrecompiledContractMeta{
 				Address:               pseudoAddress,
 				CustomPrecompiledType: 9999,
@@ -103,7 +102,8 @@ func Test_CustomPrecompiledContractMeta_ERC20_Validate(t *testing.T) {
 				TypedMeta:             validErc20Meta,
 				Disabled:              false,
 			},
-			wantPanic: true,
+			wantErr:         true,
+			wantErrContains: "unsupported custom precompiled type",
 		},
 		{
 			name: "fail - name cannot be empty",
@@ -189,13 +189,6 @@ func Test_CustomPrecompiledContractMeta_ERC20_Validate(t *testing.T) {
 						}
 					}
 
-					if tt.wantPanic {
-						require.Panics(t, func() {
-							_ = tt.meta.Validate(protocolVersion)
-						})
-						return
-					}
-
 					err := tt.meta.Validate(protocolVersion)
 					if tt.wantErr {
 						require.Error(t, err)
@@ -244,7 +237,6 @@ func Test_CustomPrecompiledContractMeta_Staking_Validate(t *testing.T) {
 		meta            CustomPrecompiledContractMeta
 		filterCpc       func(ProtocolCpc) bool
 		wantErr         bool
-		wantPanic       bool
 		wantErrContains string
 	}{
 		{
@@ -307,7 +299,7 @@ func Test_CustomPrecompiledContractMeta_Staking_Validate(t *testing.T) {
 			wantErrContains: "custom precompiled type cannot be zero",
 		},
 		{
-			name: "fail - panic if unsupported type",
+			name: "fail - reject unsupported type",
 			meta: CustomPrecompiledContractMeta{
 				Address:               pseudoAddress,
 				CustomPrecompiledType: 9999,
@@ -315,7 +307,8 @@ func Test_CustomPrecompiledContractMeta_Staking_Validate(t *testing.T) {
 				TypedMeta:             validStakingMeta,
 				Disabled:              false,
 			},
-			wantPanic: true,
+			wantErr:         true,
+			wantErrContains: "unsupported custom precompiled type",
 		},
 		{
 			name: "fail - name cannot be empty",
@@ -400,13 +393,6 @@ func Test_CustomPrecompiledContractMeta_Staking_Validate(t *testing.T) {
 						}
 					}
 
-					if tt.wantPanic {
-						require.Panics(t, func() {
-							_ = tt.meta.Validate(protocolVersion)
-						})
-						return
-					}
-
 					err := tt.meta.Validate(protocolVersion)
 					if tt.wantErr {
 						require.Error(t, err)
@@ -443,7 +429,6 @@ func Test_CustomPrecompiledContractMeta_Bech32_Validate(t *testing.T) {
 		meta            CustomPrecompiledContractMeta
 		filterCpc       func(ProtocolCpc) bool
 		wantErr         bool
-		wantPanic       bool
 		wantErrContains string
 	}{
 		{
@@ -506,7 +491,7 @@ func Test_CustomPrecompiledContractMeta_Bech32_Validate(t *testing.T) {
 			wantErrContains: "custom precompiled type cannot be zero",
 		},
 		{
-			name: "fail - panic if unsupported type",
+			name: "fail - reject unsupported type",
 			meta: CustomPrecompiledContractMeta{
 				Address:               pseudoAddress,
 				CustomPrecompiledType: 9999,
@@ -514,7 +499,8 @@ func Test_CustomPrecompiledContractMeta_Bech32_Validate(t *testing.T) {
 				TypedMeta:             EmptyTypedMeta,
 				Disabled:              false,
 			},
-			wantPanic: true,
+			wantErr:         true,
+			wantErrContains: "unsupported custom precompiled type",
 		},
 		{
 			name: "fail - name cannot be empty",
@@ -589,13 +575,6 @@ func Test_CustomPrecompiledContractMeta_Bech32_Validate(t *testing.T) {
 						}
 					}
 
-					if tt.wantPanic {
-						require.Panics(t, func() {
-							_ = tt.meta.Validate(protocolVersion)
-						})
-						return
-					}
-
 					err := tt.meta.Validate(protocolVersion)
 					if tt.wantErr {
 						require.Error(t, err)
@@ -624,15 +603,145 @@ func Test_CustomPrecompiledContractMeta_Bech32_Validate(t *testing.T) {
 	})
 }
 
+func Test_CustomPrecompiledContractMeta_Gov_Validate(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	tests := []struct {
+		name            string
+		meta            CustomPrecompiledContractMeta
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid meta",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  constants.DisplayDenom,
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - valid gov meta, `disabled` is allowed",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  constants.DisplayDenom,
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - meta cannot be empty",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  constants.DisplayDenom,
+				TypedMeta:             "",
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "missing metadata",
+		},
+		{
+			name: "fail - reject invalid gov meta (logic)",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  constants.DisplayDenom,
+				TypedMeta:             "{ }", // has something inside, not allowed
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid metadata for type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for v := uint32(ProtocolCpcV2); v <= uint32(LatestProtocolCpc); v++ {
+				t.Run(fmt.Sprintf("%d", v), func(t *testing.T) {
+					err := tt.meta.Validate(ProtocolCpc(v))
+					if tt.wantErr {
+						require.Error(t, err)
+						require.ErrorContains(t, err, tt.wantErrContains)
+						return
+					}
+
+					require.NoError(t, err)
+				})
+			}
+		})
+	}
+}
+
+func Test_CustomPrecompiledContractMeta_Validate_ProtocolVersion(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	for _, cpcType := range []uint32{
+		CpcTypeGov,
+		CpcTypeDistribution,
+		CpcTypeIbcTransfer,
+		CpcTypeMulticall,
+		CpcTypeSlashing,
+		CpcTypeAuthz,
+		CpcTypeVesting,
+		CpcTypeBank,
+	} {
+		t.Run(fmt.Sprintf("type %d", cpcType), func(t *testing.T) {
+			meta := CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: cpcType,
+				Name:                  "CPC",
+				TypedMeta:             EmptyTypedMeta,
+			}
+
+			require.False(t, ProtocolCpcV1.IsSupportedCustomPrecompiledType(cpcType))
+			err := meta.Validate(ProtocolCpcV1)
+			require.Error(t, err)
+			require.ErrorContains(t, err, "is not supported by protocol version")
+
+			require.True(t, ProtocolCpcV2.IsSupportedCustomPrecompiledType(cpcType))
+			require.NoError(t, meta.Validate(ProtocolCpcV2))
+		})
+	}
+
+	for _, cpcType := range []uint32{CpcTypeErc20, CpcTypeStaking, CpcTypeBech32} {
+		require.True(t, ProtocolCpcV1.IsSupportedCustomPrecompiledType(cpcType))
+		require.True(t, ProtocolCpcV2.IsSupportedCustomPrecompiledType(cpcType))
+	}
+
+	require.False(t, LatestProtocolCpc.IsSupportedCustomPrecompiledType(0))
+	require.False(t, LatestProtocolCpc.IsSupportedCustomPrecompiledType(math.MaxUint32))
+}
+
 func Test_ConstantValues(t *testing.T) {
 	t.Run("CPC types", func(t *testing.T) {
 		require.Equal(t, uint32(1), CpcTypeErc20)
 		require.Equal(t, uint32(2), CpcTypeStaking)
 		require.Equal(t, uint32(3), CpcTypeBech32)
+		require.Equal(t, uint32(4), CpcTypeGov)
+		require.Equal(t, uint32(5), CpcTypeDistribution)
+		require.Equal(t, uint32(6), CpcTypeIbcTransfer)
+		require.Equal(t, uint32(7), CpcTypeMulticall)
+		require.Equal(t, uint32(8), CpcTypeSlashing)
+		require.Equal(t, uint32(9), CpcTypeAuthz)
+		require.Equal(t, uint32(10), CpcTypeVesting)
+		require.Equal(t, uint32(11), CpcTypeBank)
 	})
 
 	t.Run("fixed CPC addresses", func(t *testing.T) {
 		require.Equal(t, common.HexToAddress("0xcc01000000000000000000000000000000000001"), CpcStakingFixedAddress)
 		require.Equal(t, common.HexToAddress("0xcc02000000000000000000000000000000000002"), CpcBech32FixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc03000000000000000000000000000000000003"), CpcGovFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc04000000000000000000000000000000000004"), CpcDistributionFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc05000000000000000000000000000000000005"), CpcIbcTransferFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc06000000000000000000000000000000000006"), CpcMulticallFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc07000000000000000000000000000000000007"), CpcSlashingFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc08000000000000000000000000000000000008"), CpcAuthzFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc09000000000000000000000000000000000009"), CpcVestingFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc0a00000000000000000000000000000000000a"), CpcBankFixedAddress)
 	})
 }
@@ -296,6 +296,197 @@ func (m *QueryCustomPrecompiledContractResponse) GetContract() WrappedCustomPrec
 	return WrappedCustomPrecompiledContractMeta{}
 }
 
+// QueryCustomPrecompiledContractAbiRequest is the request type for the Query/CustomPrecompiledContractAbi RPC
+// method.
+type QueryCustomPrecompiledContractAbiRequest struct {
+	// address is the ethereum hex address to query the custom precompiled contract ABI for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryCustomPrecompiledContractAbiRequest) Reset() {
+	*m = QueryCustomPrecompiledContractAbiRequest{}
+}
+func (m *QueryCustomPrecompiledContractAbiRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCustomPrecompiledContractAbiRequest) ProtoMessage()    {}
+func (*QueryCustomPrecompiledContractAbiRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27930cc1106f6f41, []int{5}
+}
+func (m *QueryCustomPrecompiledContractAbiRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCustomPrecompiledContractAbiRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomPrecompiledContractAbiRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCustomPrecompiledContractAbiRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomPrecompiledContractAbiRequest.Merge(m, src)
+}
+func (m *QueryCustomPrecompiledContractAbiRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCustomPrecompiledContractAbiRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomPrecompiledContractAbiRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCustomPrecompiledContractAbiRequest proto.InternalMessageInfo
+
+func (m *QueryCustomPrecompiledContractAbiRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// CustomPrecompiledContractMethod describes a method supported by a custom precompiled contract.
+type CustomPrecompiledContractMethod struct {
+	// selector is the 0x-prefixed hex of the 4-bytes method signature.
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	// signature is the method signature, eg: `transfer(address,uint256)`.
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// read_only is true if the method does not modify the state.
+	ReadOnly bool `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// required_gas is the static gas required by the method, including the override from the gas schedule in params.
+	RequiredGas uint64 `protobuf:"varint,4,opt,name=required_gas,json=requiredGas,proto3" json:"required_gas,omitempty"`
+}
+
+func (m *CustomPrecompiledContractMethod) Reset()         { *m = CustomPrecompiledContractMethod{} }
+func (m *CustomPrecompiledContractMethod) String() string { return proto.CompactTextString(m) }
+func (*CustomPrecompiledContractMethod) ProtoMessage()    {}
+func (*CustomPrecompiledContractMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27930cc1106f6f41, []int{6}
+}
+func (m *CustomPrecompiledContractMethod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomPrecompiledContractMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomPrecompiledContractMethod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustomPrecompiledContractMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomPrecompiledContractMethod.Merge(m, src)
+}
+func (m *CustomPrecompiledContractMethod) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomPrecompiledContractMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomPrecompiledContractMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomPrecompiledContractMethod proto.InternalMessageInfo
+
+func (m *CustomPrecompiledContractMethod) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *CustomPrecompiledContractMethod) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *CustomPrecompiledContractMethod) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *CustomPrecompiledContractMethod) GetRequiredGas() uint64 {
+	if m != nil {
+		return m.RequiredGas
+	}
+	return 0
+}
+
+// QueryCustomPrecompiledContractAbiResponse is the response type for the Query/CustomPrecompiledContractAbi RPC
+// method.
+type QueryCustomPrecompiledContractAbiResponse struct {
+	// contract is the deployed custom precompiled contract
+	Contract WrappedCustomPrecompiledContractMeta `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract"`
+	// abi is the JSON ABI of the custom precompiled contract.
+	Abi string `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+	// methods is the list of methods supported by the custom precompiled contract.
+	Methods []CustomPrecompiledContractMethod `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods"`
+}
+
+func (m *QueryCustomPrecompiledContractAbiResponse) Reset() {
+	*m = QueryCustomPrecompiledContractAbiResponse{}
+}
+func (m *QueryCustomPrecompiledContractAbiResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCustomPrecompiledContractAbiResponse) ProtoMessage() {}
+func (*QueryCustomPrecompiledContractAbiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27930cc1106f6f41, []int{7}
+}
+func (m *QueryCustomPrecompiledContractAbiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCustomPrecompiledContractAbiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomPrecompiledContractAbiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCustomPrecompiledContractAbiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomPrecompiledContractAbiResponse.Merge(m, src)
+}
+func (m *QueryCustomPrecompiledContractAbiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCustomPrecompiledContractAbiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomPrecompiledContractAbiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCustomPrecompiledContractAbiResponse proto.InternalMessageInfo
+
+func (m *QueryCustomPrecompiledContractAbiResponse) GetContract() WrappedCustomPrecompiledContractMeta {
+	if m != nil {
+		return m.Contract
+	}
+	return WrappedCustomPrecompiledContractMeta{}
+}
+
+func (m *QueryCustomPrecompiledContractAbiResponse) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *QueryCustomPrecompiledContractAbiResponse) GetMethods() []CustomPrecompiledContractMethod {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
 // QueryErc20CustomPrecompiledContractByDenomRequest is the request type for the Query/Erc20CustomPrecompiledContractByDenom RPC
 // method.
 type QueryErc20CustomPrecompiledContractByDenomRequest struct {
@@ -311,7 +502,7 @@ func (m *QueryErc20CustomPrecompiledContractByDenomRequest) String() string {
 }
 func (*QueryErc20CustomPrecompiledContractByDenomRequest) ProtoMessage() {}
 func (*QueryErc20CustomPrecompiledContractByDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_27930cc1106f6f41, []int{5}
+	return fileDescriptor_27930cc1106f6f41, []int{8}
 }
 func (m *QueryErc20CustomPrecompiledContractByDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +552,7 @@ func (m *QueryErc20CustomPrecompiledContractByDenomResponse) String() string {
 }
 func (*QueryErc20CustomPrecompiledContractByDenomResponse) ProtoMessage() {}
 func (*QueryErc20CustomPrecompiledContractByDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_27930cc1106f6f41, []int{6}
+	return fileDescriptor_27930cc1106f6f41, []int{9}
 }
 func (m *QueryErc20CustomPrecompiledContractByDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +596,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_27930cc1106f6f41, []int{7}
+	return fileDescriptor_27930cc1106f6f41, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +635,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_27930cc1106f6f41, []int{8}
+	return fileDescriptor_27930cc1106f6f41, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,6 +677,9 @@ func init() {
 	proto.RegisterType((*QueryCustomPrecompiledContractsResponse)(nil), "everlast.cpc.v1.QueryCustomPrecompiledContractsResponse")
 	proto.RegisterType((*QueryCustomPrecompiledContractRequest)(nil), "everlast.cpc.v1.QueryCustomPrecompiledContractRequest")
 	proto.RegisterType((*QueryCustomPrecompiledContractResponse)(nil), "everlast.cpc.v1.QueryCustomPrecompiledContractResponse")
+	proto.RegisterType((*QueryCustomPrecompiledContractAbiRequest)(nil), "everlast.cpc.v1.QueryCustomPrecompiledContractAbiRequest")
+	proto.RegisterType((*CustomPrecompiledContractMethod)(nil), "everlast.cpc.v1.CustomPrecompiledContractMethod")
+	proto.RegisterType((*QueryCustomPrecompiledContractAbiResponse)(nil), "everlast.cpc.v1.QueryCustomPrecompiledContractAbiResponse")
 	proto.RegisterType((*QueryErc20CustomPrecompiledContractByDenomRequest)(nil), "everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomRequest")
 	proto.RegisterType((*QueryErc20CustomPrecompiledContractByDenomResponse)(nil), "everlast.cpc.v1.QueryErc20CustomPrecompiledContractByDenomResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "everlast.cpc.v1.QueryParamsRequest")
@@ -495,51 +689,61 @@ func init() {
 func init() { proto.RegisterFile("everlast/cpc/v1/query.proto", fileDescriptor_27930cc1106f6f41) }
 
 var fileDescriptor_27930cc1106f6f41 = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0xb3, 0x69, 0x7f, 0xf9, 0x35, 0xd3, 0x83, 0x30, 0x16, 0x9a, 0xa6, 0x9a, 0xd6, 0xb5,
-	0xff, 0x10, 0xdc, 0x31, 0x91, 0xfe, 0x39, 0x09, 0x4d, 0xad, 0x55, 0xf0, 0x4f, 0xcc, 0xa5, 0xe8,
-	0x25, 0x4c, 0x26, 0xc3, 0xba, 0xd0, 0x9d, 0x99, 0xee, 0x4c, 0x82, 0xa1, 0xf4, 0x50, 0xef, 0x82,
-	0xe0, 0xc9, 0x57, 0xe0, 0x3b, 0x91, 0x1e, 0x14, 0x0a, 0x5e, 0x3c, 0x89, 0xb4, 0xbe, 0x10, 0xd9,
-	0xd9, 0xd9, 0xc4, 0x24, 0x4d, 0xda, 0xa5, 0x78, 0xdb, 0x7d, 0x9e, 0x79, 0xbe, 0xf3, 0xfd, 0x3c,
-	0x3b, 0xcf, 0x2c, 0x98, 0xa5, 0x2d, 0x1a, 0xec, 0x61, 0xa9, 0x10, 0x11, 0x04, 0xb5, 0x8a, 0x68,
-	0xbf, 0x49, 0x83, 0xb6, 0x23, 0x02, 0xae, 0x38, 0xbc, 0x16, 0x27, 0x1d, 0x22, 0x88, 0xd3, 0x2a,
-	0xe6, 0xef, 0x10, 0x2e, 0x7d, 0x2e, 0x51, 0x1d, 0x4b, 0x1a, 0xad, 0x44, 0xad, 0x62, 0x9d, 0x2a,
-	0x5c, 0x44, 0x02, 0xbb, 0x1e, 0xc3, 0xca, 0xe3, 0x2c, 0x2a, 0xce, 0x4f, 0xb9, 0xdc, 0xe5, 0xfa,
-	0x11, 0x85, 0x4f, 0x26, 0x7a, 0xc3, 0xe5, 0xdc, 0xdd, 0xa3, 0x08, 0x0b, 0x0f, 0x61, 0xc6, 0xb8,
-	0xd2, 0x25, 0xd2, 0x64, 0x6f, 0xf6, 0xbb, 0x71, 0x29, 0xa3, 0xd2, 0x8b, 0xd3, 0xb7, 0xfa, 0xd3,
-	0x22, 0xa0, 0x84, 0xfb, 0xc2, 0xdb, 0xa3, 0x66, 0x89, 0x2d, 0xc0, 0xd2, 0xcb, 0xd0, 0xd7, 0x56,
-	0x53, 0x2a, 0xee, 0x57, 0x3a, 0xf9, 0xc6, 0x16, 0x67, 0x2a, 0xc0, 0x44, 0xc9, 0x2a, 0xdd, 0x6f,
-	0x52, 0xa9, 0xe0, 0x23, 0x00, 0xba, 0x9e, 0x73, 0xd6, 0xbc, 0xb5, 0x32, 0x59, 0x5a, 0x72, 0x22,
-	0x40, 0x27, 0x04, 0x74, 0xa2, 0x56, 0x18, 0x40, 0xa7, 0x82, 0x5d, 0x6a, 0x6a, 0xab, 0x7f, 0x55,
-	0xda, 0x9f, 0x2d, 0xb0, 0xb0, 0x1b, 0x60, 0x21, 0x68, 0x63, 0xe8, 0xa6, 0xcf, 0xa8, 0xc2, 0x30,
-	0x07, 0xfe, 0xc7, 0x8d, 0x46, 0x40, 0xa5, 0xd4, 0xbb, 0x65, 0xab, 0xf1, 0x2b, 0x9c, 0x05, 0x59,
-	0xd5, 0x16, 0xb4, 0xc6, 0xb0, 0x4f, 0x73, 0x69, 0x9d, 0x9b, 0x08, 0x03, 0xcf, 0xb1, 0x4f, 0xe1,
-	0x63, 0x30, 0xee, 0x53, 0x85, 0x73, 0x63, 0xda, 0xa1, 0xe3, 0xf4, 0x7d, 0x13, 0x67, 0xe4, 0xa6,
-	0xe5, 0xf1, 0xe3, 0x9f, 0x73, 0xa9, 0xaa, 0x56, 0xb0, 0xbf, 0x59, 0x60, 0xf9, 0xc2, 0xe6, 0x48,
-	0xc1, 0x99, 0xa4, 0xf0, 0x15, 0xc8, 0x92, 0x38, 0x98, 0xb3, 0xe6, 0xc7, 0x56, 0x26, 0x4b, 0xab,
-	0x03, 0x5b, 0x5f, 0x06, 0xdb, 0x38, 0xe8, 0xaa, 0xc1, 0x9d, 0x9e, 0xc6, 0xa7, 0x35, 0xd6, 0xf2,
-	0x85, 0x8d, 0x8f, 0x7c, 0xf5, 0x74, 0x7e, 0x13, 0x2c, 0x8e, 0xc6, 0x89, 0x3f, 0xf5, 0xd0, 0xce,
-	0xdb, 0x47, 0xd6, 0x45, 0xe7, 0xa5, 0xd3, 0x91, 0x5d, 0x30, 0x11, 0x33, 0x98, 0xd3, 0x72, 0xa5,
-	0x86, 0x74, 0xc4, 0xec, 0x0a, 0x28, 0x6a, 0x0b, 0xdb, 0x01, 0x29, 0xdd, 0x1b, 0x5a, 0x5a, 0x6e,
-	0x3f, 0xa4, 0x8c, 0xfb, 0x31, 0xd2, 0x2c, 0xc8, 0xfa, 0x1e, 0xab, 0x35, 0xc2, 0x98, 0x81, 0x9a,
-	0xf0, 0x3d, 0xa6, 0xd7, 0xd8, 0xef, 0x2d, 0x50, 0x4a, 0x22, 0xf9, 0xaf, 0x09, 0xa7, 0x00, 0xd4,
-	0x76, 0x2a, 0x38, 0xc0, 0x7e, 0x3c, 0x80, 0xf6, 0x53, 0x70, 0xbd, 0x27, 0x6a, 0x5c, 0xac, 0x82,
-	0x8c, 0xd0, 0x11, 0xe3, 0x61, 0x7a, 0xc0, 0x43, 0x54, 0x60, 0x76, 0x31, 0x8b, 0x4b, 0x9f, 0x32,
-	0xe0, 0x3f, 0x2d, 0x07, 0xbf, 0x58, 0x20, 0x3f, 0xfc, 0x84, 0xc3, 0xf5, 0x01, 0xbd, 0xcb, 0x5d,
-	0x18, 0xf9, 0x8d, 0xe4, 0x85, 0x11, 0x92, 0xbd, 0xfa, 0xee, 0xfb, 0xef, 0x8f, 0x69, 0x04, 0xef,
-	0xa2, 0xfe, 0x0b, 0x8c, 0xe8, 0xe2, 0x5a, 0xf7, 0x1e, 0x6b, 0xd4, 0xba, 0x83, 0xf2, 0xd5, 0x02,
-	0x33, 0x43, 0xd5, 0xe1, 0x5a, 0x42, 0x3b, 0x31, 0xc6, 0x7a, 0xe2, 0x3a, 0x43, 0xf1, 0x40, 0x53,
-	0x6c, 0xc0, 0xb5, 0x24, 0x14, 0xe8, 0xc0, 0x8c, 0xda, 0x21, 0x3c, 0x4a, 0x83, 0xc5, 0x4b, 0x1d,
-	0x48, 0x58, 0x3e, 0xdf, 0x62, 0x92, 0x01, 0xc9, 0x6f, 0x5d, 0x49, 0xc3, 0x20, 0xbf, 0xd0, 0xc8,
-	0x4f, 0xe0, 0xce, 0x00, 0x32, 0x0d, 0x75, 0x6a, 0x23, 0xc0, 0x6b, 0xf5, 0x76, 0x34, 0x9d, 0xe8,
-	0xa0, 0x33, 0xa8, 0x87, 0x50, 0x81, 0x4c, 0x74, 0x7a, 0xe1, 0xed, 0xf3, 0xfd, 0xf5, 0x8c, 0x48,
-	0x7e, 0x61, 0xf4, 0x22, 0xe3, 0x72, 0x4e, 0xbb, 0x9c, 0x81, 0xd3, 0x03, 0x2e, 0xa3, 0xd9, 0x28,
-	0x6f, 0x1e, 0x9f, 0x16, 0xac, 0x93, 0xd3, 0x82, 0xf5, 0xeb, 0xb4, 0x60, 0x7d, 0x38, 0x2b, 0xa4,
-	0x4e, 0xce, 0x0a, 0xa9, 0x1f, 0x67, 0x85, 0xd4, 0xeb, 0x65, 0xd7, 0x53, 0x6f, 0x9a, 0x75, 0x87,
-	0x70, 0x1f, 0x6d, 0x4b, 0x82, 0x59, 0x79, 0xbb, 0x2b, 0xf2, 0x56, 0xcb, 0x84, 0x3f, 0x22, 0x59,
-	0xcf, 0xe8, 0xdf, 0xeb, 0xfd, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe3, 0xb0, 0x55, 0x30,
-	0x08, 0x00, 0x00,
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0xcf, 0x24, 0x61, 0x37, 0x79, 0x8b, 0x04, 0x1a, 0x2a, 0x35, 0xf5, 0x2e, 0xd9, 0xad, 0x69,
+	0xbb, 0x01, 0x09, 0xbb, 0x09, 0xda, 0xb6, 0x54, 0x08, 0x69, 0xb3, 0x5d, 0x16, 0x24, 0xa0, 0x21,
+	0x97, 0x0a, 0x2e, 0xd6, 0xd8, 0x1e, 0x79, 0x2d, 0xc5, 0x1e, 0xaf, 0x67, 0x12, 0x11, 0x55, 0x3d,
+	0x94, 0x3b, 0x12, 0x12, 0x47, 0x3e, 0x00, 0xdf, 0x04, 0xf5, 0x00, 0x52, 0x25, 0x2e, 0x70, 0x41,
+	0x68, 0x97, 0x03, 0x5f, 0x80, 0x3b, 0xf2, 0x78, 0x9c, 0x6c, 0x92, 0xcd, 0x1f, 0x77, 0xb5, 0x37,
+	0xfb, 0xbd, 0x79, 0xbf, 0xf7, 0xfb, 0xbd, 0x79, 0xef, 0xd9, 0xb0, 0x49, 0x07, 0x34, 0xee, 0x11,
+	0x2e, 0x4c, 0x27, 0x72, 0xcc, 0x41, 0xd3, 0x3c, 0xe9, 0xd3, 0x78, 0x68, 0x44, 0x31, 0x13, 0x0c,
+	0xbf, 0x91, 0x39, 0x0d, 0x27, 0x72, 0x8c, 0x41, 0x53, 0x7b, 0xcf, 0x61, 0x3c, 0x60, 0xdc, 0xb4,
+	0x09, 0xa7, 0xe9, 0x49, 0x73, 0xd0, 0xb4, 0xa9, 0x20, 0x4d, 0x33, 0x22, 0x9e, 0x1f, 0x12, 0xe1,
+	0xb3, 0x30, 0x0d, 0xd6, 0xae, 0x79, 0xcc, 0x63, 0xf2, 0xd1, 0x4c, 0x9e, 0x94, 0x75, 0xcb, 0x63,
+	0xcc, 0xeb, 0x51, 0x93, 0x44, 0xbe, 0x49, 0xc2, 0x90, 0x09, 0x19, 0xc2, 0x95, 0xf7, 0xed, 0x69,
+	0x36, 0x1e, 0x0d, 0x29, 0xf7, 0x33, 0xf7, 0xcd, 0x69, 0x77, 0x14, 0x53, 0x87, 0x05, 0x91, 0xdf,
+	0xa3, 0xea, 0x88, 0x1e, 0xc1, 0x9d, 0xaf, 0x12, 0x5e, 0x07, 0x7d, 0x2e, 0x58, 0xd0, 0x19, 0xf9,
+	0xdd, 0x03, 0x16, 0x8a, 0x98, 0x38, 0x82, 0x77, 0xe9, 0x49, 0x9f, 0x72, 0x81, 0x3f, 0x01, 0x18,
+	0x73, 0xae, 0xa1, 0x1d, 0xd4, 0xd8, 0x68, 0xdd, 0x31, 0x52, 0x81, 0x46, 0x22, 0xd0, 0x48, 0x4b,
+	0xa1, 0x04, 0x1a, 0x1d, 0xe2, 0x51, 0x15, 0xdb, 0x3d, 0x17, 0xa9, 0xff, 0x8c, 0xe0, 0xd6, 0x93,
+	0x98, 0x44, 0x11, 0x75, 0xe7, 0x26, 0xfd, 0x82, 0x0a, 0x82, 0x6b, 0xb0, 0x4e, 0x5c, 0x37, 0xa6,
+	0x9c, 0xcb, 0x6c, 0xd5, 0x6e, 0xf6, 0x8a, 0x37, 0xa1, 0x2a, 0x86, 0x11, 0xb5, 0x42, 0x12, 0xd0,
+	0x5a, 0x51, 0xfa, 0x2a, 0x89, 0xe1, 0x4b, 0x12, 0x50, 0xfc, 0x29, 0x94, 0x03, 0x2a, 0x48, 0xad,
+	0x24, 0x19, 0x1a, 0xc6, 0xd4, 0x9d, 0x18, 0x0b, 0x93, 0xb6, 0xcb, 0x2f, 0xfe, 0xda, 0x2e, 0x74,
+	0x25, 0x82, 0xfe, 0x1b, 0x82, 0xdd, 0xa5, 0xc5, 0xe1, 0x11, 0x0b, 0x39, 0xc5, 0x5f, 0x43, 0xd5,
+	0xc9, 0x8c, 0x35, 0xb4, 0x53, 0x6a, 0x6c, 0xb4, 0xf6, 0x66, 0x52, 0xaf, 0x22, 0x5b, 0x31, 0x18,
+	0xa3, 0xe1, 0xa3, 0x89, 0xc2, 0x17, 0xa5, 0xac, 0xdd, 0xa5, 0x85, 0x4f, 0x79, 0x4d, 0x54, 0x7e,
+	0x1f, 0x6e, 0x2f, 0x96, 0x93, 0x5d, 0xf5, 0xdc, 0xca, 0xeb, 0xcf, 0xd1, 0xb2, 0x7e, 0x19, 0x55,
+	0xe4, 0x09, 0x54, 0x32, 0x0d, 0xaa, 0x5b, 0x2e, 0x55, 0x90, 0x11, 0x98, 0xfe, 0x08, 0x1a, 0x8b,
+	0x29, 0xec, 0xdb, 0xfe, 0x72, 0x25, 0x3f, 0x21, 0xd8, 0x5e, 0x94, 0xf7, 0x98, 0xb9, 0x58, 0x83,
+	0x0a, 0xa7, 0x3d, 0xea, 0x08, 0x16, 0xab, 0xf0, 0xd1, 0x3b, 0xde, 0x82, 0x2a, 0xf7, 0xbd, 0x90,
+	0x88, 0x7e, 0x9c, 0xf5, 0xe0, 0xd8, 0x90, 0x74, 0x68, 0x4c, 0x89, 0x6b, 0xb1, 0xb0, 0x37, 0x94,
+	0x9d, 0x58, 0xe9, 0x56, 0x12, 0xc3, 0xe3, 0xb0, 0x37, 0xc4, 0x37, 0xe1, 0xf5, 0x98, 0x9e, 0xf4,
+	0xfd, 0x98, 0xba, 0x96, 0x47, 0x78, 0xad, 0xbc, 0x83, 0x1a, 0xe5, 0xee, 0x46, 0x66, 0x3b, 0x22,
+	0x5c, 0xff, 0x17, 0xc1, 0xbb, 0x2b, 0x88, 0xbc, 0xe2, 0x52, 0xe3, 0x37, 0xa1, 0x44, 0x6c, 0x5f,
+	0xc9, 0x4b, 0x1e, 0x71, 0x07, 0xd6, 0x03, 0x59, 0x1c, 0x5e, 0x2b, 0xc9, 0x2e, 0xbf, 0x9b, 0x6b,
+	0xc0, 0x8e, 0x99, 0xab, 0x92, 0x64, 0x30, 0x7a, 0x07, 0x9a, 0x52, 0xe9, 0x61, 0xec, 0xb4, 0xee,
+	0xce, 0x8d, 0x6d, 0x0f, 0x1f, 0xd1, 0x90, 0x05, 0xd9, 0xbd, 0x6e, 0x42, 0x35, 0xf0, 0x43, 0xcb,
+	0x4d, 0x6c, 0xd9, 0xd5, 0x04, 0x7e, 0x28, 0xcf, 0xe8, 0xdf, 0x23, 0x68, 0xe5, 0x81, 0xbc, 0xea,
+	0x86, 0xbd, 0x06, 0x58, 0xd2, 0xe9, 0x90, 0x98, 0x04, 0xd9, 0x3e, 0xd5, 0x3f, 0x87, 0xb7, 0x26,
+	0xac, 0x8a, 0xc5, 0x1e, 0xac, 0x45, 0xd2, 0xa2, 0x38, 0x5c, 0x9f, 0xe1, 0x90, 0x06, 0xa8, 0x2c,
+	0xea, 0x70, 0xeb, 0xbf, 0x75, 0x78, 0x4d, 0xc2, 0xe1, 0x5f, 0x10, 0x68, 0xf3, 0x17, 0x16, 0xbe,
+	0x3f, 0x83, 0xb7, 0xda, 0xfe, 0xd7, 0x1e, 0xe4, 0x0f, 0x4c, 0x25, 0xe9, 0x7b, 0xdf, 0xfd, 0xfe,
+	0xcf, 0x8f, 0x45, 0x13, 0xbf, 0x6f, 0x4e, 0x7f, 0x8f, 0x1c, 0x19, 0x6c, 0x8d, 0x3f, 0x4b, 0xae,
+	0x35, 0xde, 0x7b, 0xbf, 0x22, 0xb8, 0x31, 0x17, 0x1d, 0xdf, 0xcb, 0x49, 0x27, 0x93, 0x71, 0x3f,
+	0x77, 0x9c, 0x52, 0xf1, 0xb1, 0x54, 0xf1, 0x00, 0xdf, 0xcb, 0xa3, 0xc2, 0x7c, 0xaa, 0xf6, 0xcd,
+	0x33, 0xfc, 0x27, 0x82, 0xad, 0x45, 0xd3, 0x8c, 0x3f, 0xcc, 0xc9, 0x6c, 0xbc, 0xe6, 0xb4, 0x87,
+	0xaf, 0x12, 0xaa, 0x74, 0xb5, 0xa5, 0xae, 0x8f, 0xf0, 0xc3, 0x3c, 0xba, 0x2c, 0x62, 0xfb, 0xe7,
+	0xb4, 0x3d, 0x2f, 0xc2, 0xed, 0x95, 0x86, 0x0d, 0xb7, 0x2f, 0x66, 0x9a, 0x67, 0xf8, 0xb5, 0x83,
+	0x4b, 0x61, 0x28, 0xd9, 0x8f, 0xa5, 0xec, 0xcf, 0xf0, 0xd1, 0x8c, 0x6c, 0x9a, 0xe0, 0x58, 0x8b,
+	0xc4, 0xdb, 0xc3, 0x74, 0xf3, 0x98, 0x4f, 0x47, 0x4b, 0xe8, 0x19, 0x16, 0xb0, 0x96, 0x4e, 0x26,
+	0x7e, 0xe7, 0x62, 0x7e, 0x13, 0xe3, 0xaf, 0xdd, 0x5a, 0x7c, 0x48, 0xb1, 0xdc, 0x96, 0x2c, 0x6f,
+	0xe0, 0xeb, 0x33, 0x2c, 0xd3, 0xb9, 0x6f, 0xef, 0xbf, 0x38, 0xad, 0xa3, 0x97, 0xa7, 0x75, 0xf4,
+	0xf7, 0x69, 0x1d, 0xfd, 0x70, 0x56, 0x2f, 0xbc, 0x3c, 0xab, 0x17, 0xfe, 0x38, 0xab, 0x17, 0xbe,
+	0xd9, 0xf5, 0x7c, 0x71, 0xdc, 0xb7, 0x0d, 0x87, 0x05, 0xe6, 0x21, 0x77, 0x48, 0xd8, 0x3e, 0x1c,
+	0x83, 0x7c, 0x2b, 0x61, 0x92, 0x7f, 0x26, 0x6e, 0xaf, 0xc9, 0x3f, 0xc1, 0x0f, 0xfe, 0x1f, 0x00,
+	0xbd, 0x6d, 0x0f, 0x9e, 0xdb, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -558,6 +762,8 @@ type QueryClient interface {
 	CustomPrecompiledContracts(ctx context.Context, in *QueryCustomPrecompiledContractsRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractsResponse, error)
 	// CustomPrecompiledContract queries the list of deployed custom precompiled contract.
 	CustomPrecompiledContract(ctx context.Context, in *QueryCustomPrecompiledContractRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractResponse, error)
+	// CustomPrecompiledContractAbi queries the ABI and the supported methods of a deployed custom precompiled contract.
+	CustomPrecompiledContractAbi(ctx context.Context, in *QueryCustomPrecompiledContractAbiRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractAbiResponse, error)
 	// Erc20CustomPrecompiledContractByDenom queries the list of deployed custom precompiled contract by denom.
 	Erc20CustomPrecompiledContractByDenom(ctx context.Context, in *QueryErc20CustomPrecompiledContractByDenomRequest, opts ...grpc.CallOption) (*QueryErc20CustomPrecompiledContractByDenomResponse, error)
 	// Params queries the parameters of x/cpc module.
@@ -590,6 +796,15 @@ func (c *queryClient) CustomPrecompiledContract(ctx context.Context, in *QueryCu
 	return out, nil
 }
 
+func (c *queryClient) CustomPrecompiledContractAbi(ctx context.Context, in *QueryCustomPrecompiledContractAbiRequest, opts ...grpc.CallOption) (*QueryCustomPrecompiledContractAbiResponse, error) {
+	out := new(QueryCustomPrecompiledContractAbiResponse)
+	err := c.cc.Invoke(ctx, "/everlast.cpc.v1.Query/CustomPrecompiledContractAbi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Erc20CustomPrecompiledContractByDenom(ctx context.Context, in *QueryErc20CustomPrecompiledContractByDenomRequest, opts ...grpc.CallOption) (*QueryErc20CustomPrecompiledContractByDenomResponse, error) {
 	out := new(QueryErc20CustomPrecompiledContractByDenomResponse)
 	err := c.cc.Invoke(ctx, "/everlast.cpc.v1.Query/Erc20CustomPrecompiledContractByDenom", in, out, opts...)
@@ -614,6 +829,8 @@ type QueryServer interface {
 	CustomPrecompiledContracts(context.Context, *QueryCustomPrecompiledContractsRequest) (*QueryCustomPrecompiledContractsResponse, error)
 	// CustomPrecompiledContract queries the list of deployed custom precompiled contract.
 	CustomPrecompiledContract(context.Context, *QueryCustomPrecompiledContractRequest) (*QueryCustomPrecompiledContractResponse, error)
+	// CustomPrecompiledContractAbi queries the ABI and the supported methods of a deployed custom precompiled contract.
+	CustomPrecompiledContractAbi(context.Context, *QueryCustomPrecompiledContractAbiRequest) (*QueryCustomPrecompiledContractAbiResponse, error)
 	// Erc20CustomPrecompiledContractByDenom queries the list of deployed custom precompiled contract by denom.
 	Erc20CustomPrecompiledContractByDenom(context.Context, *QueryErc20CustomPrecompiledContractByDenomRequest) (*QueryErc20CustomPrecompiledContractByDenomResponse, error)
 	// Params queries the parameters of x/cpc module.
@@ -630,6 +847,9 @@ func (*UnimplementedQueryServer) CustomPrecompiledContracts(ctx context.Context,
 func (*UnimplementedQueryServer) CustomPrecompiledContract(ctx context.Context, req *QueryCustomPrecompiledContractRequest) (*QueryCustomPrecompiledContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CustomPrecompiledContract not implemented")
 }
+func (*UnimplementedQueryServer) CustomPrecompiledContractAbi(ctx context.Context, req *QueryCustomPrecompiledContractAbiRequest) (*QueryCustomPrecompiledContractAbiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomPrecompiledContractAbi not implemented")
+}
 func (*UnimplementedQueryServer) Erc20CustomPrecompiledContractByDenom(ctx context.Context, req *QueryErc20CustomPrecompiledContractByDenomRequest) (*QueryErc20CustomPrecompiledContractByDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Erc20CustomPrecompiledContractByDenom not implemented")
 }
@@ -677,6 +897,24 @@ func _Query_CustomPrecompiledContract_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CustomPrecompiledContractAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCustomPrecompiledContractAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CustomPrecompiledContractAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/everlast.cpc.v1.Query/CustomPrecompiledContractAbi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CustomPrecompiledContractAbi(ctx, req.(*QueryCustomPrecompiledContractAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Erc20CustomPrecompiledContractByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryErc20CustomPrecompiledContractByDenomRequest)
 	if err := dec(in); err != nil {
@@ -725,6 +963,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CustomPrecompiledContract",
 			Handler:    _Query_CustomPrecompiledContract_Handler,
 		},
+		{
+			MethodName: "CustomPrecompiledContractAbi",
+			Handler:    _Query_CustomPrecompiledContractAbi_Handler,
+		},
 		{
 			MethodName: "Erc20CustomPrecompiledContractByDenom",
 			Handler:    _Query_Erc20CustomPrecompiledContractByDenom_Handler,
@@ -932,6 +1174,142 @@ func (m *QueryCustomPrecompiledContractResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCustomPrecompiledContractAbiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCustomPrecompiledContractAbiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomPrecompiledContractAbiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomPrecompiledContractMethod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomPrecompiledContractMethod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomPrecompiledContractMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiredGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequiredGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCustomPrecompiledContractAbiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCustomPrecompiledContractAbiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomPrecompiledContractAbiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryErc20CustomPrecompiledContractByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1137,33 +1515,90 @@ func (m *QueryCustomPrecompiledContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryErc20CustomPrecompiledContractByDenomRequest) Size() (n int) {
+func (m *QueryCustomPrecompiledContractAbiRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MinDenom)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryErc20CustomPrecompiledContractByDenomResponse) Size() (n int) {
+func (m *CustomPrecompiledContractMethod) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Contract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.RequiredGas != 0 {
+		n += 1 + sovQuery(uint64(m.RequiredGas))
+	}
+	return n
+}
+
+func (m *QueryCustomPrecompiledContractAbiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, e := range m.Methods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryErc20CustomPrecompiledContractByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryErc20CustomPrecompiledContractByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1705,6 +2140,390 @@ func (m *QueryCustomPrecompiledContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCustomPrecompiledContractAbiRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCustomPrecompiledContractAbiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCustomPrecompiledContractAbiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomPrecompiledContractMethod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomPrecompiledContractMethod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomPrecompiledContractMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredGas", wireType)
+			}
+			m.RequiredGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCustomPrecompiledContractAbiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCustomPrecompiledContractAbiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCustomPrecompiledContractAbiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, CustomPrecompiledContractMethod{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryErc20CustomPrecompiledContractByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
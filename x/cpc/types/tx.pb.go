@@ -361,6 +361,268 @@ func (m *MsgDeployStakingContractResponse) GetContractAddress() string {
 	return ""
 }
 
+// MsgCreateManagedErc20ContractRequest defines a Msg for creating a new factory denom
+// and deploying the managed ERC20 contract for it.
+type MsgCreateManagedErc20ContractRequest struct {
+	// creator is the address of the creator account, will be the owner of the managed ERC20 contract.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// subdenom is the sub-denom of the new denom, the full denom is in format: factory/{creator}/{subdenom}.
+	Subdenom string `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty"`
+	// name is the name of the ERC20 token.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the symbol of the ERC20 token.
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// decimals is the number of decimals of the ERC20 token.
+	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *MsgCreateManagedErc20ContractRequest) Reset()         { *m = MsgCreateManagedErc20ContractRequest{} }
+func (m *MsgCreateManagedErc20ContractRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateManagedErc20ContractRequest) ProtoMessage()    {}
+func (*MsgCreateManagedErc20ContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a732f798978a056, []int{6}
+}
+func (m *MsgCreateManagedErc20ContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateManagedErc20ContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateManagedErc20ContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateManagedErc20ContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateManagedErc20ContractRequest.Merge(m, src)
+}
+func (m *MsgCreateManagedErc20ContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateManagedErc20ContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateManagedErc20ContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateManagedErc20ContractRequest proto.InternalMessageInfo
+
+func (m *MsgCreateManagedErc20ContractRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateManagedErc20ContractRequest) GetSubdenom() string {
+	if m != nil {
+		return m.Subdenom
+	}
+	return ""
+}
+
+func (m *MsgCreateManagedErc20ContractRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgCreateManagedErc20ContractRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MsgCreateManagedErc20ContractRequest) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// MsgCreateManagedErc20ContractResponse defines the Msg/CreateManagedErc20Contract response type.
+type MsgCreateManagedErc20ContractResponse struct {
+	// denom is the created factory denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// contract_address is the address of the deployed managed ERC20 contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgCreateManagedErc20ContractResponse) Reset()         { *m = MsgCreateManagedErc20ContractResponse{} }
+func (m *MsgCreateManagedErc20ContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateManagedErc20ContractResponse) ProtoMessage()    {}
+func (*MsgCreateManagedErc20ContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a732f798978a056, []int{7}
+}
+func (m *MsgCreateManagedErc20ContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateManagedErc20ContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateManagedErc20ContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateManagedErc20ContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateManagedErc20ContractResponse.Merge(m, src)
+}
+func (m *MsgCreateManagedErc20ContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateManagedErc20ContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateManagedErc20ContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateManagedErc20ContractResponse proto.InternalMessageInfo
+
+func (m *MsgCreateManagedErc20ContractResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgCreateManagedErc20ContractResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgUpdateCustomPrecompiledContract defines a Msg for updating a deployed custom precompiled contract.
+type MsgUpdateCustomPrecompiledContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the hex address of the custom precompiled contract to be updated.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// name is the new name of the contract, keep the current name if empty.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// typed_meta is the new json-encoded type-based metadata of the contract, keep the current metadata if empty.
+	TypedMeta string `protobuf:"bytes,4,opt,name=typed_meta,json=typedMeta,proto3" json:"typed_meta,omitempty"`
+	// disabled is the new value of the disabled flag of the contract.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) Reset()         { *m = MsgUpdateCustomPrecompiledContract{} }
+func (m *MsgUpdateCustomPrecompiledContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCustomPrecompiledContract) ProtoMessage()    {}
+func (*MsgUpdateCustomPrecompiledContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a732f798978a056, []int{8}
+}
+func (m *MsgUpdateCustomPrecompiledContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCustomPrecompiledContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCustomPrecompiledContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCustomPrecompiledContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCustomPrecompiledContract.Merge(m, src)
+}
+func (m *MsgUpdateCustomPrecompiledContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCustomPrecompiledContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCustomPrecompiledContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCustomPrecompiledContract proto.InternalMessageInfo
+
+func (m *MsgUpdateCustomPrecompiledContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) GetTypedMeta() string {
+	if m != nil {
+		return m.TypedMeta
+	}
+	return ""
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+// MsgUpdateCustomPrecompiledContractResponse defines the response structure for executing a
+// MsgUpdateCustomPrecompiledContract message.
+type MsgUpdateCustomPrecompiledContractResponse struct {
+}
+
+func (m *MsgUpdateCustomPrecompiledContractResponse) Reset() {
+	*m = MsgUpdateCustomPrecompiledContractResponse{}
+}
+func (m *MsgUpdateCustomPrecompiledContractResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateCustomPrecompiledContractResponse) ProtoMessage() {}
+func (*MsgUpdateCustomPrecompiledContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a732f798978a056, []int{9}
+}
+func (m *MsgUpdateCustomPrecompiledContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCustomPrecompiledContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCustomPrecompiledContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCustomPrecompiledContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCustomPrecompiledContractResponse.Merge(m, src)
+}
+func (m *MsgUpdateCustomPrecompiledContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCustomPrecompiledContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCustomPrecompiledContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCustomPrecompiledContractResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "everlast.cpc.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "everlast.cpc.v1.MsgUpdateParamsResponse")
@@ -368,46 +630,61 @@ func init() {
 	proto.RegisterType((*MsgDeployErc20ContractResponse)(nil), "everlast.cpc.v1.MsgDeployErc20ContractResponse")
 	proto.RegisterType((*MsgDeployStakingContractRequest)(nil), "everlast.cpc.v1.MsgDeployStakingContractRequest")
 	proto.RegisterType((*MsgDeployStakingContractResponse)(nil), "everlast.cpc.v1.MsgDeployStakingContractResponse")
+	proto.RegisterType((*MsgCreateManagedErc20ContractRequest)(nil), "everlast.cpc.v1.MsgCreateManagedErc20ContractRequest")
+	proto.RegisterType((*MsgCreateManagedErc20ContractResponse)(nil), "everlast.cpc.v1.MsgCreateManagedErc20ContractResponse")
+	proto.RegisterType((*MsgUpdateCustomPrecompiledContract)(nil), "everlast.cpc.v1.MsgUpdateCustomPrecompiledContract")
+	proto.RegisterType((*MsgUpdateCustomPrecompiledContractResponse)(nil), "everlast.cpc.v1.MsgUpdateCustomPrecompiledContractResponse")
 }
 
 func init() { proto.RegisterFile("everlast/cpc/v1/tx.proto", fileDescriptor_6a732f798978a056) }
 
 var fileDescriptor_6a732f798978a056 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0xaf, 0xdb, 0x32, 0x2d, 0xe6, 0x4f, 0x91, 0x19, 0x34, 0x0b, 0x2c, 0x8b, 0x7a, 0xa1, 0x20,
-	0x91, 0xac, 0x45, 0xe2, 0x80, 0xb8, 0xac, 0xac, 0x27, 0x54, 0x09, 0x65, 0xe2, 0xb2, 0x4b, 0xe5,
-	0x3a, 0x96, 0x17, 0x51, 0xdb, 0x21, 0x76, 0xbb, 0x55, 0xe2, 0x04, 0x5f, 0x80, 0x1b, 0x5f, 0x03,
-	0x21, 0x3e, 0xc4, 0x8e, 0x13, 0x17, 0x38, 0x21, 0xd4, 0x1e, 0xf8, 0x1a, 0xa8, 0x49, 0xd6, 0xb2,
-	0xb6, 0x11, 0xeb, 0xcd, 0xef, 0xf7, 0xfe, 0xfd, 0x7e, 0xcf, 0x4f, 0x0f, 0x9a, 0x74, 0x48, 0xe3,
-	0x3e, 0x56, 0xda, 0x23, 0x11, 0xf1, 0x86, 0x0d, 0x4f, 0x9f, 0xba, 0x51, 0x2c, 0xb5, 0x44, 0x95,
-	0x0b, 0x8f, 0x4b, 0x22, 0xe2, 0x0e, 0x1b, 0x56, 0x95, 0x48, 0xc5, 0xa5, 0xf2, 0xb8, 0x62, 0xd3,
-	0x40, 0xae, 0x58, 0x1a, 0x69, 0x6d, 0xa7, 0x8e, 0x6e, 0x62, 0x79, 0xa9, 0x91, 0xb9, 0xb6, 0x98,
-	0x64, 0x32, 0xc5, 0xa7, 0xaf, 0x0c, 0xdd, 0x59, 0x6c, 0xca, 0xa8, 0xa0, 0x2a, 0xcc, 0x92, 0x6a,
-	0x9f, 0x01, 0xac, 0x74, 0x14, 0x7b, 0x13, 0x05, 0x58, 0xd3, 0xd7, 0x38, 0xc6, 0x5c, 0xa1, 0x67,
-	0xd0, 0xc0, 0x03, 0x7d, 0x2c, 0xe3, 0x50, 0x8f, 0x4c, 0xe0, 0x80, 0xba, 0xd1, 0x32, 0xbf, 0x7f,
-	0x7b, 0xb2, 0x95, 0x75, 0xdb, 0x0f, 0x82, 0x98, 0x2a, 0x75, 0xa8, 0xe3, 0x50, 0x30, 0x7f, 0x1e,
-	0x8a, 0x5e, 0x40, 0x28, 0xe8, 0x49, 0x37, 0x4a, 0xaa, 0x98, 0x45, 0x07, 0xd4, 0xaf, 0x37, 0xab,
-	0xee, 0x82, 0x34, 0x37, 0x6d, 0xd2, 0x2a, 0x9f, 0xfd, 0xda, 0x2d, 0xf8, 0x86, 0xa0, 0x27, 0x29,
-	0xf0, 0xfc, 0xd6, 0x87, 0x3f, 0x5f, 0x1e, 0xcf, 0xab, 0xd5, 0xb6, 0x61, 0x75, 0x81, 0x98, 0x4f,
-	0x55, 0x24, 0x85, 0xa2, 0xb5, 0xaf, 0x00, 0xee, 0x74, 0x14, 0x3b, 0xa0, 0x51, 0x5f, 0x8e, 0xda,
-	0x31, 0x69, 0xee, 0xbd, 0x94, 0x42, 0xc7, 0x98, 0x68, 0x9f, 0xbe, 0x1b, 0x50, 0xa5, 0xd1, 0x83,
-	0x25, 0x09, 0xff, 0x12, 0x45, 0xb0, 0x2c, 0x30, 0xa7, 0x09, 0x45, 0xc3, 0x4f, 0xde, 0xe8, 0x1e,
-	0xdc, 0x50, 0x23, 0xde, 0x93, 0x7d, 0xb3, 0x94, 0xa0, 0x99, 0x85, 0x2c, 0xb8, 0x19, 0x50, 0x12,
-	0x72, 0xdc, 0x57, 0x66, 0xd9, 0x01, 0xf5, 0x9b, 0xfe, 0xcc, 0x46, 0xf7, 0xa1, 0xc1, 0x43, 0xd1,
-	0x0d, 0xa8, 0x90, 0xdc, 0xbc, 0x96, 0xa4, 0x6d, 0xf2, 0x50, 0x1c, 0x4c, 0xed, 0x25, 0x3d, 0xaf,
-	0xa0, 0x9d, 0xc7, 0x39, 0x95, 0x85, 0x1e, 0xc1, 0xdb, 0x24, 0xc3, 0xba, 0x38, 0x1d, 0x72, 0xc6,
-	0xbd, 0x72, 0x81, 0x67, 0xb3, 0xaf, 0x7d, 0x04, 0x70, 0x77, 0x56, 0xed, 0x50, 0xe3, 0xb7, 0xa1,
-	0x60, 0xeb, 0xcd, 0x60, 0xae, 0xb7, 0x98, 0xab, 0xb7, 0x74, 0x59, 0xef, 0x92, 0xa4, 0x0e, 0x74,
-	0xf2, 0x49, 0xac, 0x2d, 0xaa, 0xf9, 0xa3, 0x08, 0x4b, 0x1d, 0xc5, 0xd0, 0x11, 0xbc, 0x71, 0x69,
-	0x1f, 0x9d, 0xa5, 0x1d, 0x5a, 0x58, 0x0c, 0xab, 0xfe, 0xbf, 0x88, 0x19, 0x9d, 0x21, 0xbc, 0xb3,
-	0xe2, 0x0b, 0x90, 0xbb, 0xaa, 0x40, 0xfe, 0x7e, 0x59, 0xde, 0x95, 0xe3, 0xb3, 0xbe, 0xef, 0xe1,
-	0xdd, 0x95, 0x73, 0x42, 0x7b, 0xf9, 0x95, 0x56, 0xff, 0xab, 0xd5, 0x58, 0x23, 0x23, 0xed, 0xde,
-	0xda, 0x3f, 0x1b, 0xdb, 0xe0, 0x7c, 0x6c, 0x83, 0xdf, 0x63, 0x1b, 0x7c, 0x9a, 0xd8, 0x85, 0xf3,
-	0x89, 0x5d, 0xf8, 0x39, 0xb1, 0x0b, 0x47, 0x0f, 0x59, 0xa8, 0x8f, 0x07, 0x3d, 0x97, 0x48, 0xee,
-	0xb5, 0x15, 0xc1, 0xa2, 0xd5, 0xf6, 0x66, 0x17, 0xe3, 0x34, 0xb9, 0x19, 0x7a, 0x14, 0x51, 0xd5,
-	0xdb, 0x48, 0xee, 0xc5, 0xd3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x85, 0x6b, 0x63, 0x2a, 0xc5,
-	0x04, 0x00, 0x00,
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xd0, 0x82, 0xf4, 0x89, 0x62, 0x56, 0x94, 0xb2, 0xca, 0xd2, 0x34, 0x1a, 0x2b, 0xd1,
+	0x5d, 0x28, 0x91, 0x83, 0x7a, 0xa1, 0xc0, 0xc9, 0x6c, 0x42, 0x96, 0x78, 0xe1, 0xd2, 0x4c, 0x77,
+	0x27, 0xcb, 0xc6, 0xce, 0xce, 0xba, 0x33, 0x2d, 0x34, 0xf1, 0xa4, 0x47, 0x2f, 0x26, 0x1e, 0xfc,
+	0x1a, 0xc6, 0xf8, 0x21, 0x38, 0x78, 0x20, 0x9e, 0x3c, 0x19, 0x03, 0x89, 0x7e, 0x08, 0x2f, 0x66,
+	0xff, 0x22, 0xed, 0x6e, 0x4a, 0xb9, 0xed, 0x7b, 0xfb, 0xfe, 0xfc, 0x7e, 0xbf, 0x79, 0x6f, 0x06,
+	0x2a, 0xa4, 0x47, 0xfc, 0x0e, 0xe6, 0x42, 0x33, 0x3d, 0x53, 0xeb, 0xad, 0x6a, 0xe2, 0x50, 0xf5,
+	0x7c, 0x26, 0x98, 0x34, 0x9b, 0xfc, 0x51, 0x4d, 0xcf, 0x54, 0x7b, 0xab, 0xf2, 0xbc, 0xc9, 0x38,
+	0x65, 0x5c, 0xa3, 0xdc, 0x0e, 0x02, 0x29, 0xb7, 0xa3, 0x48, 0x79, 0x21, 0xfa, 0xd1, 0x0a, 0x2d,
+	0x2d, 0x32, 0xe2, 0x5f, 0x73, 0x36, 0xb3, 0x59, 0xe4, 0x0f, 0xbe, 0x62, 0xef, 0xe2, 0x60, 0x53,
+	0x9b, 0xb8, 0x84, 0x3b, 0x71, 0x52, 0xed, 0x13, 0x82, 0x59, 0x9d, 0xdb, 0x2f, 0x3d, 0x0b, 0x0b,
+	0xb2, 0x83, 0x7d, 0x4c, 0xb9, 0xb4, 0x0e, 0x65, 0xdc, 0x15, 0xfb, 0xcc, 0x77, 0x44, 0xbf, 0x82,
+	0xaa, 0xa8, 0x5e, 0x6e, 0x56, 0xbe, 0x7f, 0x7d, 0x3c, 0x17, 0x77, 0xdb, 0xb0, 0x2c, 0x9f, 0x70,
+	0xbe, 0x2b, 0x7c, 0xc7, 0xb5, 0x8d, 0xb3, 0x50, 0xe9, 0x39, 0x80, 0x4b, 0x0e, 0x5a, 0x5e, 0x58,
+	0xa5, 0x32, 0x51, 0x45, 0xf5, 0xab, 0x8d, 0x79, 0x75, 0x80, 0x9a, 0x1a, 0x35, 0x69, 0x96, 0x8e,
+	0x7e, 0x2e, 0x15, 0x8c, 0xb2, 0x4b, 0x0e, 0x22, 0xc7, 0xd3, 0xeb, 0x6f, 0xff, 0x7c, 0x5e, 0x3e,
+	0xab, 0x56, 0x5b, 0x80, 0xf9, 0x01, 0x60, 0x06, 0xe1, 0x1e, 0x73, 0x39, 0xa9, 0x7d, 0x41, 0xb0,
+	0xa8, 0x73, 0x7b, 0x8b, 0x78, 0x1d, 0xd6, 0xdf, 0xf6, 0xcd, 0xc6, 0xca, 0x26, 0x73, 0x85, 0x8f,
+	0x4d, 0x61, 0x90, 0xd7, 0x5d, 0xc2, 0x85, 0x74, 0x77, 0x88, 0xc2, 0xff, 0x40, 0x25, 0x28, 0xb9,
+	0x98, 0x92, 0x10, 0x62, 0xd9, 0x08, 0xbf, 0xa5, 0xdb, 0x30, 0xc5, 0xfb, 0xb4, 0xcd, 0x3a, 0x95,
+	0x62, 0xe8, 0x8d, 0x2d, 0x49, 0x86, 0x69, 0x8b, 0x98, 0x0e, 0xc5, 0x1d, 0x5e, 0x29, 0x55, 0x51,
+	0xfd, 0x9a, 0x91, 0xda, 0xd2, 0x1d, 0x28, 0x53, 0xc7, 0x6d, 0x59, 0xc4, 0x65, 0xb4, 0x32, 0x19,
+	0xa6, 0x4d, 0x53, 0xc7, 0xdd, 0x0a, 0xec, 0x21, 0x3e, 0x2f, 0x40, 0xc9, 0xc3, 0x1c, 0xd1, 0x92,
+	0x1e, 0xc2, 0x0d, 0x33, 0xf6, 0xb5, 0x70, 0x24, 0x72, 0x8c, 0x7d, 0x36, 0xf1, 0xc7, 0xda, 0xd7,
+	0xde, 0x21, 0x58, 0x4a, 0xab, 0xed, 0x0a, 0xfc, 0xca, 0x71, 0xed, 0xf1, 0x34, 0x38, 0xe3, 0x3b,
+	0x91, 0xcb, 0xb7, 0x78, 0x9e, 0xef, 0x10, 0x25, 0x1d, 0xaa, 0xf9, 0x20, 0xc6, 0x27, 0xf5, 0x0d,
+	0xc1, 0x3d, 0x9d, 0xdb, 0x9b, 0x3e, 0xc1, 0x82, 0xe8, 0xd8, 0xc5, 0x36, 0xb1, 0x32, 0x4f, 0xb7,
+	0x01, 0x57, 0xcc, 0x20, 0x88, 0xf9, 0x23, 0xc7, 0x33, 0x09, 0x0c, 0x78, 0xf1, 0x6e, 0x3b, 0x3a,
+	0xaa, 0x88, 0x71, 0x6a, 0xa7, 0xf3, 0x50, 0xcc, 0x9c, 0x87, 0x52, 0xae, 0x3e, 0x93, 0x03, 0xfa,
+	0xcc, 0x04, 0xfa, 0x24, 0x1d, 0x6b, 0xfb, 0x70, 0x7f, 0x04, 0x9b, 0x58, 0xa2, 0x39, 0x98, 0x8c,
+	0x70, 0x45, 0xba, 0x44, 0x46, 0xa6, 0x70, 0x13, 0xd9, 0xc2, 0xfd, 0x46, 0x50, 0x4b, 0x77, 0x65,
+	0xb3, 0xcb, 0x05, 0xa3, 0x3b, 0x3e, 0x31, 0x19, 0xf5, 0x9c, 0x0e, 0xb1, 0x92, 0x7e, 0x97, 0xde,
+	0xeb, 0x8b, 0x23, 0xc9, 0x54, 0x72, 0x11, 0x40, 0xf4, 0x3d, 0x62, 0xb5, 0x28, 0x11, 0x38, 0x56,
+	0xb3, 0x1c, 0x7a, 0x74, 0x22, 0x70, 0x28, 0xa8, 0xc3, 0x71, 0xbb, 0x43, 0xac, 0x50, 0xd0, 0x69,
+	0x23, 0xb5, 0x87, 0x06, 0xee, 0x11, 0x2c, 0x8f, 0xe6, 0x99, 0xe8, 0xda, 0xf8, 0x5b, 0x82, 0xa2,
+	0xce, 0x6d, 0x69, 0x0f, 0x66, 0xce, 0xdd, 0x6f, 0xd5, 0xa1, 0x3b, 0x69, 0xe0, 0xa2, 0x91, 0xeb,
+	0xa3, 0x22, 0xd2, 0xb3, 0xeb, 0xc1, 0xcd, 0x8c, 0x95, 0x96, 0xd4, 0xac, 0x02, 0xf9, 0xf7, 0x95,
+	0xac, 0x5d, 0x38, 0x3e, 0xee, 0xfb, 0x06, 0x6e, 0x65, 0xee, 0x9d, 0xb4, 0x92, 0x5f, 0x29, 0xfb,
+	0x9e, 0x90, 0x57, 0xc7, 0xc8, 0x88, 0xbb, 0xbf, 0x47, 0x20, 0xe7, 0x0f, 0xb6, 0xf4, 0x24, 0xab,
+	0xe2, 0xc8, 0xb5, 0x96, 0xd7, 0xc7, 0x4d, 0x8b, 0xd1, 0x7c, 0x44, 0xb0, 0x34, 0x6a, 0xf6, 0xd7,
+	0xf2, 0x4f, 0x34, 0x37, 0x49, 0x7e, 0x76, 0x89, 0xa4, 0x04, 0x55, 0x73, 0xe3, 0xe8, 0x44, 0x41,
+	0xc7, 0x27, 0x0a, 0xfa, 0x75, 0xa2, 0xa0, 0x0f, 0xa7, 0x4a, 0xe1, 0xf8, 0x54, 0x29, 0xfc, 0x38,
+	0x55, 0x0a, 0x7b, 0x0f, 0x6c, 0x47, 0xec, 0x77, 0xdb, 0xaa, 0xc9, 0xa8, 0xb6, 0xcd, 0x4d, 0xec,
+	0x36, 0xb7, 0xb5, 0xf4, 0x95, 0x3e, 0x0c, 0xdf, 0xe9, 0x60, 0x3d, 0x78, 0x7b, 0x2a, 0x7c, 0xa3,
+	0xd7, 0xfe, 0x0d, 0x00, 0x1c, 0x1b, 0xdd, 0xee, 0x39, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -429,6 +706,13 @@ type MsgClient interface {
 	DeployErc20Contract(ctx context.Context, in *MsgDeployErc20ContractRequest, opts ...grpc.CallOption) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(ctx context.Context, in *MsgDeployStakingContractRequest, opts ...grpc.CallOption) (*MsgDeployStakingContractResponse, error)
+	// CreateManagedErc20Contract defines a method creating a new factory denom
+	// and deploying the managed ERC20 contract for it, owned by the creator.
+	CreateManagedErc20Contract(ctx context.Context, in *MsgCreateManagedErc20ContractRequest, opts ...grpc.CallOption) (*MsgCreateManagedErc20ContractResponse, error)
+	// UpdateCustomPrecompiledContract defined a governance operation for updating
+	// the name, typed metadata and the disabled flag of a deployed custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateCustomPrecompiledContract(ctx context.Context, in *MsgUpdateCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgUpdateCustomPrecompiledContractResponse, error)
 }
 
 type msgClient struct {
@@ -466,6 +750,24 @@ func (c *msgClient) DeployStakingContract(ctx context.Context, in *MsgDeployStak
 	return out, nil
 }
 
+func (c *msgClient) CreateManagedErc20Contract(ctx context.Context, in *MsgCreateManagedErc20ContractRequest, opts ...grpc.CallOption) (*MsgCreateManagedErc20ContractResponse, error) {
+	out := new(MsgCreateManagedErc20ContractResponse)
+	err := c.cc.Invoke(ctx, "/everlast.cpc.v1.Msg/CreateManagedErc20Contract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCustomPrecompiledContract(ctx context.Context, in *MsgUpdateCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgUpdateCustomPrecompiledContractResponse, error) {
+	out := new(MsgUpdateCustomPrecompiledContractResponse)
+	err := c.cc.Invoke(ctx, "/everlast.cpc.v1.Msg/UpdateCustomPrecompiledContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/cpc module parameters.
@@ -475,6 +777,13 @@ type MsgServer interface {
 	DeployErc20Contract(context.Context, *MsgDeployErc20ContractRequest) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(context.Context, *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error)
+	// CreateManagedErc20Contract defines a method creating a new factory denom
+	// and deploying the managed ERC20 contract for it, owned by the creator.
+	CreateManagedErc20Contract(context.Context, *MsgCreateManagedErc20ContractRequest) (*MsgCreateManagedErc20ContractResponse, error)
+	// UpdateCustomPrecompiledContract defined a governance operation for updating
+	// the name, typed metadata and the disabled flag of a deployed custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateCustomPrecompiledContract(context.Context, *MsgUpdateCustomPrecompiledContract) (*MsgUpdateCustomPrecompiledContractResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -490,6 +799,12 @@ func (*UnimplementedMsgServer) DeployErc20Contract(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) DeployStakingContract(ctx context.Context, req *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployStakingContract not implemented")
 }
+func (*UnimplementedMsgServer) CreateManagedErc20Contract(ctx context.Context, req *MsgCreateManagedErc20ContractRequest) (*MsgCreateManagedErc20ContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateManagedErc20Contract not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCustomPrecompiledContract(ctx context.Context, req *MsgUpdateCustomPrecompiledContract) (*MsgUpdateCustomPrecompiledContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomPrecompiledContract not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -549,6 +864,42 @@ func _Msg_DeployStakingContract_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateManagedErc20Contract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateManagedErc20ContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateManagedErc20Contract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/everlast.cpc.v1.Msg/CreateManagedErc20Contract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateManagedErc20Contract(ctx, req.(*MsgCreateManagedErc20ContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCustomPrecompiledContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCustomPrecompiledContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCustomPrecompiledContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/everlast.cpc.v1.Msg/UpdateCustomPrecompiledContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCustomPrecompiledContract(ctx, req.(*MsgUpdateCustomPrecompiledContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "everlast.cpc.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -565,6 +916,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeployStakingContract",
 			Handler:    _Msg_DeployStakingContract_Handler,
 		},
+		{
+			MethodName: "CreateManagedErc20Contract",
+			Handler:    _Msg_CreateManagedErc20Contract_Handler,
+		},
+		{
+			MethodName: "UpdateCustomPrecompiledContract",
+			Handler:    _Msg_UpdateCustomPrecompiledContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "everlast/cpc/v1/tx.proto",
@@ -791,59 +1150,236 @@ func (m *MsgDeployStakingContractResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateManagedErc20ContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.NewParams.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateManagedErc20ContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeployErc20ContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateManagedErc20ContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateManagedErc20ContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateManagedErc20ContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateManagedErc20ContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TypedMeta) > 0 {
+		i -= len(m.TypedMeta)
+		copy(dAtA[i:], m.TypedMeta)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TypedMeta)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCustomPrecompiledContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCustomPrecompiledContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCustomPrecompiledContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeployErc20ContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if m.Decimals != 0 {
 		n += 1 + sovTx(uint64(m.Decimals))
 	}
@@ -900,6 +1436,88 @@ func (m *MsgDeployStakingContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateManagedErc20ContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *MsgCreateManagedErc20ContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCustomPrecompiledContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TypedMeta)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateCustomPrecompiledContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -967,11 +1585,487 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployStakingContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployStakingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var msglen int
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -981,25 +2075,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Decimals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1021,7 +2101,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeployStakingContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,12 +2124,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeployStakingContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeployStakingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1071,7 +2183,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateManagedErc20ContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1094,15 +2206,15 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateManagedErc20ContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateManagedErc20ContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1130,11 +2242,11 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1162,11 +2274,11 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1194,30 +2306,11 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1245,8 +2338,27 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDenom = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1268,7 +2380,7 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateManagedErc20ContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,13 +2403,45 @@ func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateManagedErc20ContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateManagedErc20ContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -1350,7 +2494,7 @@ func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCustomPrecompiledContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1373,10 +2517,10 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployStakingContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCustomPrecompiledContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployStakingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCustomPrecompiledContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1413,7 +2557,7 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1441,13 +2585,77 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedMeta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypedMeta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
-			m.Decimals = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1457,11 +2665,12 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1483,7 +2692,7 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployStakingContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCustomPrecompiledContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1506,44 +2715,12 @@ func (m *MsgDeployStakingContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployStakingContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCustomPrecompiledContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployStakingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCustomPrecompiledContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -28,6 +28,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	corevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	evertypes "github.com/EscanBE/everlast/types"
@@ -60,10 +61,16 @@ func (k Keeper) Account(c context.Context, req *evmtypes.QueryAccountRequest) (*
 		nonce = acc.GetSequence()
 	}
 
+	codeHash := k.GetCodeHash(ctx, addr.Bytes())
+	if k.IsCustomPrecompiledContractCodeEnabled(ctx) && k.IsEnabledCustomPrecompiledContract(ctx, addr) {
+		// consistent with the code hash inside the EVM
+		codeHash = cpctypes.PseudoCodePrecompiledHash
+	}
+
 	return &evmtypes.QueryAccountResponse{
 		Nonce:    nonce,
 		Balance:  k.GetBalance(ctx, addr).String(),
-		CodeHash: k.GetCodeHash(ctx, addr.Bytes()).Hex(),
+		CodeHash: codeHash.Hex(),
 	}, nil
 }
 
@@ -243,6 +250,12 @@ func (k Keeper) EthCall(c context.Context, req *evmtypes.EthCallRequest) (*evmty
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	cfg, err := k.EVMConfig(ctx, nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -288,6 +301,11 @@ func (k Keeper) EstimateGas(c context.Context, req *evmtypes.EthCallRequest) (*e
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -392,6 +410,97 @@ func (k Keeper) EstimateGas(c context.Context, req *evmtypes.EthCallRequest) (*e
 	return &evmtypes.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api.
+// It runs the call with the access list tracer repeatedly, every run uses the access list collected by the previous one,
+// until the access list stops changing.
+func (k Keeper) CreateAccessList(c context.Context, req *evmtypes.EthCallRequest) (*evmtypes.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	var args evmtypes.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	cfg.NoBaseFee = true
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	from := args.GetFrom()
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+
+	// the precompiled contracts, including the custom precompiled contracts,
+	// are always warm so no need to be included in the access list
+	const mergeNetsplit = true
+	precompiles := corevm.ActivePrecompiles(cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), mergeNetsplit))
+	for _, contract := range k.cpcKeeper.GetAllCustomPrecompiledContracts(ctx) {
+		precompiles = append(precompiles, common.BytesToAddress(contract.GetMetadata().Address))
+	}
+
+	var prevTracer *logger.AccessListTracer
+	if args.AccessList != nil {
+		prevTracer = logger.NewAccessListTracer(*args.AccessList, from, to, precompiles)
+	} else {
+		prevTracer = logger.NewAccessListTracer(nil, from, to, precompiles)
+	}
+
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		txConfig := k.NewTxConfigFromMessage(ctx, msg)
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			bzAccessList, err := json.Marshal(accessList)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			return &evmtypes.CreateAccessListResponse{
+				AccessList: bzAccessList,
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -547,6 +656,89 @@ func (k Keeper) TraceBlock(c context.Context, req *evmtypes.QueryTraceBlockReque
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call, which does not need to be signed, on top of the provided block.
+// The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *evmtypes.QueryTraceCallRequest) (*evmtypes.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args evmtypes.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// use the context of required context block
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value, means latest block
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	ctx, err := k.applyStateOverrides(ctx, req.StateOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, req.ProposerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	cfg.NoBaseFee = true
+
+	cfg.BaseFee = k.feeMarketKeeper.GetBaseFee(ctx).BigInt()
+
+	ctx, err = k.applyBlockOverrides(ctx, cfg, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := k.NewTxConfigFromMessage(ctx, msg)
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	ctx = ctx.WithGasMeter(evertypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+	// pass false to not commit StateDB
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evmtypes.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -557,6 +749,25 @@ func (k *Keeper) traceTx(
 	traceConfig *evmtypes.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, errorsmod.Wrapf(err, "failed to convert tx %s to message", ethTx.Hash().Hex()).Error())
+	}
+
+	ctx = k.SetupExecutionContext(ctx, ethTx)
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *evmvm.EVMConfig,
+	txConfig evmvm.TxConfig,
+	msg core.Message,
+	traceConfig *evmtypes.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -566,11 +777,6 @@ func (k *Keeper) traceTx(
 		timeout   = defaultTraceTimeout
 	)
 
-	msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, errorsmod.Wrapf(err, "failed to convert tx %s to message", ethTx.Hash().Hex()).Error())
-	}
-
 	if traceConfig == nil {
 		traceConfig = &evmtypes.TraceConfig{}
 	}
@@ -621,7 +827,6 @@ func (k *Keeper) traceTx(
 		}
 	}()
 
-	ctx = k.SetupExecutionContext(ctx, ethTx)
 	res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, commitMessage, cfg, txConfig)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
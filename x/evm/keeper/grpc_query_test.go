@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	evmvm "github.com/EscanBE/everlast/x/evm/vm"
 
@@ -23,6 +24,7 @@ import (
 
 	"github.com/EscanBE/everlast/server/config"
 	utiltx "github.com/EscanBE/everlast/testutil/tx"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	evmtypes "github.com/EscanBE/everlast/x/evm/types"
 )
 
@@ -74,6 +76,20 @@ func (suite *KeeperTestSuite) TestQueryAccount() {
 			},
 			expPass: true,
 		},
+		{
+			name: "pass - custom precompiled contract has pseudo code hash",
+			malleate: func() {
+				expAccount = &evmtypes.QueryAccountResponse{
+					Balance:  "0",
+					CodeHash: cpctypes.PseudoCodePrecompiledHash.Hex(),
+					Nonce:    0,
+				}
+				req = &evmtypes.QueryAccountRequest{
+					Address: cpctypes.CpcBankFixedAddress.String(),
+				}
+			},
+			expPass: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1021,6 +1037,234 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var (
+		args           evmtypes.TransactionArgs
+		traceConfig    *evmtypes.TraceConfig
+		stateOverrides []byte
+		blockOverrides []byte
+		contractAddr   common.Address
+	)
+
+	receiver := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	transferData, err := evmtypes.ERC20Contract.ABI.Pack("transfer", receiver, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expPass       bool
+		expErrMsg     string
+		traceResponse string
+		postCheck     func(data []byte)
+	}{
+		{
+			name: "pass - default trace",
+			malleate: func() {
+			},
+			expPass:       true,
+			traceResponse: "{\"gas\":51928,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
+		},
+		{
+			name: "pass - trace reverted call",
+			malleate: func() {
+				from := utiltx.GenerateAddress()
+				args.From = &from
+			},
+			expPass: true,
+			postCheck: func(data []byte) {
+				var result ethlogger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.True(result.Failed)
+				suite.NotEmpty(result.StructLogs)
+				suite.Equal("REVERT", result.StructLogs[len(result.StructLogs)-1].Op)
+			},
+		},
+		{
+			name: "pass - named tracer with tracer config",
+			malleate: func() {
+				traceConfig = &evmtypes.TraceConfig{
+					Tracer:           "callTracer",
+					TracerJsonConfig: `{"onlyTopCall":true}`,
+				}
+			},
+			expPass: true,
+			postCheck: func(data []byte) {
+				var result map[string]any
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Equal("CALL", result["type"])
+				suite.Equal(strings.ToLower(contractAddr.String()), result["to"])
+				suite.Equal("0x0000000000000000000000000000000000000000000000000000000000000001", result["output"])
+			},
+		},
+		{
+			name: "pass - javascript tracer",
+			malleate: func() {
+				traceConfig = &evmtypes.TraceConfig{
+					Tracer: "{data: [], fault: function(log) {}, step: function(log) { if(log.op.toString() == \"CALL\") this.data.push(log.stack.peek(0)); }, result: function() { return this.data; }}",
+				}
+			},
+			expPass:       true,
+			traceResponse: "[]",
+		},
+		{
+			name: "pass - with state overrides",
+			malleate: func() {
+				// returns the value of storage slot 0
+				code := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+				var err error
+				stateOverrides, err = json.Marshal(evmtypes.StateOverride{
+					contractAddr: {
+						Code: &code,
+						StateDiff: &map[common.Hash]common.Hash{
+							{}: common.BigToHash(big.NewInt(42)),
+						},
+					},
+				})
+				suite.Require().NoError(err)
+			},
+			expPass: true,
+			postCheck: func(data []byte) {
+				var result ethlogger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.False(result.Failed)
+				suite.Equal(common.BigToHash(big.NewInt(42)).Hex()[2:], result.ReturnValue)
+			},
+		},
+		{
+			name: "pass - with block overrides",
+			malleate: func() {
+				// returns the block number
+				code := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+				var err error
+				stateOverrides, err = json.Marshal(evmtypes.StateOverride{
+					contractAddr: {Code: &code},
+				})
+				suite.Require().NoError(err)
+
+				blockOverrides = []byte(`{"number":"0x3e8"}`)
+			},
+			expPass: true,
+			postCheck: func(data []byte) {
+				var result ethlogger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.False(result.Failed)
+				suite.Equal(common.BigToHash(big.NewInt(1000)).Hex()[2:], result.ReturnValue)
+			},
+		},
+		{
+			name: "fail - invalid trace config - Negative Limit",
+			malleate: func() {
+				traceConfig = &evmtypes.TraceConfig{
+					Limit: -1,
+				}
+			},
+			expPass:   false,
+			expErrMsg: "output limit cannot be negative",
+		},
+		{
+			name: "fail - invalid trace config - Invalid Tracer",
+			malleate: func() {
+				traceConfig = &evmtypes.TraceConfig{
+					Tracer: "invalid_tracer",
+				}
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - invalid trace config - Invalid Timeout",
+			malleate: func() {
+				traceConfig = &evmtypes.TraceConfig{
+					Timeout: "wrong_time",
+				}
+			},
+			expPass:   false,
+			expErrMsg: "timeout value",
+		},
+		{
+			name: "fail - invalid state overrides",
+			malleate: func() {
+				stateOverrides = []byte("invalid")
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - invalid block overrides",
+			malleate: func() {
+				blockOverrides = []byte(`{"number":"0x0"}`)
+			},
+			expPass:   false,
+			expErrMsg: "invalid block number override",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+
+			args = evmtypes.TransactionArgs{
+				From: &suite.address,
+				To:   &contractAddr,
+				Data: (*hexutil.Bytes)(&transferData),
+			}
+			traceConfig = nil
+			stateOverrides = nil
+			blockOverrides = nil
+
+			tc.malleate()
+
+			bzArgs, err := json.Marshal(&args)
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.TraceCall(suite.ctx, &evmtypes.QueryTraceCallRequest{
+				Args:           bzArgs,
+				GasCap:         config.DefaultGasCap,
+				TraceConfig:    traceConfig,
+				BlockNumber:    suite.ctx.BlockHeight(),
+				BlockTime:      suite.ctx.BlockTime(),
+				StateOverrides: stateOverrides,
+				BlockOverrides: blockOverrides,
+			})
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				if tc.expErrMsg != "" {
+					suite.Require().ErrorContains(err, tc.expErrMsg)
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			if tc.traceResponse != "" {
+				// if data is to big, slice the result
+				if len(res.Data) > 150 {
+					suite.Require().Equal(tc.traceResponse, string(res.Data[:150]))
+				} else {
+					suite.Require().Equal(tc.traceResponse, string(res.Data))
+				}
+			}
+			if tc.postCheck != nil {
+				tc.postCheck(res.Data)
+			}
+
+			// call must not be committed
+			balanceOfData, err := evmtypes.ERC20Contract.ABI.Pack("balanceOf", receiver)
+			suite.Require().NoError(err)
+			bzArgs, err = json.Marshal(&evmtypes.TransactionArgs{
+				To:   &contractAddr,
+				Data: (*hexutil.Bytes)(&balanceOfData),
+			})
+			suite.Require().NoError(err)
+			resBalance, err := suite.queryClient.EthCall(suite.ctx, &evmtypes.EthCallRequest{Args: bzArgs, GasCap: config.DefaultGasCap})
+			suite.Require().NoError(err)
+			suite.Zero(new(big.Int).SetBytes(resBalance.Ret).Sign())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs               []*evmtypes.MsgEthereumTx
@@ -1387,6 +1631,298 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallWithStateOverrides() {
+	// returns the value of storage slot 0
+	codeReturnsSlot0 := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	// returns the balance of the contract itself
+	codeReturnsSelfBalance := hexutil.Bytes(common.FromHex("0x4760005260206000f3"))
+
+	slot0 := common.Hash{}
+	slot1 := common.BigToHash(big.NewInt(1))
+
+	var contract common.Address
+
+	testCases := []struct {
+		name      string
+		malleate  func() evmtypes.StateOverride
+		expPass   bool
+		expErrMsg string
+		expRet    *big.Int
+	}{
+		{
+			name: "pass - without overrides, no code to execute",
+			malleate: func() evmtypes.StateOverride {
+				return nil
+			},
+			expPass: true,
+			expRet:  nil,
+		},
+		{
+			name: "pass - override code and state diff",
+			malleate: func() evmtypes.StateOverride {
+				return evmtypes.StateOverride{
+					contract: {
+						Code:      &codeReturnsSlot0,
+						StateDiff: &map[common.Hash]common.Hash{slot0: common.BigToHash(big.NewInt(42))},
+					},
+				}
+			},
+			expPass: true,
+			expRet:  big.NewInt(42),
+		},
+		{
+			name: "pass - override state replaces the entire storage",
+			malleate: func() evmtypes.StateOverride {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, slot0, common.BigToHash(big.NewInt(7)).Bytes())
+
+				return evmtypes.StateOverride{
+					contract: {
+						Code:  &codeReturnsSlot0,
+						State: &map[common.Hash]common.Hash{slot1: common.BigToHash(big.NewInt(1))},
+					},
+				}
+			},
+			expPass: true,
+			expRet:  big.NewInt(0),
+		},
+		{
+			name: "pass - override state diff keeps the other storage slots",
+			malleate: func() evmtypes.StateOverride {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, slot0, common.BigToHash(big.NewInt(7)).Bytes())
+
+				return evmtypes.StateOverride{
+					contract: {
+						Code:      &codeReturnsSlot0,
+						StateDiff: &map[common.Hash]common.Hash{slot1: common.BigToHash(big.NewInt(1))},
+					},
+				}
+			},
+			expPass: true,
+			expRet:  big.NewInt(7),
+		},
+		{
+			name: "pass - override balance",
+			malleate: func() evmtypes.StateOverride {
+				balance := (*hexutil.Big)(big.NewInt(1234))
+				return evmtypes.StateOverride{
+					contract: {
+						Code:    &codeReturnsSelfBalance,
+						Balance: &balance,
+					},
+				}
+			},
+			expPass: true,
+			expRet:  big.NewInt(1234),
+		},
+		{
+			name: "fail - both state and state diff",
+			malleate: func() evmtypes.StateOverride {
+				return evmtypes.StateOverride{
+					contract: {
+						State:     &map[common.Hash]common.Hash{},
+						StateDiff: &map[common.Hash]common.Hash{},
+					},
+				}
+			},
+			expPass:   false,
+			expErrMsg: "has both 'state' and 'stateDiff'",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract = utiltx.GenerateAddress()
+
+			overrides := tc.malleate()
+
+			args, err := json.Marshal(&evmtypes.TransactionArgs{
+				From: &suite.address,
+				To:   &contract,
+			})
+			suite.Require().NoError(err)
+
+			req := &evmtypes.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			if overrides != nil {
+				req.Overrides, err = json.Marshal(overrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			if tc.expRet == nil {
+				suite.Empty(res.Ret)
+			} else {
+				suite.Equal(tc.expRet.String(), new(big.Int).SetBytes(res.Ret).String())
+			}
+
+			// overrides must not be persisted
+			suite.Empty(suite.app.EvmKeeper.GetCode(suite.ctx, suite.app.EvmKeeper.GetCodeHash(suite.ctx, contract.Bytes())))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasWithStateOverrides() {
+	suite.SetupTest()
+
+	contract := utiltx.GenerateAddress()
+	// store 1 into storage slot 0
+	code := hexutil.Bytes(common.FromHex("0x600160005500"))
+
+	args, err := json.Marshal(&evmtypes.TransactionArgs{
+		From: &suite.address,
+		To:   &contract,
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EstimateGas(suite.ctx, &evmtypes.EthCallRequest{
+		Args:   args,
+		GasCap: config.DefaultGasCap,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(ethparams.TxGas, res.Gas)
+
+	overrides, err := json.Marshal(evmtypes.StateOverride{
+		contract: {Code: &code},
+	})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.EstimateGas(suite.ctx, &evmtypes.EthCallRequest{
+		Args:      args,
+		GasCap:    config.DefaultGasCap,
+		Overrides: overrides,
+	})
+	suite.Require().NoError(err)
+	suite.Greater(res.Gas, ethparams.TxGas+ethparams.SstoreSetGasEIP2200)
+
+	_, err = suite.queryClient.EstimateGas(suite.ctx, &evmtypes.EthCallRequest{
+		Args:      args,
+		GasCap:    config.DefaultGasCap,
+		Overrides: []byte("invalid"),
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	suite.SetupTest()
+
+	contract := utiltx.GenerateAddress()
+	another := utiltx.GenerateAddress()
+	ecRecover := common.BytesToAddress([]byte{1})
+
+	// query balance of another account, a precompiled contract and a custom precompiled contract
+	// then load storage slot 0
+	var code hexutil.Bytes
+	for _, addr := range []common.Address{another, ecRecover, cpctypes.CpcBankFixedAddress} {
+		code = append(code, byte(corevm.PUSH20))
+		code = append(code, addr.Bytes()...)
+		code = append(code, byte(corevm.BALANCE), byte(corevm.POP))
+	}
+	code = append(code, common.FromHex("0x6000545000")...)
+
+	overrides, err := json.Marshal(evmtypes.StateOverride{
+		contract: {Code: &code},
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		args          evmtypes.TransactionArgs
+		overrides     []byte
+		expAccessList ethtypes.AccessList
+		expPass       bool
+	}{
+		{
+			name: "pass - transfer does not need access list",
+			args: evmtypes.TransactionArgs{
+				From: &suite.address,
+				To:   &another,
+			},
+			expAccessList: ethtypes.AccessList{},
+			expPass:       true,
+		},
+		{
+			name: "pass - precompiled contracts are excluded",
+			args: evmtypes.TransactionArgs{
+				From: &suite.address,
+				To:   &contract,
+			},
+			overrides: overrides,
+			expAccessList: ethtypes.AccessList{
+				{Address: contract, StorageKeys: []common.Hash{{}}},
+				{Address: another, StorageKeys: []common.Hash{}},
+			},
+			expPass: true,
+		},
+		{
+			name: "pass - provided access list is kept",
+			args: evmtypes.TransactionArgs{
+				From: &suite.address,
+				To:   &contract,
+				AccessList: &ethtypes.AccessList{
+					{Address: another, StorageKeys: []common.Hash{common.BytesToHash([]byte{1})}},
+				},
+			},
+			overrides: overrides,
+			expAccessList: ethtypes.AccessList{
+				{Address: contract, StorageKeys: []common.Hash{{}}},
+				{Address: another, StorageKeys: []common.Hash{common.BytesToHash([]byte{1})}},
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - invalid state overrides",
+			args: evmtypes.TransactionArgs{
+				From: &suite.address,
+				To:   &contract,
+			},
+			overrides: []byte("invalid"),
+			expPass:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			args, err := json.Marshal(&tc.args)
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.CreateAccessList(suite.ctx, &evmtypes.EthCallRequest{
+				Args:      args,
+				GasCap:    config.DefaultGasCap,
+				Overrides: tc.overrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Empty(res.VmError)
+
+			var accessList ethtypes.AccessList
+			suite.Require().NoError(json.Unmarshal(res.AccessList, &accessList))
+			suite.ElementsMatch(tc.expAccessList, accessList)
+
+			// gas used is the same as when calling with the generated access list
+			tc.args.AccessList = &accessList
+			args, err = json.Marshal(&tc.args)
+			suite.Require().NoError(err)
+
+			callRes, err := suite.queryClient.EthCall(suite.ctx, &evmtypes.EthCallRequest{
+				Args:      args,
+				GasCap:    config.DefaultGasCap,
+				Overrides: tc.overrides,
+			})
+			suite.Require().NoError(err)
+			suite.Equal(callRes.GasUsed, res.GasUsed)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1442,6 +1978,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.ctx, nil)
 			},
 		},
+		{
+			name: "CreateAccessList method",
+			queryFunc: func() (interface{}, error) {
+				return k.CreateAccessList(suite.ctx, nil)
+			},
+		},
 		{
 			name: "TraceTx method",
 			queryFunc: func() (interface{}, error) {
@@ -1454,6 +1996,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			name: "TraceCall method",
+			queryFunc: func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
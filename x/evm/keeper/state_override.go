@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/EscanBE/everlast/x/evm/types"
+	evmvm "github.com/EscanBE/everlast/x/evm/vm"
+)
+
+// applyStateOverrides applies the JSON-encoded state overrides, provided by eth_call and eth_estimateGas,
+// on a branch of the given context and returns the branched context.
+// The changes are never written back to the given context.
+func (k Keeper) applyStateOverrides(ctx sdk.Context, bzOverrides []byte) (overriddenCtx sdk.Context, err error) {
+	if len(bzOverrides) == 0 {
+		return ctx, nil
+	}
+
+	var overrides evmtypes.StateOverride
+	if err := json.Unmarshal(bzOverrides, &overrides); err != nil {
+		return ctx, err
+	}
+	if err := overrides.Validate(); err != nil {
+		return ctx, err
+	}
+
+	overriddenCtx, _ = ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to apply state overrides: %v", r)
+		}
+	}()
+
+	stateDB := evmvm.NewStateDB(overriddenCtx, common.Address{}, &k, k.accountKeeper, k.bankKeeper)
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+
+		if account.Balance != nil && *account.Balance != nil {
+			balance := (*account.Balance).ToInt()
+			diff := new(big.Int).Sub(balance, stateDB.GetBalance(addr))
+			if diff.Sign() > 0 {
+				stateDB.AddBalance(addr, diff)
+			} else if diff.Sign() < 0 {
+				stateDB.SubBalance(addr, new(big.Int).Neg(diff))
+			}
+		}
+
+		if account.State != nil {
+			// replace the entire storage
+			var existingKeys []common.Hash
+			_ = stateDB.ForEachStorage(addr, func(key, _ common.Hash) bool {
+				existingKeys = append(existingKeys, key)
+				return true
+			})
+			for _, key := range existingKeys {
+				stateDB.SetState(addr, key, common.Hash{})
+			}
+			for key, value := range *account.State {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	// do not delete empty objects, the overrides must be kept as-is
+	if err := stateDB.CommitMultiStore(false); err != nil {
+		return ctx, err
+	}
+
+	return overriddenCtx, nil
+}
+
+// applyBlockOverrides applies the JSON-encoded block overrides, provided by debug_traceCall,
+// to the given context and EVM config.
+func (k Keeper) applyBlockOverrides(ctx sdk.Context, cfg *evmvm.EVMConfig, bzOverrides []byte) (sdk.Context, error) {
+	if len(bzOverrides) == 0 {
+		return ctx, nil
+	}
+
+	var overrides evmtypes.BlockOverrides
+	if err := json.Unmarshal(bzOverrides, &overrides); err != nil {
+		return ctx, err
+	}
+	if err := overrides.Validate(); err != nil {
+		return ctx, err
+	}
+
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) // #nosec G701 -- checked for int overflow already
+	}
+
+	if overrides.GasLimit != nil {
+		ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(uint64(*overrides.GasLimit)))
+	}
+
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+
+	return ctx, nil
+}
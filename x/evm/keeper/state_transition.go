@@ -70,6 +70,7 @@ func (k *Keeper) NewEVM(
 	{
 		// init the custom precompiled contracts
 		protocolVersion := k.cpcKeeper.GetProtocolCpcVersion(ctx)
+		cpcParams := k.cpcKeeper.GetParams(ctx)
 
 		var contracts []corevm.PrecompiledContract
 		for _, contract := range k.cpcKeeper.GetAllCustomPrecompiledContracts(ctx) {
@@ -78,16 +79,19 @@ func (k *Keeper) NewEVM(
 				panic(fmt.Sprintf("no executors found for custom precompiled contract %s", contract.GetMetadata().Name))
 			}
 
+			metadata := contract.GetMetadata()
+
 			var methods []corevm.CustomPrecompiledContractMethod
 			for _, executor := range executors {
 				methods = append(methods, cpckeeper.NewCustomPrecompiledContractMethod(
 					executor,
 					protocolVersion,
+					cpcParams.GetMethodGas(metadata.CustomPrecompiledType, executor.Method4BytesSignatures()),
 				))
 			}
 
-			metadata := contract.GetMetadata()
-			contracts = append(contracts, corevm.NewCustomPrecompiledContract(common.BytesToAddress(metadata.Address), methods, metadata.Name))
+			cpc := corevm.NewCustomPrecompiledContract(common.BytesToAddress(metadata.Address), methods, metadata.Name).(*corevm.CustomPrecompiledContract)
+			contracts = append(contracts, cpc.WithDisabled(metadata.Disabled))
 		}
 		evm = evm.WithCustomPrecompiledContracts(contracts...)
 	}
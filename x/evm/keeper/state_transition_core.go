@@ -23,6 +23,7 @@ import (
 	"math/big"
 
 	evmtypes "github.com/EscanBE/everlast/x/evm/types"
+	evmvm "github.com/EscanBE/everlast/x/evm/vm"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	corevm "github.com/ethereum/go-ethereum/core/vm"
@@ -253,6 +254,16 @@ func (st *StateTransition) TransitionDb() (*core.ExecutionResult, error) {
 		ret   []byte
 		vmerr error // vm errors do not effect consensus and are therefore not assigned to err
 	)
+	// The dynamic gas of the Custom Precompiled Contracts is consumed from the gas available to each call during the execution,
+	// then charged to the transaction after the execution.
+	cStateDB, isCStateDB := st.state.(evmvm.CStateDB)
+	var snapshot int
+	var nonce uint64
+	if isCStateDB {
+		cStateDB.SetCustomPrecompiledContractGasPool(st.gas)
+		snapshot = cStateDB.Snapshot()
+		nonce = cStateDB.GetNonce(msg.From())
+	}
 	if contractCreation {
 		ret, _, st.gas, vmerr = st.evm.Create(sender, st.data, st.gas, st.value)
 	} else {
@@ -260,6 +271,19 @@ func (st *StateTransition) TransitionDb() (*core.ExecutionResult, error) {
 		st.state.SetNonce(msg.From(), st.state.GetNonce(sender.Address())+1)
 		ret, st.gas, vmerr = st.evm.Call(sender, st.to(), st.data, st.gas, st.value)
 	}
+	if isCStateDB {
+		if cpcGas := cStateDB.GetCustomPrecompiledContractGasUsed(); cpcGas > st.gas {
+			// The gas returned by the calls to the contracts was spent by the callers,
+			// the transaction can not pay for the dynamic gas so all the state changes are reverted.
+			cStateDB.RevertToSnapshot(snapshot)
+			cStateDB.SetNonce(msg.From(), nonce+1)
+			ret = nil
+			vmerr = corevm.ErrOutOfGas
+			st.gas = 0
+		} else {
+			st.gas -= cpcGas
+		}
+	}
 
 	if !rules.IsLondon {
 		// Before EIP-3529: refunds were capped to gasUsed / 2
@@ -6,6 +6,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"cosmossdk.io/store/prefix"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	evmtypes "github.com/EscanBE/everlast/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -102,6 +103,18 @@ func (k *Keeper) GetCodeHash(ctx sdk.Context, addr []byte) common.Hash {
 	return codeHash
 }
 
+// IsCustomPrecompiledContractCodeEnabled returns true if the protocol version of the Custom Precompiled Contracts
+// requires the enabled custom precompiled contracts to have pseudocode inside the EVM.
+func (k *Keeper) IsCustomPrecompiledContractCodeEnabled(ctx sdk.Context) bool {
+	return k.cpcKeeper.GetProtocolCpcVersion(ctx) >= cpctypes.ProtocolCpcV2
+}
+
+// IsEnabledCustomPrecompiledContract returns true if the address is a deployed and not disabled Custom Precompiled Contract.
+func (k *Keeper) IsEnabledCustomPrecompiledContract(ctx sdk.Context, addr common.Address) bool {
+	meta := k.cpcKeeper.GetCustomPrecompiledContractMeta(ctx, addr)
+	return meta != nil && !meta.Disabled
+}
+
 // SetCodeHash sets the code hash for the given address.
 func (k *Keeper) SetCodeHash(ctx sdk.Context, addr common.Address, codeHash common.Hash) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), evmtypes.KeyPrefixCodeHash)
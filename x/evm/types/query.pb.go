@@ -697,6 +697,8 @@ type EthCallRequest struct {
 	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
 	// gas_cap defines the default gas cap to be used
 	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// overrides is the state overrides to be applied before execution, uses the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -746,6 +748,13 @@ func (m *EthCallRequest) GetGasCap() uint64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -792,6 +801,70 @@ func (m *EstimateGasResponse) GetGas() uint64 {
 	return 0
 }
 
+// CreateAccessListResponse defines CreateAccessList response
+type CreateAccessListResponse struct {
+	// access_list is the generated access list, uses the same json format as the json rpc api.
+	AccessList []byte `protobuf:"bytes,1,opt,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	// gas_used is the gas used by the call when the generated access list is applied
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by vm execution when the generated access list is applied
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *CreateAccessListResponse) Reset()         { *m = CreateAccessListResponse{} }
+func (m *CreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessListResponse) ProtoMessage()    {}
+func (*CreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{16}
+}
+func (m *CreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessListResponse.Merge(m, src)
+}
+func (m *CreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessListResponse proto.InternalMessageInfo
+
+func (m *CreateAccessListResponse) GetAccessList() []byte {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *CreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -815,7 +888,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{16}
+	return fileDescriptor_e15a877459347994, []int{17}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -903,7 +976,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{17}
+	return fileDescriptor_e15a877459347994, []int{18}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1032,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{19}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,7 +1113,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{20}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,6 +1149,171 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// block_number of the block which the call is executed on top of
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the block which the call is executed on top of
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block which the call is executed on top of
+	BlockTime time.Time `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the block which the call is executed on top of
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,7,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// state_overrides is the state overrides to be applied before execution, uses the same json format as the json rpc api.
+	StateOverrides []byte `protobuf:"bytes,8,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block_overrides is the block context overrides to be applied before execution, uses the same json format as the json
+	// rpc api.
+	BlockOverrides []byte `protobuf:"bytes,9,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{21}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetStateOverrides() []byte {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{22}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1085,7 +1323,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,7 +1362,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,10 +1408,13 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*CreateAccessListResponse)(nil), "ethermint.evm.v1.CreateAccessListResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 }
@@ -1181,91 +1422,102 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6c, 0x1b, 0x45,
-	0x17, 0xcf, 0xc6, 0x4e, 0x9c, 0xbe, 0xa4, 0x6d, 0xbe, 0xa9, 0xdb, 0xba, 0xdb, 0xc4, 0x76, 0xf7,
-	0x23, 0xb5, 0x29, 0xed, 0x6e, 0x13, 0xa4, 0x0a, 0x90, 0x10, 0xc4, 0x56, 0xf8, 0xa3, 0xaa, 0xa8,
-	0x98, 0x88, 0x03, 0x12, 0xb2, 0xc6, 0xeb, 0xe9, 0xda, 0x8a, 0x77, 0x67, 0xbb, 0x33, 0xb6, 0x1c,
-	0xaa, 0x1e, 0xa8, 0x10, 0x20, 0x71, 0xa9, 0xc4, 0x8d, 0x53, 0xef, 0x9c, 0x39, 0x73, 0xed, 0xb1,
-	0x12, 0x17, 0xc4, 0xa1, 0xa0, 0x96, 0x03, 0x77, 0x6e, 0x9c, 0xd0, 0xcc, 0xce, 0xda, 0x5e, 0xdb,
-	0x6b, 0xbb, 0xa8, 0xa7, 0xdd, 0x79, 0xf3, 0xe6, 0xfd, 0x7e, 0x6f, 0xde, 0x9b, 0xf7, 0x1e, 0x6c,
-	0x11, 0xde, 0x22, 0x81, 0xdb, 0xf6, 0xb8, 0x45, 0x7a, 0xae, 0xd5, 0xdb, 0xb5, 0xee, 0x76, 0x49,
-	0x70, 0x6c, 0xfa, 0x01, 0xe5, 0x14, 0x6d, 0x0e, 0x76, 0x4d, 0xd2, 0x73, 0xcd, 0xde, 0xae, 0x7e,
-	0xc5, 0xa6, 0xcc, 0xa5, 0xcc, 0x6a, 0x60, 0x46, 0x42, 0x55, 0xab, 0xb7, 0xdb, 0x20, 0x1c, 0xef,
-	0x5a, 0x3e, 0x76, 0xda, 0x1e, 0xe6, 0x6d, 0xea, 0x85, 0xa7, 0x75, 0x7d, 0xc2, 0xb6, 0x30, 0x12,
-	0xee, 0x5d, 0x98, 0xd8, 0xe3, 0x7d, 0xb5, 0x95, 0x75, 0xa8, 0x43, 0xe5, 0xaf, 0x25, 0xfe, 0x94,
-	0x74, 0xcb, 0xa1, 0xd4, 0xe9, 0x10, 0x0b, 0xfb, 0x6d, 0x0b, 0x7b, 0x1e, 0xe5, 0x12, 0x89, 0xa9,
-	0xdd, 0x82, 0xda, 0x95, 0xab, 0x46, 0xf7, 0x8e, 0xc5, 0xdb, 0x2e, 0x61, 0x1c, 0xbb, 0x7e, 0xa8,
-	0x60, 0xbc, 0x09, 0x67, 0x3e, 0x16, 0x6c, 0xf7, 0x6d, 0x9b, 0x76, 0x3d, 0x5e, 0x23, 0x77, 0xbb,
-	0x84, 0x71, 0x94, 0x83, 0x0c, 0x6e, 0x36, 0x03, 0xc2, 0x58, 0x4e, 0x2b, 0x6a, 0xe5, 0x13, 0xb5,
-	0x68, 0xf9, 0xd6, 0xda, 0xb7, 0x8f, 0x0a, 0x4b, 0x7f, 0x3d, 0x2a, 0x2c, 0x19, 0x36, 0x64, 0xe3,
-	0x47, 0x99, 0x4f, 0x3d, 0x46, 0xc4, 0xd9, 0x06, 0xee, 0x60, 0xcf, 0x26, 0xd1, 0x59, 0xb5, 0x44,
-	0x17, 0xe1, 0x84, 0x4d, 0x9b, 0xa4, 0xde, 0xc2, 0xac, 0x95, 0x5b, 0x96, 0x7b, 0x6b, 0x42, 0xf0,
-	0x01, 0x66, 0x2d, 0x94, 0x85, 0x15, 0x8f, 0x8a, 0x43, 0xa9, 0xa2, 0x56, 0x4e, 0xd7, 0xc2, 0x85,
-	0xf1, 0x0e, 0x5c, 0x90, 0x20, 0x55, 0x79, 0xbd, 0xff, 0x81, 0xe5, 0xd7, 0x1a, 0xe8, 0xd3, 0x2c,
-	0x28, 0xb2, 0x3b, 0x70, 0x2a, 0x8c, 0x5c, 0x3d, 0x6e, 0xe9, 0x64, 0x28, 0xdd, 0x0f, 0x85, 0x48,
-	0x87, 0x35, 0x26, 0x40, 0x05, 0xbf, 0x65, 0xc9, 0x6f, 0xb0, 0x16, 0x26, 0x70, 0x68, 0xb5, 0xee,
-	0x75, 0xdd, 0x06, 0x09, 0x94, 0x07, 0x27, 0x95, 0xf4, 0x23, 0x29, 0x34, 0x6e, 0xc2, 0x96, 0xe4,
-	0xf1, 0x29, 0xee, 0xb4, 0x9b, 0x98, 0xd3, 0x60, 0xcc, 0x99, 0x4b, 0xb0, 0x61, 0x53, 0x6f, 0x9c,
-	0xc7, 0xba, 0x90, 0xed, 0x4f, 0x78, 0xf5, 0x9d, 0x06, 0xdb, 0x09, 0xd6, 0x94, 0x63, 0x25, 0x38,
-	0x1d, 0xb1, 0x8a, 0x5b, 0x8c, 0xc8, 0xbe, 0x44, 0xd7, 0xa2, 0x24, 0xaa, 0x84, 0x71, 0x7e, 0x91,
-	0xf0, 0x5c, 0x57, 0x49, 0x34, 0x38, 0x3a, 0x2f, 0x89, 0x8c, 0x9b, 0x0a, 0xec, 0x13, 0x4e, 0x03,
-	0xec, 0xcc, 0x07, 0x43, 0x9b, 0x90, 0x3a, 0x22, 0xc7, 0x2a, 0xdf, 0xc4, 0xef, 0x08, 0xfc, 0x55,
-	0x05, 0x3f, 0x30, 0xa6, 0xe0, 0xb3, 0xb0, 0xd2, 0xc3, 0x9d, 0x6e, 0x04, 0x1e, 0x2e, 0x8c, 0x1b,
-	0xb0, 0xa9, 0x52, 0xa9, 0xf9, 0x42, 0x4e, 0x96, 0xe0, 0x7f, 0x23, 0xe7, 0x14, 0x04, 0x82, 0xb4,
-	0xc8, 0x7d, 0x79, 0x6a, 0xa3, 0x26, 0xff, 0x8d, 0x2c, 0x20, 0xa9, 0x78, 0x1b, 0x07, 0xd8, 0x65,
-	0x0a, 0xc2, 0xb8, 0xa5, 0x3c, 0x8e, 0xa4, 0xca, 0xc0, 0x0d, 0x58, 0xf5, 0xa5, 0x44, 0x9a, 0x58,
-	0xdf, 0xcb, 0x99, 0xe3, 0x55, 0xc9, 0x0c, 0x4f, 0x54, 0xd2, 0x8f, 0x9f, 0x16, 0x96, 0x6a, 0x4a,
-	0xdb, 0x78, 0x1b, 0x4e, 0x1d, 0xf0, 0x56, 0x15, 0x77, 0x3a, 0x91, 0x0f, 0x08, 0xd2, 0x38, 0x70,
-	0x58, 0x44, 0x45, 0xfc, 0xa3, 0xf3, 0x90, 0x71, 0x30, 0xab, 0xdb, 0xd8, 0x57, 0x59, 0xb1, 0xea,
-	0x60, 0x56, 0xc5, 0xbe, 0x51, 0x82, 0x33, 0x07, 0x8c, 0xb7, 0x5d, 0xcc, 0xc9, 0xfb, 0x78, 0xc8,
-	0x66, 0x13, 0x52, 0x0e, 0x0e, 0x4d, 0xa4, 0x6b, 0xe2, 0xd7, 0xf8, 0x29, 0xa5, 0x78, 0x1f, 0x06,
-	0xd8, 0x26, 0x87, 0xfd, 0x08, 0x6d, 0x17, 0x52, 0x2e, 0x73, 0x14, 0xe9, 0xc2, 0x24, 0xe9, 0x5b,
-	0xcc, 0x39, 0x10, 0x32, 0xd2, 0x75, 0x0f, 0xfb, 0x35, 0xa1, 0x8b, 0xde, 0x85, 0x0d, 0x2e, 0x8c,
-	0xd4, 0x6d, 0xea, 0xdd, 0x69, 0x3b, 0x92, 0xd1, 0xfa, 0xde, 0xf6, 0xe4, 0x59, 0x09, 0x55, 0x95,
-	0x4a, 0xb5, 0x75, 0x3e, 0x5c, 0xa0, 0x2a, 0x6c, 0xf8, 0x01, 0x69, 0x12, 0x9b, 0x30, 0x46, 0x03,
-	0x96, 0x4b, 0x15, 0x53, 0x8b, 0xa0, 0xc7, 0x0e, 0x89, 0x27, 0xda, 0xe8, 0x50, 0xfb, 0x28, 0x7a,
-	0x0c, 0xe9, 0xa2, 0x56, 0x4e, 0xd5, 0xd6, 0xa5, 0x2c, 0x7c, 0x0a, 0x68, 0x1b, 0x20, 0x54, 0x91,
-	0x35, 0x6e, 0x45, 0x66, 0xc4, 0x09, 0x29, 0x91, 0x45, 0xae, 0x1a, 0x6d, 0x8b, 0x3a, 0x9c, 0x5b,
-	0x95, 0x6e, 0xe8, 0x66, 0x58, 0xa4, 0xcd, 0xa8, 0x48, 0x9b, 0x87, 0x51, 0x91, 0xae, 0xac, 0x89,
-	0xc8, 0x3d, 0xfc, 0xbd, 0xa0, 0x29, 0x23, 0x62, 0x07, 0x7d, 0x0e, 0x9b, 0x7e, 0x40, 0x7d, 0xca,
-	0x48, 0x30, 0x78, 0xdb, 0x19, 0x11, 0xba, 0xca, 0xde, 0x3f, 0x4f, 0x0b, 0xa6, 0xd3, 0xe6, 0xad,
-	0x6e, 0xc3, 0xb4, 0xa9, 0x6b, 0xa9, 0xa6, 0x14, 0x7e, 0xae, 0xb1, 0xe6, 0x91, 0xc5, 0x8f, 0x7d,
-	0xc2, 0xcc, 0xea, 0xb0, 0xa8, 0xd4, 0x4e, 0x47, 0xb6, 0x94, 0xc0, 0xb8, 0xa2, 0xde, 0xc4, 0x20,
-	0x6c, 0xc3, 0x84, 0x6d, 0x62, 0x8e, 0xa3, 0x2c, 0x11, 0xff, 0xc6, 0xdf, 0xcb, 0x70, 0x6e, 0xa8,
-	0x5c, 0x11, 0x14, 0x47, 0xc2, 0xcc, 0xfb, 0x22, 0x21, 0x16, 0xba, 0x68, 0xa1, 0x3b, 0x11, 0xe6,
-	0xd4, 0x0b, 0x87, 0x79, 0x3c, 0x42, 0x2b, 0xf3, 0x22, 0xb4, 0x3a, 0x3b, 0x42, 0x99, 0x97, 0x17,
-	0xa1, 0xb5, 0x97, 0x17, 0xa1, 0x6b, 0x70, 0x7e, 0xe2, 0xd2, 0x67, 0x04, 0xe9, 0xec, 0xa0, 0x3c,
-	0x33, 0xf2, 0x1e, 0x89, 0x2a, 0x97, 0x71, 0x7b, 0x50, 0x7a, 0x95, 0x58, 0x99, 0x78, 0x03, 0xd6,
-	0xc4, 0x14, 0x53, 0xbf, 0x43, 0x54, 0xf9, 0xab, 0x6c, 0x0b, 0x1f, 0x7f, 0x7b, 0x5a, 0x38, 0x1b,
-	0xd2, 0x64, 0xcd, 0x23, 0xb3, 0x4d, 0x2d, 0x17, 0xf3, 0x96, 0xf9, 0xa1, 0xc7, 0x45, 0x69, 0x96,
-	0x16, 0xf6, 0x7e, 0xde, 0x80, 0x15, 0x69, 0x12, 0x7d, 0xa9, 0x41, 0x46, 0x75, 0x24, 0xb4, 0x33,
-	0x19, 0xbf, 0x29, 0x23, 0x87, 0x7e, 0x79, 0x9e, 0x5a, 0x48, 0xcf, 0x28, 0x3d, 0xf8, 0xe5, 0xcf,
-	0xef, 0x97, 0x2f, 0xa1, 0x82, 0x18, 0x90, 0x28, 0x8b, 0xc6, 0x24, 0xd5, 0x91, 0xac, 0x7b, 0xea,
-	0xbe, 0xef, 0xa3, 0x1f, 0x34, 0x38, 0x19, 0x6b, 0xfa, 0xe8, 0xb5, 0x04, 0x88, 0x69, 0xc3, 0x85,
-	0x7e, 0x75, 0x31, 0x65, 0xc5, 0xca, 0x94, 0xac, 0xca, 0xe8, 0x72, 0x9c, 0x55, 0x34, 0x5b, 0x4c,
-	0x90, 0xfb, 0x51, 0x83, 0xcd, 0xf1, 0xde, 0x8d, 0xcc, 0x04, 0xc8, 0x84, 0x91, 0x41, 0xb7, 0x16,
-	0xd6, 0x57, 0x2c, 0x6f, 0x48, 0x96, 0xd7, 0x91, 0x19, 0x67, 0xd9, 0x8b, 0xf4, 0x87, 0x44, 0x47,
-	0x47, 0x91, 0xfb, 0xe8, 0x81, 0x06, 0x19, 0xd5, 0xa1, 0x13, 0xc3, 0x19, 0x6f, 0xfe, 0x89, 0xe1,
-	0x1c, 0x6b, 0xf4, 0x46, 0x59, 0x52, 0x32, 0x50, 0x31, 0x4e, 0x49, 0x75, 0x7b, 0x36, 0x72, 0x65,
-	0xdf, 0x68, 0x90, 0x51, 0x7d, 0x3a, 0x91, 0x44, 0x7c, 0x28, 0x48, 0x24, 0x31, 0xd6, 0xee, 0x8d,
-	0x6b, 0x92, 0x44, 0x09, 0xed, 0xc4, 0x49, 0xb0, 0x50, 0x6d, 0xc8, 0xc1, 0xba, 0x77, 0x44, 0x8e,
-	0xef, 0xa3, 0x1e, 0xa4, 0x45, 0x2b, 0x47, 0x46, 0x62, 0x8a, 0x0c, 0xe6, 0x03, 0xfd, 0xff, 0x33,
-	0x75, 0x14, 0xfe, 0x8e, 0xc4, 0x2f, 0xa0, 0xed, 0xf1, 0xec, 0x69, 0xc6, 0x6e, 0x80, 0xc1, 0x6a,
-	0xd8, 0xd1, 0xd1, 0x2b, 0x09, 0x56, 0x63, 0x83, 0x83, 0xbe, 0x33, 0x47, 0x4b, 0xa1, 0x6f, 0x49,
-	0xf4, 0x73, 0x28, 0x1b, 0x47, 0x0f, 0xc7, 0x05, 0xc4, 0x21, 0xa3, 0xc6, 0x05, 0x54, 0x9c, 0xb4,
-	0x17, 0x9f, 0x24, 0xf4, 0xd2, 0xbc, 0x3a, 0x1f, 0x61, 0xe6, 0x25, 0x66, 0x0e, 0x9d, 0x8b, 0x63,
-	0x12, 0xde, 0xaa, 0xdb, 0x02, 0xea, 0x0b, 0x58, 0x1f, 0x99, 0x32, 0x16, 0x40, 0x9e, 0xe2, 0xeb,
-	0x94, 0x31, 0xc5, 0x30, 0x24, 0xee, 0x16, 0xd2, 0xc7, 0x70, 0x95, 0x6a, 0xdd, 0xc1, 0x0c, 0xf5,
-	0x21, 0xa3, 0x7a, 0x5f, 0x62, 0x9e, 0xc5, 0x47, 0x9a, 0xc4, 0x3c, 0x1b, 0x6b, 0xa1, 0x49, 0x5e,
-	0x87, 0x4d, 0x8f, 0xf7, 0xd1, 0x57, 0x1a, 0xc0, 0xb0, 0xa8, 0xa3, 0xf2, 0x2c, 0xb3, 0xa3, 0xcd,
-	0x56, 0x7f, 0x75, 0x01, 0x4d, 0xc5, 0xe1, 0x92, 0xe4, 0x70, 0x11, 0x5d, 0x98, 0xc6, 0x41, 0xb6,
-	0x30, 0x71, 0x01, 0xaa, 0x29, 0xcc, 0x78, 0xed, 0xa3, 0xbd, 0x64, 0xc6, 0x6b, 0x8f, 0xf5, 0x96,
-	0xa4, 0x0b, 0x88, 0xfa, 0x4d, 0x65, 0xff, 0xf1, 0xb3, 0xbc, 0xf6, 0xe4, 0x59, 0x5e, 0xfb, 0xe3,
-	0x59, 0x5e, 0x7b, 0xf8, 0x3c, 0xbf, 0xf4, 0xe4, 0x79, 0x7e, 0xe9, 0xd7, 0xe7, 0xf9, 0xa5, 0xcf,
-	0x4a, 0x23, 0x4d, 0xf3, 0x80, 0xd9, 0xd8, 0xab, 0x1c, 0x58, 0xa4, 0x47, 0x82, 0x0e, 0x66, 0xdc,
-	0xea, 0x4b, 0x43, 0xb2, 0x73, 0x36, 0x56, 0x65, 0x93, 0x7e, 0xfd, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xaa, 0x1d, 0x54, 0x58, 0xc2, 0x0f, 0x00, 0x00,
+	// 1509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x6c, 0x1b, 0xc5,
+	0x17, 0xce, 0xc6, 0x4e, 0x6c, 0x3f, 0xa7, 0xa9, 0x7f, 0x53, 0x37, 0x75, 0xb6, 0x89, 0x9d, 0xee,
+	0x8f, 0xc4, 0xa6, 0xb4, 0xbb, 0x4d, 0x90, 0x2a, 0xe0, 0x02, 0xb1, 0x15, 0xfe, 0xa8, 0x14, 0x8a,
+	0x09, 0x1c, 0x40, 0xc8, 0x1a, 0xaf, 0xa7, 0x6b, 0x2b, 0xde, 0x5d, 0x77, 0x67, 0x6c, 0x39, 0x54,
+	0x95, 0xa0, 0x42, 0x80, 0xe0, 0x52, 0x89, 0x1b, 0xa7, 0xde, 0x39, 0x73, 0xe5, 0xdc, 0x1b, 0x95,
+	0xb8, 0x20, 0x0e, 0x05, 0xb5, 0x1c, 0xb8, 0x73, 0xe3, 0x84, 0x66, 0x76, 0xd6, 0xf6, 0xfa, 0x7f,
+	0xab, 0x9c, 0x38, 0x79, 0xe7, 0xcd, 0x37, 0xef, 0xfb, 0x66, 0xde, 0xf3, 0xcc, 0x07, 0x1b, 0x84,
+	0xd5, 0x89, 0x67, 0x37, 0x1c, 0x66, 0x90, 0x8e, 0x6d, 0x74, 0x76, 0x8d, 0x5b, 0x6d, 0xe2, 0x1d,
+	0xeb, 0x2d, 0xcf, 0x65, 0x2e, 0x4a, 0xf5, 0x66, 0x75, 0xd2, 0xb1, 0xf5, 0xce, 0xae, 0x7a, 0xd1,
+	0x74, 0xa9, 0xed, 0x52, 0xa3, 0x8a, 0x29, 0xf1, 0xa1, 0x46, 0x67, 0xb7, 0x4a, 0x18, 0xde, 0x35,
+	0x5a, 0xd8, 0x6a, 0x38, 0x98, 0x35, 0x5c, 0xc7, 0x5f, 0xad, 0xaa, 0x23, 0xb9, 0x79, 0x12, 0x7f,
+	0x6e, 0x7d, 0x64, 0x8e, 0x75, 0xe5, 0x54, 0xda, 0x72, 0x2d, 0x57, 0x7c, 0x1a, 0xfc, 0x4b, 0x46,
+	0x37, 0x2c, 0xd7, 0xb5, 0x9a, 0xc4, 0xc0, 0xad, 0x86, 0x81, 0x1d, 0xc7, 0x65, 0x82, 0x89, 0xca,
+	0xd9, 0x9c, 0x9c, 0x15, 0xa3, 0x6a, 0xfb, 0xa6, 0xc1, 0x1a, 0x36, 0xa1, 0x0c, 0xdb, 0x2d, 0x1f,
+	0xa0, 0xbd, 0x0c, 0x67, 0xde, 0xe3, 0x6a, 0xf7, 0x4d, 0xd3, 0x6d, 0x3b, 0xac, 0x4c, 0x6e, 0xb5,
+	0x09, 0x65, 0x28, 0x03, 0x31, 0x5c, 0xab, 0x79, 0x84, 0xd2, 0x8c, 0xb2, 0xa5, 0x14, 0x12, 0xe5,
+	0x60, 0xf8, 0x4a, 0xfc, 0xeb, 0xfb, 0xb9, 0x85, 0xbf, 0xee, 0xe7, 0x16, 0x34, 0x13, 0xd2, 0xe1,
+	0xa5, 0xb4, 0xe5, 0x3a, 0x94, 0xf0, 0xb5, 0x55, 0xdc, 0xc4, 0x8e, 0x49, 0x82, 0xb5, 0x72, 0x88,
+	0xce, 0x43, 0xc2, 0x74, 0x6b, 0xa4, 0x52, 0xc7, 0xb4, 0x9e, 0x59, 0x14, 0x73, 0x71, 0x1e, 0x78,
+	0x13, 0xd3, 0x3a, 0x4a, 0xc3, 0x92, 0xe3, 0xf2, 0x45, 0x91, 0x2d, 0xa5, 0x10, 0x2d, 0xfb, 0x03,
+	0xed, 0x55, 0x58, 0x17, 0x24, 0x25, 0x71, 0xbc, 0xcf, 0xa0, 0xf2, 0x4b, 0x05, 0xd4, 0x71, 0x19,
+	0xa4, 0xd8, 0x6d, 0x58, 0xf5, 0x2b, 0x57, 0x09, 0x67, 0x3a, 0xe5, 0x47, 0xf7, 0xfd, 0x20, 0x52,
+	0x21, 0x4e, 0x39, 0x29, 0xd7, 0xb7, 0x28, 0xf4, 0xf5, 0xc6, 0x3c, 0x05, 0xf6, 0xb3, 0x56, 0x9c,
+	0xb6, 0x5d, 0x25, 0x9e, 0xdc, 0xc1, 0x29, 0x19, 0x7d, 0x47, 0x04, 0xb5, 0x6b, 0xb0, 0x21, 0x74,
+	0x7c, 0x88, 0x9b, 0x8d, 0x1a, 0x66, 0xae, 0x37, 0xb4, 0x99, 0x0b, 0xb0, 0x62, 0xba, 0xce, 0xb0,
+	0x8e, 0x24, 0x8f, 0xed, 0x8f, 0xec, 0xea, 0x5b, 0x05, 0x36, 0x27, 0x64, 0x93, 0x1b, 0xcb, 0xc3,
+	0xe9, 0x40, 0x55, 0x38, 0x63, 0x20, 0xf6, 0x04, 0xb7, 0x16, 0x34, 0x51, 0xd1, 0xaf, 0xf3, 0xd3,
+	0x94, 0xe7, 0x0a, 0xa4, 0xc3, 0x4b, 0x67, 0x35, 0x91, 0x76, 0x4d, 0x92, 0xbd, 0xcf, 0x5c, 0x0f,
+	0x5b, 0xb3, 0xc9, 0x50, 0x0a, 0x22, 0x47, 0xe4, 0x58, 0xf6, 0x1b, 0xff, 0x1c, 0xa0, 0xbf, 0x04,
+	0xe9, 0x70, 0x32, 0x49, 0x9f, 0x86, 0xa5, 0x0e, 0x6e, 0xb6, 0x03, 0x72, 0x7f, 0xa0, 0x5d, 0x85,
+	0x94, 0x6c, 0xa5, 0xda, 0x53, 0x6d, 0x32, 0x0f, 0xff, 0x1b, 0x58, 0x27, 0x29, 0x10, 0x44, 0x79,
+	0xef, 0x8b, 0x55, 0x2b, 0x65, 0xf1, 0xad, 0xa5, 0x01, 0x09, 0xe0, 0x0d, 0xec, 0x61, 0x9b, 0x4a,
+	0x0a, 0xed, 0x3a, 0x9c, 0x09, 0x45, 0x65, 0x82, 0xab, 0xb0, 0xdc, 0x12, 0x11, 0x91, 0x22, 0xb9,
+	0x97, 0xd1, 0x87, 0x6f, 0x25, 0xdd, 0x5f, 0x51, 0x8c, 0x3e, 0x78, 0x94, 0x5b, 0x28, 0x4b, 0xb4,
+	0xf6, 0x31, 0xac, 0x1e, 0xb0, 0x7a, 0x09, 0x37, 0x9b, 0xc1, 0x1e, 0x10, 0x44, 0xb1, 0x67, 0xd1,
+	0x40, 0x0a, 0xff, 0x46, 0xe7, 0x20, 0x66, 0x61, 0x5a, 0x31, 0x71, 0x4b, 0x76, 0xc5, 0xb2, 0x85,
+	0x69, 0x09, 0xb7, 0xd0, 0x06, 0x24, 0xdc, 0x0e, 0xf1, 0xbc, 0x46, 0x8d, 0x50, 0xd1, 0x0e, 0x2b,
+	0xe5, 0x7e, 0x40, 0xcb, 0xc3, 0x99, 0x03, 0xca, 0x1a, 0x36, 0x66, 0xe4, 0x0d, 0xdc, 0xd7, 0x9a,
+	0x82, 0x88, 0x85, 0x7d, 0x82, 0x68, 0x99, 0x7f, 0x6a, 0xb7, 0x20, 0x53, 0xf2, 0x08, 0x66, 0x64,
+	0xdf, 0x34, 0x09, 0xa5, 0x6f, 0x37, 0x68, 0xbf, 0x77, 0x73, 0x90, 0xc4, 0x22, 0x5a, 0x69, 0x36,
+	0x28, 0x93, 0xb2, 0x00, 0xf7, 0x80, 0x68, 0x1d, 0xe2, 0x5c, 0x5c, 0x9b, 0x92, 0x9a, 0x54, 0xc7,
+	0xc5, 0x7e, 0x40, 0x49, 0x8d, 0x4f, 0x75, 0xec, 0x0a, 0xf1, 0x3c, 0xd7, 0x6f, 0xd6, 0x44, 0x39,
+	0xd6, 0xb1, 0x0f, 0xf8, 0x50, 0xfb, 0x31, 0x22, 0x0f, 0xf2, 0xd0, 0xc3, 0x26, 0x39, 0xec, 0x06,
+	0xdb, 0xdf, 0x85, 0x88, 0x4d, 0x2d, 0x79, 0x8a, 0xb9, 0xd1, 0x53, 0xbc, 0x4e, 0xad, 0x03, 0x1e,
+	0x23, 0x6d, 0xfb, 0xb0, 0x5b, 0xe6, 0x58, 0xf4, 0x1a, 0xac, 0x30, 0x9e, 0xa4, 0x62, 0xba, 0xce,
+	0xcd, 0x86, 0x25, 0x44, 0x24, 0xf7, 0x36, 0x47, 0xd7, 0x0a, 0xaa, 0x92, 0x00, 0x95, 0x93, 0xac,
+	0x3f, 0x40, 0x25, 0x58, 0x69, 0x79, 0xa4, 0x46, 0xf8, 0x9e, 0x5c, 0x8f, 0x9f, 0x64, 0x64, 0x1e,
+	0xf6, 0xd0, 0x22, 0x7e, 0x67, 0x54, 0x9b, 0xae, 0x79, 0x14, 0xfc, 0x3b, 0xa3, 0x5b, 0x4a, 0x21,
+	0x52, 0x4e, 0x8a, 0x98, 0xff, 0xdf, 0x44, 0x9b, 0x00, 0x3e, 0x44, 0x5c, 0xba, 0x4b, 0xe2, 0x44,
+	0x12, 0x22, 0x22, 0x6e, 0xdd, 0x52, 0x30, 0xcd, 0x1f, 0x86, 0xcc, 0xb2, 0xd8, 0x86, 0xaa, 0xfb,
+	0xaf, 0x86, 0x1e, 0xbc, 0x1a, 0xfa, 0x61, 0xf0, 0x6a, 0x14, 0xe3, 0xbc, 0x95, 0xee, 0xfd, 0x9e,
+	0x53, 0x64, 0x12, 0x3e, 0x83, 0x3e, 0x81, 0x54, 0xcb, 0x73, 0x5b, 0x2e, 0x25, 0x5e, 0xef, 0xb2,
+	0x89, 0xf1, 0xa2, 0x15, 0xf7, 0xfe, 0x79, 0x94, 0xd3, 0xad, 0x06, 0xab, 0xb7, 0xab, 0xba, 0xe9,
+	0xda, 0x86, 0x7c, 0x25, 0xfd, 0x9f, 0xcb, 0xb4, 0x76, 0x64, 0xb0, 0xe3, 0x16, 0xa1, 0x7a, 0xa9,
+	0x7f, 0xcb, 0x95, 0x4f, 0x07, 0xb9, 0x64, 0x40, 0xbb, 0x28, 0xff, 0xa4, 0xbd, 0xb2, 0xf5, 0xff,
+	0x41, 0x35, 0xcc, 0x70, 0xd0, 0xb6, 0xfc, 0x5b, 0xfb, 0x7b, 0x11, 0xd6, 0xfa, 0xe0, 0x22, 0x97,
+	0x38, 0x50, 0x66, 0xd6, 0xe5, 0x3d, 0x38, 0xd7, 0x41, 0x73, 0xec, 0x48, 0x99, 0x23, 0x4f, 0x5d,
+	0xe6, 0xe1, 0x0a, 0x2d, 0xcd, 0xaa, 0xd0, 0xf2, 0xf4, 0x0a, 0xc5, 0x4e, 0xae, 0x42, 0xf1, 0x93,
+	0xab, 0xd0, 0x65, 0x38, 0x37, 0x72, 0xe8, 0x53, 0x8a, 0xf4, 0x53, 0x04, 0xce, 0xf6, 0xf1, 0xcf,
+	0x7c, 0x13, 0x9d, 0x7c, 0x75, 0xfe, 0x93, 0xff, 0x1f, 0x6e, 0x05, 0x28, 0xc3, 0x8c, 0x54, 0xfa,
+	0xf7, 0xb6, 0xa8, 0x7d, 0x79, 0x55, 0x84, 0xdf, 0x0d, 0xa2, 0x1c, 0xe8, 0x6f, 0xa6, 0x0f, 0x4c,
+	0xf8, 0x40, 0x11, 0xee, 0x01, 0xb5, 0x4b, 0xb0, 0x36, 0x5c, 0xbf, 0x29, 0xe5, 0x3e, 0xdb, 0xb3,
+	0x07, 0x94, 0xbc, 0x4e, 0x82, 0x97, 0x53, 0xbb, 0x01, 0xe9, 0x70, 0x58, 0xa6, 0x78, 0x09, 0xe2,
+	0xdc, 0x45, 0x57, 0x6e, 0x12, 0xf9, 0xfc, 0x16, 0x37, 0xf9, 0xa1, 0xfd, 0xf6, 0x28, 0x77, 0xd6,
+	0xdf, 0x37, 0xad, 0x1d, 0xe9, 0x0d, 0xd7, 0xb0, 0x31, 0xab, 0xeb, 0x6f, 0x39, 0x8c, 0x5b, 0x03,
+	0x91, 0x61, 0xef, 0xe7, 0x55, 0x58, 0x12, 0x29, 0xd1, 0xe7, 0x0a, 0xc4, 0xa4, 0x23, 0x42, 0xdb,
+	0xa3, 0x0d, 0x31, 0xc6, 0xf2, 0xaa, 0x3b, 0xb3, 0x60, 0xbe, 0x3c, 0x2d, 0x7f, 0xf7, 0x97, 0x3f,
+	0xbf, 0x5b, 0xbc, 0x80, 0x72, 0xdc, 0xa0, 0xbb, 0x34, 0xb0, 0xe9, 0xd2, 0x11, 0x19, 0xb7, 0x65,
+	0x01, 0xef, 0xa0, 0xef, 0x15, 0x38, 0x15, 0x32, 0x9d, 0xe8, 0x85, 0x09, 0x14, 0xe3, 0xcc, 0xad,
+	0x7a, 0x69, 0x3e, 0xb0, 0x54, 0xa5, 0x0b, 0x55, 0x05, 0xb4, 0x13, 0x56, 0x15, 0x78, 0xdb, 0x11,
+	0x71, 0x3f, 0x28, 0x90, 0x1a, 0xf6, 0x8e, 0x48, 0x9f, 0x40, 0x39, 0xc1, 0xb2, 0xaa, 0xc6, 0xdc,
+	0x78, 0xa9, 0xf2, 0xaa, 0x50, 0x79, 0x05, 0xe9, 0x61, 0x95, 0x9d, 0x00, 0xdf, 0x17, 0x3a, 0x68,
+	0x85, 0xef, 0xa0, 0xbb, 0x0a, 0xc4, 0xa4, 0x43, 0x9c, 0x58, 0xce, 0xb0, 0xf9, 0x54, 0x77, 0x66,
+	0xc1, 0xa4, 0xa4, 0x82, 0x90, 0xa4, 0xa1, 0xad, 0xb0, 0x24, 0xe9, 0x36, 0xe9, 0xc0, 0x91, 0x7d,
+	0xa5, 0x40, 0x4c, 0xfa, 0xc4, 0x89, 0x22, 0xc2, 0xa6, 0x54, 0xdd, 0x99, 0x05, 0x93, 0x22, 0x2e,
+	0x0b, 0x11, 0x79, 0xb4, 0x1d, 0x16, 0x41, 0x7d, 0x58, 0x5f, 0x83, 0x71, 0xfb, 0x88, 0x1c, 0xdf,
+	0x41, 0x1d, 0x88, 0x72, 0x2b, 0x89, 0xb4, 0x89, 0x2d, 0xd2, 0xf3, 0xa7, 0xea, 0xff, 0xa7, 0x62,
+	0x24, 0xff, 0xb6, 0xe0, 0xcf, 0xa1, 0xcd, 0xe1, 0xee, 0xa9, 0x85, 0x4e, 0x80, 0xc2, 0xb2, 0xef,
+	0x28, 0xd1, 0x73, 0x13, 0xb2, 0x86, 0x8c, 0xab, 0xba, 0x3d, 0x03, 0x25, 0xd9, 0x37, 0x04, 0xfb,
+	0x1a, 0x4a, 0x87, 0xd9, 0x7d, 0xbb, 0x8a, 0x18, 0xc4, 0xa4, 0x5d, 0x45, 0x5b, 0xa3, 0xf9, 0xc2,
+	0x4e, 0x56, 0xcd, 0xcf, 0x7a, 0xd6, 0x03, 0xce, 0xac, 0xe0, 0xcc, 0xa0, 0xb5, 0x30, 0x27, 0x61,
+	0xf5, 0x8a, 0xc9, 0xa9, 0x3e, 0x85, 0xe4, 0x80, 0x8f, 0x9d, 0x83, 0x79, 0xcc, 0x5e, 0xc7, 0x18,
+	0x61, 0x4d, 0x13, 0xbc, 0x1b, 0x48, 0x1d, 0xe2, 0x95, 0xd0, 0x8a, 0x85, 0x29, 0xfa, 0x46, 0x81,
+	0xd4, 0xb0, 0x37, 0x9e, 0x43, 0xc1, 0xc5, 0x51, 0xc4, 0x24, 0x87, 0x3d, 0xa9, 0xeb, 0x4d, 0x81,
+	0xaf, 0x0c, 0x98, 0x6f, 0xd4, 0x85, 0x98, 0xf4, 0x5d, 0x13, 0x9b, 0x3e, 0x6c, 0xa7, 0xd5, 0x9d,
+	0x59, 0xb0, 0xe9, 0x25, 0xf0, 0x9f, 0x74, 0xd6, 0x45, 0x5f, 0x28, 0x00, 0x7d, 0x43, 0x81, 0x0a,
+	0xd3, 0xd2, 0x0e, 0x1a, 0x3d, 0xf5, 0xf9, 0x39, 0x90, 0x52, 0xc3, 0x05, 0xa1, 0xe1, 0x3c, 0x5a,
+	0x1f, 0xa7, 0x41, 0x3c, 0x7a, 0xe8, 0x33, 0x05, 0x12, 0xbd, 0x77, 0x0e, 0xe5, 0xa7, 0xe5, 0x1e,
+	0xac, 0x46, 0x61, 0x36, 0x50, 0x6a, 0xd8, 0x12, 0x1a, 0x54, 0x94, 0x19, 0xa7, 0x41, 0x34, 0x63,
+	0x97, 0xdf, 0x7e, 0xe2, 0x89, 0x9b, 0x72, 0xfb, 0x0d, 0xbe, 0xad, 0xea, 0xce, 0x2c, 0xd8, 0xf4,
+	0x1a, 0x04, 0xef, 0x6f, 0x71, 0xff, 0xc1, 0xe3, 0xac, 0xf2, 0xf0, 0x71, 0x56, 0xf9, 0xe3, 0x71,
+	0x56, 0xb9, 0xf7, 0x24, 0xbb, 0xf0, 0xf0, 0x49, 0x76, 0xe1, 0xd7, 0x27, 0xd9, 0x85, 0x8f, 0xf2,
+	0x03, 0xae, 0xe4, 0x80, 0x9a, 0xd8, 0x29, 0x1e, 0x18, 0xa4, 0x43, 0xbc, 0x26, 0xa6, 0xcc, 0xe8,
+	0x8a, 0x44, 0xc2, 0x9a, 0x54, 0x97, 0x85, 0x0b, 0x7a, 0xf1, 0xdf, 0x01, 0x00, 0x2f, 0xc8, 0x61,
+	0x5e, 0x52, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1299,10 +1551,14 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the current block.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 }
@@ -1396,6 +1652,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1414,6 +1679,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1444,10 +1718,14 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the current block.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 }
@@ -1483,12 +1761,18 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -1659,6 +1943,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -1695,6 +1997,24 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -1753,6 +2073,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -1761,6 +2085,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2245,6 +2573,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasCap != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
 		i--
@@ -2288,6 +2623,48 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		i -= len(m.AccessList)
+		copy(dAtA[i:], m.AccessList)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccessList)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2512,7 +2889,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2522,27 +2899,145 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return dAtA[:n], nil
+	if len(m.StateOverrides) > 0 {
+		i -= len(m.StateOverrides)
+		copy(dAtA[i:], m.StateOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateOverrides)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
@@ -2791,6 +3286,10 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.GasCap != 0 {
 		n += 1 + sovQuery(uint64(m.GasCap))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2806,6 +3305,26 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *CreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccessList)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2900,6 +3419,60 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StateOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBaseFeeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4286,6 +4859,40 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4376,7 +4983,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateAccessListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4399,17 +5006,17 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateAccessListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4419,33 +5026,31 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AccessList = append(m.AccessList[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccessList == nil {
+				m.AccessList = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var msglen int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4455,33 +5060,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4491,15 +5079,169 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
@@ -5056,6 +5798,415 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateOverrides == nil {
+				m.StateOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate performs basic validation of the state overrides.
+func (so StateOverride) Validate() error {
+	for addr, account := range so {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		if account.Balance != nil && *account.Balance != nil && (*account.Balance).ToInt().Sign() < 0 {
+			return fmt.Errorf("account %s has negative balance override", addr.Hex())
+		}
+	}
+
+	return nil
+}
+
+// BlockOverrides is a set of block context fields to override during the execution of a message call.
+// Note: difficulty and random are not supported and will be ignored.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	GasLimit *hexutil.Uint64 `json:"gasLimit"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// Validate performs basic validation of the block overrides.
+func (bo BlockOverrides) Validate() error {
+	if bo.Number != nil {
+		if number := bo.Number.ToInt(); number.Sign() < 1 || !number.IsInt64() {
+			return fmt.Errorf("invalid block number override %s", number)
+		}
+	}
+
+	if bo.Time != nil && uint64(*bo.Time) > math.MaxInt64 {
+		return fmt.Errorf("invalid block time override %d", uint64(*bo.Time))
+	}
+
+	if bo.BaseFee != nil && bo.BaseFee.ToInt().Sign() < 0 {
+		return fmt.Errorf("negative base fee override %s", bo.BaseFee.ToInt())
+	}
+
+	return nil
+}
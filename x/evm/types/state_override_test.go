@@ -0,0 +1,156 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOverrideValidate(t *testing.T) {
+	addr := common.BytesToAddress([]byte{1, 2, 3})
+	storage := map[common.Hash]common.Hash{
+		common.BytesToHash([]byte{1}): common.BytesToHash([]byte{2}),
+	}
+	balance := func(i int64) **hexutil.Big {
+		b := (*hexutil.Big)(big.NewInt(i))
+		return &b
+	}
+
+	testCases := []struct {
+		name      string
+		overrides StateOverride
+		expPass   bool
+	}{
+		{
+			name:      "pass - empty overrides",
+			overrides: StateOverride{},
+			expPass:   true,
+		},
+		{
+			name: "pass - valid overrides",
+			overrides: StateOverride{
+				addr: {
+					Nonce:     (*hexutil.Uint64)(new(uint64)),
+					Code:      &hexutil.Bytes{0x60, 0x00},
+					Balance:   balance(1),
+					StateDiff: &storage,
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - both state and stateDiff",
+			overrides: StateOverride{
+				addr: {
+					State:     &storage,
+					StateDiff: &storage,
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - negative balance",
+			overrides: StateOverride{
+				addr: {
+					Balance: balance(-1),
+				},
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.overrides.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestStateOverrideJSON(t *testing.T) {
+	bz := []byte(`{"0x0000000000000000000000000000000000010203":{"nonce":"0x1","code":"0x6000","balance":"0x2a","stateDiff":{"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000002"}}}`)
+
+	var overrides StateOverride
+	require.NoError(t, json.Unmarshal(bz, &overrides))
+	require.Len(t, overrides, 1)
+
+	account, found := overrides[common.BytesToAddress([]byte{1, 2, 3})]
+	require.True(t, found)
+	require.Equal(t, uint64(1), uint64(*account.Nonce))
+	require.Equal(t, hexutil.Bytes{0x60, 0x00}, *account.Code)
+	require.Equal(t, int64(42), (*account.Balance).ToInt().Int64())
+	require.Nil(t, account.State)
+	require.Equal(t, common.BytesToHash([]byte{2}), (*account.StateDiff)[common.BytesToHash([]byte{1})])
+}
+
+func TestBlockOverridesValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		overrides BlockOverrides
+		expPass   bool
+	}{
+		{
+			name:      "pass - empty overrides",
+			overrides: BlockOverrides{},
+			expPass:   true,
+		},
+		{
+			name: "pass - valid overrides",
+			overrides: BlockOverrides{
+				Number:   (*hexutil.Big)(big.NewInt(1000)),
+				Time:     (*hexutil.Uint64)(func() *uint64 { v := uint64(1_700_000_000); return &v }()),
+				GasLimit: (*hexutil.Uint64)(func() *uint64 { v := uint64(30_000_000); return &v }()),
+				Coinbase: &common.Address{1},
+				BaseFee:  (*hexutil.Big)(big.NewInt(0)),
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - zero block number",
+			overrides: BlockOverrides{
+				Number: (*hexutil.Big)(big.NewInt(0)),
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - block number overflow int64",
+			overrides: BlockOverrides{
+				Number: (*hexutil.Big)(new(big.Int).Lsh(big.NewInt(1), 63)),
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - block time overflow int64",
+			overrides: BlockOverrides{
+				Time: (*hexutil.Uint64)(func() *uint64 { v := uint64(1 << 63); return &v }()),
+			},
+			expPass: false,
+		},
+		{
+			name: "fail - negative base fee",
+			overrides: BlockOverrides{
+				BaseFee: (*hexutil.Big)(big.NewInt(-1)),
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.overrides.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -18,4 +18,6 @@ type EvmKeeper interface {
 	SetCodeHash(ctx sdk.Context, addr common.Address, codeHash common.Hash)
 	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
 	IsEmptyAccount(ctx sdk.Context, addr common.Address) bool
+	IsCustomPrecompiledContractCodeEnabled(ctx sdk.Context) bool
+	IsEnabledCustomPrecompiledContract(ctx sdk.Context, addr common.Address) bool
 }
@@ -17,6 +17,7 @@ import (
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	evmtypes "github.com/EscanBE/everlast/x/evm/types"
 	evmutils "github.com/EscanBE/everlast/x/evm/utils"
 )
@@ -41,6 +42,20 @@ type CStateDB interface {
 
 	DestroyAccount(acc common.Address)
 
+	// SetCustomPrecompiledContractGasPool sets the gas pool of the Custom Precompiled Contract being executed,
+	// which is the gas available to the dynamic gas of the call.
+	// The pool is initialized to the remaining gas of the transaction before execution.
+	SetCustomPrecompiledContractGasPool(gas uint64)
+	// GetCustomPrecompiledContractGasPool returns the remaining gas of the pool.
+	GetCustomPrecompiledContractGasPool() uint64
+	// ConsumeCustomPrecompiledContractGas consumes gas from the pool, returns false if the remaining gas is not enough.
+	ConsumeCustomPrecompiledContractGas(gas uint64) bool
+	// RefundCustomPrecompiledContractGas gives back the unused gas to the pool.
+	RefundCustomPrecompiledContractGas(gas uint64)
+	// GetCustomPrecompiledContractGasUsed returns the total dynamic gas consumed by the Custom Precompiled Contracts,
+	// which is charged to the transaction after execution.
+	GetCustomPrecompiledContractGasUsed() uint64
+
 	// Not yet available in current version of go-ethereum
 
 	Selfdestruct6780(address common.Address)
@@ -78,6 +93,8 @@ type cStateDb struct {
 	evmDenom    string
 	chainConfig *ethparams.ChainConfig
 
+	cpcCodeEnabled bool // enabled Custom Precompiled Contracts have pseudocode, decided by the CPC protocol version
+
 	// other revertible states
 
 	touched        AccountTracker // list of touched address, which then will be considered to remove if empty
@@ -87,6 +104,11 @@ type cStateDb struct {
 	logs           Logs
 	// Legacy TODO UPGRADE check code changes for transientStorage at https://github.com/ethereum/go-ethereum/blob/master/core/state/transient_storage.go
 	transientStorage TransientStorage
+
+	// non-revertible states
+
+	cpcGasPool uint64 // remaining gas of the Custom Precompiled Contract being executed
+	cpcGasUsed uint64 // dynamic gas consumed by the Custom Precompiled Contracts, gas consumed by reverted calls is not refunded
 }
 
 // preventCommit is a flag to prevent committing state changes to the underlying storage.
@@ -126,6 +148,8 @@ func NewStateDB(
 		evmDenom:    evmParams.EvmDenom,
 		chainConfig: evmParams.ChainConfig.EthereumConfig(ethKeeper.GetEip155ChainId(ctx).BigInt()),
 
+		cpcCodeEnabled: ethKeeper.IsCustomPrecompiledContractCodeEnabled(ctx),
+
 		touched:          newAccountTracker(),
 		refund:           0,
 		selfDestructed:   newAccountTracker(),
@@ -292,10 +316,18 @@ func (d *cStateDb) SetNonce(address common.Address, n uint64) {
 }
 
 func (d *cStateDb) GetCodeHash(address common.Address) common.Hash {
+	if d.isCustomPrecompiledContractWithCode(address) {
+		return cpctypes.PseudoCodePrecompiledHash
+	}
+
 	return d.evmKeeper.GetCodeHash(d.currentCtx, address.Bytes())
 }
 
 func (d *cStateDb) GetCode(address common.Address) []byte {
+	if d.isCustomPrecompiledContractWithCode(address) {
+		return cpctypes.PseudoCodePrecompiled
+	}
+
 	codeHash := d.GetCodeHash(address)
 	return d.evmKeeper.GetCode(d.currentCtx, codeHash)
 }
@@ -314,6 +346,12 @@ func (d *cStateDb) GetCodeSize(address common.Address) int {
 	return len(d.GetCode(address))
 }
 
+// isCustomPrecompiledContractWithCode returns true if the address is an enabled Custom Precompiled Contract
+// which must have pseudocode, so `EXTCODESIZE` and `EXTCODEHASH` treat it as a contract.
+func (d *cStateDb) isCustomPrecompiledContractWithCode(address common.Address) bool {
+	return d.cpcCodeEnabled && d.evmKeeper.IsEnabledCustomPrecompiledContract(d.currentCtx, address)
+}
+
 // AddRefund adds gas to the refund counter.
 // This method will panic if the refund counter goes above max uint64.
 func (d *cStateDb) AddRefund(gas uint64) {
@@ -333,6 +371,45 @@ func (d *cStateDb) SubRefund(gas uint64) {
 	d.refund -= gas
 }
 
+// SetCustomPrecompiledContractGasPool sets the gas pool of the Custom Precompiled Contract being executed.
+func (d *cStateDb) SetCustomPrecompiledContractGasPool(gas uint64) {
+	d.cpcGasPool = gas
+}
+
+// GetCustomPrecompiledContractGasPool returns the remaining gas of the pool.
+func (d *cStateDb) GetCustomPrecompiledContractGasPool() uint64 {
+	return d.cpcGasPool
+}
+
+// ConsumeCustomPrecompiledContractGas consumes gas from the pool, returns false if the remaining gas is not enough.
+func (d *cStateDb) ConsumeCustomPrecompiledContractGas(gas uint64) bool {
+	if d.cpcGasPool < gas {
+		return false
+	}
+	d.cpcGasPool -= gas
+	d.cpcGasUsed += gas
+	return true
+}
+
+// RefundCustomPrecompiledContractGas gives back the unused gas to the pool.
+// This method will panic if the pool goes above max uint64 or the refund is greater than the consumed gas.
+func (d *cStateDb) RefundCustomPrecompiledContractGas(gas uint64) {
+	newCpcGasPool := d.cpcGasPool + gas
+	if newCpcGasPool < gas {
+		panic(evmtypes.ErrEngineFailure.Wrapf("custom precompiled contract gas pool overflow"))
+	}
+	if d.cpcGasUsed < gas {
+		panic(evmtypes.ErrEngineFailure.Wrapf("custom precompiled contract gas refund greater than consumed %d/%d", gas, d.cpcGasUsed))
+	}
+	d.cpcGasPool = newCpcGasPool
+	d.cpcGasUsed -= gas
+}
+
+// GetCustomPrecompiledContractGasUsed returns the total dynamic gas consumed by the Custom Precompiled Contracts.
+func (d *cStateDb) GetCustomPrecompiledContractGasUsed() uint64 {
+	return d.cpcGasUsed
+}
+
 // GetRefund returns the current value of the refund counter.
 func (d *cStateDb) GetRefund() uint64 {
 	return d.refund
@@ -18,6 +18,7 @@ import (
 
 	"github.com/EscanBE/everlast/integration_test_util"
 	itutiltypes "github.com/EscanBE/everlast/integration_test_util/types"
+	cpctypes "github.com/EscanBE/everlast/x/cpc/types"
 	evmtypes "github.com/EscanBE/everlast/x/evm/types"
 	evmvm "github.com/EscanBE/everlast/x/evm/vm"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -797,6 +798,28 @@ func (suite *StateDbIntegrationTestSuite) TestGetCodeSize() {
 	suite.Equal(len(code2), stateDB.GetCodeSize(contract2WasNotExistsBefore.GetEthAddress()))
 }
 
+func (suite *StateDbIntegrationTestSuite) TestCodeOfCustomPrecompiledContract() {
+	suite.Require().GreaterOrEqual(suite.App().CpcKeeper().GetProtocolCpcVersion(suite.Ctx()), cpctypes.ProtocolCpcV2)
+
+	cpcAddr := cpctypes.CpcBankFixedAddress
+	suite.Require().NotNil(suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpcAddr))
+
+	stateDB := suite.newStateDB()
+	suite.Equal(cpctypes.PseudoCodePrecompiled, stateDB.GetCode(cpcAddr), "enabled custom precompiled contract must have pseudocode")
+	suite.Equal(len(cpctypes.PseudoCodePrecompiled), stateDB.GetCodeSize(cpcAddr))
+	suite.Equal(cpctypes.PseudoCodePrecompiledHash, stateDB.GetCodeHash(cpcAddr))
+
+	err := suite.App().CpcKeeper().UpdateCustomPrecompiledContractMeta(suite.Ctx(), cpcAddr, "", "", true)
+	suite.Require().NoError(err)
+
+	suite.Commit()
+
+	stateDB = suite.newStateDB()
+	suite.Empty(stateDB.GetCode(cpcAddr), "disabled custom precompiled contract must not have code")
+	suite.Zero(stateDB.GetCodeSize(cpcAddr))
+	suite.NotEqual(cpctypes.PseudoCodePrecompiledHash, stateDB.GetCodeHash(cpcAddr))
+}
+
 func (suite *StateDbIntegrationTestSuite) TestGetRefund() {
 	suite.CStateDB.AddRefund(1000)
 
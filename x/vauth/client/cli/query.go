@@ -22,7 +22,9 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		CmdQueryParams(),
 		CmdQueryProofExternalOwnedAccountByAddress(),
+		CmdQueryProofsExternalOwnedAccount(),
 	)
 
 	return cmd
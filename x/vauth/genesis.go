@@ -0,0 +1,34 @@
+package vauth
+
+import (
+	"fmt"
+
+	vauthkeeper "github.com/EscanBE/everlast/x/vauth/keeper"
+	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k vauthkeeper.Keeper,
+	data vauthtypes.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, proof := range data.ProofsExternalOwnedAccount {
+		if err := k.SaveProofExternalOwnedAccount(ctx, proof); err != nil {
+			panic(fmt.Errorf("error importing proof of external owned account %s: %s", proof.Account, err))
+		}
+	}
+}
+
+// ExportGenesis exports genesis state of the vauth module
+func ExportGenesis(ctx sdk.Context, k vauthkeeper.Keeper) vauthtypes.GenesisState {
+	return vauthtypes.GenesisState{
+		Params:                     k.GetParams(ctx),
+		ProofsExternalOwnedAccount: k.GetAllProofsExternalOwnedAccount(ctx),
+	}
+}
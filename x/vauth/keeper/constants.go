@@ -1,4 +0,0 @@
-package keeper
-
-// CostSubmitProofExternalOwnedAccount cost for each submission
-const CostSubmitProofExternalOwnedAccount int64 = 1e18
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"github.com/EscanBE/everlast/x/vauth"
+	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (s *KeeperTestSuite) TestGenesis_ExportImport() {
+	anotherPrivateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	anotherSignature, err := crypto.Sign(s.Hash(vauthtypes.MessageToSign), anotherPrivateKey)
+	s.Require().NoError(err)
+
+	proofs := []vauthtypes.ProofExternalOwnedAccount{
+		{
+			Account:   s.accAddr.String(),
+			Hash:      s.HashToStr(vauthtypes.MessageToSign),
+			Signature: s.SignToStr(vauthtypes.MessageToSign),
+		},
+		{
+			Account:   sdk.AccAddress(crypto.PubkeyToAddress(anotherPrivateKey.PublicKey).Bytes()).String(),
+			Hash:      s.HashToStr(vauthtypes.MessageToSign),
+			Signature: "0x" + hex.EncodeToString(anotherSignature),
+		},
+	}
+
+	for _, proof := range proofs {
+		s.Require().NoError(s.keeper.SaveProofExternalOwnedAccount(s.ctx, proof))
+	}
+
+	exported := vauth.ExportGenesis(s.ctx, s.keeper)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.ProofsExternalOwnedAccount, len(proofs))
+
+	// import into a fresh store
+	s.RefreshContext()
+	for _, proof := range proofs {
+		s.Require().False(s.keeper.HasProofExternalOwnedAccount(s.ctx, sdk.MustAccAddressFromBech32(proof.Account)))
+	}
+
+	vauth.InitGenesis(s.ctx, s.keeper, exported)
+
+	for _, proof := range proofs {
+		gotProof := s.keeper.GetProofExternalOwnedAccount(s.ctx, sdk.MustAccAddressFromBech32(proof.Account))
+		s.Require().NotNil(gotProof)
+		s.Equal(proof, *gotProof)
+	}
+
+	reExported := vauth.ExportGenesis(s.ctx, s.keeper)
+	s.Equal(exported, reExported)
+
+	s.Run("import invalid proof must panic", func() {
+		s.RefreshContext()
+
+		s.Require().Panics(func() {
+			vauth.InitGenesis(s.ctx, s.keeper, vauthtypes.GenesisState{
+				ProofsExternalOwnedAccount: []vauthtypes.ProofExternalOwnedAccount{{Account: s.accAddr.String()}},
+			})
+		})
+	})
+}
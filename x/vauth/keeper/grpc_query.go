@@ -4,7 +4,9 @@ import (
 	"context"
 	"strings"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -48,3 +50,41 @@ func (q queryServer) ProofExternalOwnedAccount(goCtx context.Context, req *vauth
 		Proof: *proof,
 	}, nil
 }
+
+// ProofsExternalOwnedAccount returns the list of proofs of external owned account (EOA)
+func (q queryServer) ProofsExternalOwnedAccount(goCtx context.Context, req *vauthtypes.QueryProofsExternalOwnedAccountRequest) (*vauthtypes.QueryProofsExternalOwnedAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var proofs []vauthtypes.ProofExternalOwnedAccount
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), vauthtypes.KeyPrefixProofExternalOwnedAccount)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var proof vauthtypes.ProofExternalOwnedAccount
+		if err := q.cdc.Unmarshal(value, &proof); err != nil {
+			return err
+		}
+
+		proofs = append(proofs, proof)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &vauthtypes.QueryProofsExternalOwnedAccountResponse{
+		Proofs:     proofs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Params returns the parameters of the module
+func (q queryServer) Params(goCtx context.Context, _ *vauthtypes.QueryParamsRequest) (*vauthtypes.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &vauthtypes.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
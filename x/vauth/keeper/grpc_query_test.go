@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"strings"
 
 	vauthkeeper "github.com/EscanBE/everlast/x/vauth/keeper"
 	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 func (s *KeeperTestSuite) Test_queryServer_ProofExternalOwnedAccount() {
@@ -68,3 +72,70 @@ func (s *KeeperTestSuite) Test_queryServer_ProofExternalOwnedAccount() {
 		s.Require().Nil(resp)
 	})
 }
+
+func (s *KeeperTestSuite) Test_queryServer_ProofsExternalOwnedAccount() {
+	queryServer := vauthkeeper.NewQueryServerImpl(s.keeper)
+
+	s.Run("pass - no proof", func() {
+		resp, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{})
+		s.Require().NoError(err)
+		s.Empty(resp.Proofs)
+	})
+
+	var proofs []vauthtypes.ProofExternalOwnedAccount
+	for i := 0; i < 3; i++ {
+		privateKey, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+		signature, err := crypto.Sign(s.Hash(vauthtypes.MessageToSign), privateKey)
+		s.Require().NoError(err)
+
+		proof := vauthtypes.ProofExternalOwnedAccount{
+			Account:   sdk.AccAddress(crypto.PubkeyToAddress(privateKey.PublicKey).Bytes()).String(),
+			Hash:      s.HashToStr(vauthtypes.MessageToSign),
+			Signature: "0x" + hex.EncodeToString(signature),
+		}
+		s.Require().NoError(s.keeper.SaveProofExternalOwnedAccount(s.ctx, proof))
+		proofs = append(proofs, proof)
+	}
+
+	s.Run("pass - query all proofs", func() {
+		resp, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{})
+		s.Require().NoError(err)
+		s.ElementsMatch(proofs, resp.Proofs)
+	})
+
+	s.Run("pass - query with pagination", func() {
+		resp, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{
+			Pagination: &query.PageRequest{
+				Limit:      2,
+				CountTotal: true,
+			},
+		})
+		s.Require().NoError(err)
+		s.Len(resp.Proofs, 2)
+		s.Equal(uint64(3), resp.Pagination.Total)
+		s.Require().NotEmpty(resp.Pagination.NextKey)
+
+		resp2, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{
+			Pagination: &query.PageRequest{
+				Key: resp.Pagination.NextKey,
+			},
+		})
+		s.Require().NoError(err)
+		s.Len(resp2.Proofs, 1)
+		s.Empty(resp2.Pagination.NextKey)
+
+		s.ElementsMatch(proofs, append(resp.Proofs, resp2.Proofs...))
+	})
+
+	s.Run("fail - empty request", func() {
+		_, err := queryServer.ProofsExternalOwnedAccount(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) Test_queryServer_Params() {
+	resp, err := vauthkeeper.NewQueryServerImpl(s.keeper).Params(s.ctx, &vauthtypes.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Equal(vauthtypes.DefaultParams(), resp.Params)
+}
@@ -15,24 +15,31 @@ import (
 
 // Keeper of the VAuth store
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   storetypes.StoreKey
-	bankKeeper bankkeeper.Keeper
-	evmKeeper  evmkeeper.Keeper
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority   sdk.AccAddress
+	bankKeeper  bankkeeper.Keeper
+	distrKeeper vauthtypes.DistrKeeper
+	evmKeeper   evmkeeper.Keeper
 }
 
 // NewKeeper returns a new instance of the VAuth keeper
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	authority sdk.AccAddress,
 	bk bankkeeper.Keeper,
+	dk vauthtypes.DistrKeeper,
 	ek evmkeeper.Keeper,
 ) Keeper {
 	return Keeper{
-		cdc:        cdc,
-		storeKey:   key,
-		bankKeeper: bk,
-		evmKeeper:  ek,
+		cdc:         cdc,
+		storeKey:    key,
+		authority:   authority,
+		bankKeeper:  bk,
+		distrKeeper: dk,
+		evmKeeper:   ek,
 	}
 }
 
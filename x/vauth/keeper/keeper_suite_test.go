@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
 	"testing"
@@ -34,6 +35,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -47,7 +49,8 @@ type KeeperTestSuite struct {
 	chainId string
 	now     time.Time
 
-	cdc codec.BinaryCodec
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
 
 	// keepers
 	authKeeper authkeeper.AccountKeeper
@@ -79,12 +82,14 @@ func (s *KeeperTestSuite) SetupTest() {
 	var ek evmkeeper.Keeper
 	var vk vauthkeeper.Keeper
 
+	var vAuthStoreKey *storetypes.KVStoreKey
+
 	{
 		// initialization
 		authStoreKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
 		bankStoreKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
 		evmStoreKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
-		vAuthStoreKey := storetypes.NewKVStoreKey(vauthtypes.StoreKey)
+		vAuthStoreKey = storetypes.NewKVStoreKey(vauthtypes.StoreKey)
 
 		db := sdkdb.NewMemDB()
 		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
@@ -113,6 +118,7 @@ func (s *KeeperTestSuite) SetupTest() {
 				banktypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
 				evmtypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
 				vauthtypes.ModuleName: {authtypes.Burner},
+				distrtypes.ModuleName: nil,
 			},
 			address.NewBech32Codec(constants.Bech32PrefixAccAddr),
 			constants.Bech32PrefixAccAddr,
@@ -146,7 +152,9 @@ func (s *KeeperTestSuite) SetupTest() {
 		vk = vauthkeeper.NewKeeper(
 			cdc,
 			vAuthStoreKey,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
 			bk,
+			mockDistrKeeper{bankKeeper: bk},
 			ek,
 		)
 
@@ -162,6 +170,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.savedCtx = sdk.Context{}
 	s.ctx = ctx.WithBlockTime(s.now).WithChainID(constants.DevnetChainID)
 	s.cdc = cdc
+	s.storeKey = vAuthStoreKey
 	s.authKeeper = ak
 	s.bankKeeper = bk
 	s.evmKeeper = ek
@@ -175,6 +184,9 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.Require().NoError(
 		s.evmKeeper.SetParams(s.ctx, evmtypes.DefaultParams()),
 	)
+	s.Require().NoError(
+		s.keeper.SetParams(s.ctx, vauthtypes.DefaultParams()),
+	)
 
 	s.SaveCurrentContext()
 }
@@ -238,6 +250,17 @@ func (s *KeeperTestSuite) mintToAccount(accAddr sdk.AccAddress, coins sdk.Coins)
 	s.Require().NoError(err)
 }
 
+var _ vauthtypes.DistrKeeper = mockDistrKeeper{}
+
+// mockDistrKeeper funds the community pool by sending coins to the distribution module account.
+type mockDistrKeeper struct {
+	bankKeeper bankkeeper.Keeper
+}
+
+func (m mockDistrKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
+
 func init() {
 	cfg := sdk.GetConfig()
 	cmdcfg.SetBech32Prefixes(cfg)
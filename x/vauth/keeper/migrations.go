@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// It sets the params, keeping the submission fee which was hard-coded and charged in EVM denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := vauthtypes.DefaultParams()
+	params.SubmissionFeeDenom = m.keeper.evmKeeper.GetParams(ctx).EvmDenom
+
+	return m.keeper.SetParams(ctx, params)
+}
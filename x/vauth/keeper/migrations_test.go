@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"github.com/EscanBE/everlast/constants"
+	evmtypes "github.com/EscanBE/everlast/x/evm/types"
+	vauthkeeper "github.com/EscanBE/everlast/x/vauth/keeper"
+	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+)
+
+func (s *KeeperTestSuite) TestMigrator_Migrate1to2() {
+	// params did not exist before the migration
+	s.ctx.KVStore(s.storeKey).Delete(vauthtypes.KeyPrefixParams)
+
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EvmDenom = "uevm"
+	s.Require().NoError(s.evmKeeper.SetParams(s.ctx, evmParams))
+
+	err := vauthkeeper.NewMigrator(s.keeper).Migrate1to2(s.ctx)
+	s.Require().NoError(err)
+
+	params := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(params.Validate())
+	s.Equal(vauthtypes.DefaultParams().SubmissionFee, params.SubmissionFee)
+	s.Equal("uevm", params.SubmissionFeeDenom, "submission fee must be charged in EVM denom as before")
+	s.NotEqual(constants.BaseDenom, params.SubmissionFeeDenom)
+	s.False(params.SubmissionFeeToCommunityPool)
+}
@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
 	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ vauthtypes.MsgServer = msgServer{}
@@ -15,3 +20,19 @@ type msgServer struct {
 func NewMsgServerImpl(keeper Keeper) vauthtypes.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (m msgServer) UpdateParams(goCtx context.Context, req *vauthtypes.MsgUpdateParams) (*vauthtypes.MsgUpdateParamsResponse, error) {
+	if m.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, req.NewParams); err != nil {
+		return nil, err
+	}
+
+	return &vauthtypes.MsgUpdateParamsResponse{}, nil
+}
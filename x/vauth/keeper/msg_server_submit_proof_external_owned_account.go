@@ -27,19 +27,24 @@ func (m msgServer) SubmitProofExternalOwnedAccount(goCtx context.Context, msg *v
 
 	// charge fee
 
-	evmParams := m.evmKeeper.GetParams(ctx)
-	fees := sdk.NewCoins(sdk.NewInt64Coin(evmParams.EvmDenom, CostSubmitProofExternalOwnedAccount))
-	err := m.bankKeeper.SendCoinsFromAccountToModule(
-		ctx,
-		sdk.MustAccAddressFromBech32(msg.Submitter), vauthtypes.ModuleName,
-		fees,
-	)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to deduct fee from submitter")
-	}
-	err = m.bankKeeper.BurnCoins(ctx, vauthtypes.ModuleName, fees)
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to burn coin from module account: %s", vauthtypes.ModuleName)
+	params := m.GetParams(ctx)
+	if fees := params.SubmissionFeeCoins(); !fees.IsZero() {
+		submitterAccAddr := sdk.MustAccAddressFromBech32(msg.Submitter)
+
+		if params.SubmissionFeeToCommunityPool {
+			if err := m.distrKeeper.FundCommunityPool(ctx, fees, submitterAccAddr); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to fund community pool from submitter")
+			}
+		} else {
+			err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, submitterAccAddr, vauthtypes.ModuleName, fees)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to deduct fee from submitter")
+			}
+			err = m.bankKeeper.BurnCoins(ctx, vauthtypes.ModuleName, fees)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "failed to burn coin from module account: %s", vauthtypes.ModuleName)
+			}
+		}
 	}
 
 	// persist
@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
 	"github.com/EscanBE/everlast/constants"
 
 	vauthkeeper "github.com/EscanBE/everlast/x/vauth/keeper"
 	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 //goland:noinspection SpellCheckingInspection
@@ -27,7 +30,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: vauthtypes.DefaultSubmissionFee,
 			wantErr:          false,
 			postRunFunc: func(s *KeeperTestSuite) {
 				s.Require().True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
@@ -41,6 +44,65 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				s.Nil(s.keeper.GetProofExternalOwnedAccount(s.ctx, s.submitterAccAddr))
 			},
 		},
+		{
+			name: "pass - fee is funded to community pool",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			submitterBalance: vauthtypes.DefaultSubmissionFee,
+			preRunFunc: func(s *KeeperTestSuite) {
+				params := s.keeper.GetParams(s.ctx)
+				params.SubmissionFeeToCommunityPool = true
+				s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+			},
+			wantErr: false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.Require().True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+
+				s.True(s.bankKeeper.GetBalance(s.ctx, s.submitterAccAddr, constants.BaseDenom).IsZero())
+				communityPool := s.bankKeeper.GetBalance(s.ctx, authtypes.NewModuleAddress(distrtypes.ModuleName), constants.BaseDenom)
+				s.Equal(sdkmath.NewInt(vauthtypes.DefaultSubmissionFee), communityPool.Amount)
+			},
+		},
+		{
+			name: "pass - no fee is charged when submission fee is zero",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			submitterBalance: 0,
+			preRunFunc: func(s *KeeperTestSuite) {
+				params := s.keeper.GetParams(s.ctx)
+				params.SubmissionFee = sdkmath.ZeroInt()
+				s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+			},
+			wantErr: false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.Require().True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
+		{
+			name: "fail - insufficient balance of the submission fee denom",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			submitterBalance: vauthtypes.DefaultSubmissionFee,
+			preRunFunc: func(s *KeeperTestSuite) {
+				params := s.keeper.GetParams(s.ctx)
+				params.SubmissionFeeDenom = "uother"
+				s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+			},
+			wantErr:         true,
+			wantErrContains: "failed to deduct fee from submitter",
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
 		{
 			name: "fail - can not proof twice",
 			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
@@ -48,7 +110,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: vauthtypes.DefaultSubmissionFee,
 			preRunFunc: func(s *KeeperTestSuite) {
 				err := s.keeper.SaveProofExternalOwnedAccount(s.ctx, vauthtypes.ProofExternalOwnedAccount{
 					Account:   s.accAddr.String(),
@@ -72,7 +134,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr("invalid"),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: vauthtypes.DefaultSubmissionFee,
 			wantErr:          true,
 			wantErrContains:  errors.ErrInvalidRequest.Error(),
 			postRunFunc: func(s *KeeperTestSuite) {
@@ -87,7 +149,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: vauthtypes.DefaultSubmissionFee,
 			wantErr:          true,
 			wantErrContains:  errors.ErrInvalidRequest.Error(),
 			postRunFunc: func(s *KeeperTestSuite) {
@@ -102,7 +164,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   "evl13zqksjwyjdvtzqjhed2m9r4xq0y8fvz7wc9mdx",
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: vauthtypes.DefaultSubmissionFee,
 			wantErr:          true,
 			wantErrContains:  errors.ErrInvalidRequest.Error(),
 			postRunFunc: func(s *KeeperTestSuite) {
@@ -117,7 +179,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount - 1,
+			submitterBalance: vauthtypes.DefaultSubmissionFee - 1,
 			wantErr:          true,
 			wantErrContains:  "failed to deduct fee from submitter",
 			postRunFunc: func(s *KeeperTestSuite) {
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	vauthkeeper "github.com/EscanBE/everlast/x/vauth/keeper"
+	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) Test_msgServer_UpdateParams() {
+	newParams := vauthtypes.Params{
+		SubmissionFee:                sdkmath.NewInt(1e9),
+		SubmissionFeeDenom:           "uother",
+		SubmissionFeeToCommunityPool: true,
+	}
+
+	tests := []struct {
+		name            string
+		msg             *vauthtypes.MsgUpdateParams
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - update params",
+			msg: &vauthtypes.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				NewParams: newParams,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - invalid authority",
+			msg: &vauthtypes.MsgUpdateParams{
+				Authority: s.submitterAccAddr.String(),
+				NewParams: newParams,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid authority",
+		},
+		{
+			name: "fail - invalid params",
+			msg: &vauthtypes.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				NewParams: vauthtypes.Params{
+					SubmissionFee: sdkmath.NewInt(1),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "invalid submission fee denom",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.RefreshContext()
+
+			resp, err := vauthkeeper.NewMsgServerImpl(s.keeper).UpdateParams(s.ctx, tt.msg)
+
+			if tt.wantErr {
+				s.Require().ErrorContains(err, tt.wantErrContains)
+				s.Nil(resp)
+				s.Equal(vauthtypes.DefaultParams(), s.keeper.GetParams(s.ctx))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(resp)
+			s.Equal(tt.msg.NewParams, s.keeper.GetParams(s.ctx))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns module parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params vauthtypes.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(vauthtypes.KeyPrefixParams)
+	if len(bz) != 0 {
+		k.cdc.MustUnmarshal(bz, &params)
+	}
+	return
+}
+
+// SetParams sets module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params vauthtypes.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(vauthtypes.KeyPrefixParams, bz)
+	return nil
+}
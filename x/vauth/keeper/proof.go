@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	storetypes "cosmossdk.io/store/types"
 	vauthtypes "github.com/EscanBE/everlast/x/vauth/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -42,3 +43,23 @@ func (k Keeper) HasProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAdd
 	key := vauthtypes.KeyProofExternalOwnedAccountByAddress(accAddr)
 	return store.Has(key)
 }
+
+// GetAllProofsExternalOwnedAccount returns all the proofs from KVStore.
+func (k Keeper) GetAllProofsExternalOwnedAccount(ctx sdk.Context) []vauthtypes.ProofExternalOwnedAccount {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vauthtypes.KeyPrefixProofExternalOwnedAccount)
+
+	var proofs []vauthtypes.ProofExternalOwnedAccount
+
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proof vauthtypes.ProofExternalOwnedAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &proof)
+		proofs = append(proofs, proof)
+	}
+
+	return proofs
+}
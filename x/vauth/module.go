@@ -3,6 +3,7 @@ package vauth
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -59,8 +60,12 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 }
 
 // ValidateGenesis used to validate the GenesisState, given in its json.RawMessage form
-func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, _ json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, data json.RawMessage) error {
+	var genesisState vauthtypes.GenesisState
+	if err := cdc.UnmarshalJSON(data, &genesisState); err != nil {
+		return err
+	}
+	return genesisState.Validate()
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module
@@ -104,6 +109,11 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	vauthtypes.RegisterMsgServer(cfg.MsgServer(), vauthkeeper.NewMsgServerImpl(am.keeper))
 	vauthtypes.RegisterQueryServer(cfg.QueryServer(), vauthkeeper.NewQueryServerImpl(am.keeper))
+
+	m := vauthkeeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(vauthtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to migrate %s: %w", vauthtypes.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
@@ -111,17 +121,21 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 }
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
-func (am AppModule) InitGenesis(_ sdk.Context, _ codec.JSONCodec, _ json.RawMessage) []abci.ValidatorUpdate {
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState vauthtypes.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the module's exported genesis state as raw JSON bytes.
-func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(vauthtypes.DefaultGenesis())
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	exportedGenesisState := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(&exportedGenesisState)
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 func (am AppModule) IsOnePerModuleType() {
 }
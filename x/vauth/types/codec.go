@@ -10,6 +10,7 @@ import (
 // RegisterCodec registers the necessary types and interfaces for the module
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitProofExternalOwnedAccount{}, "vauth/SubmitProofExternalOwnedAccount", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "vauth/UpdateParams", nil)
 }
 
 // RegisterInterfaces registers implementations by its interface, for the module
@@ -17,6 +18,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgSubmitProofExternalOwnedAccount{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,12 +1,46 @@
 package types
 
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (m GenesisState) Validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+
+	expectedHash := "0x" + hex.EncodeToString(crypto.Keccak256([]byte(MessageToSign)))
+
+	uniqueAccounts := make(map[string]struct{})
+	for _, proof := range m.ProofsExternalOwnedAccount {
+		// re-verify the signature against the MessageToSign
+		if err := proof.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid proof of external owned account: %s", proof.Account)
+		}
+
+		if proof.Hash != expectedHash {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "hash of proof must be hash of the message to sign, account %s", proof.Account)
+		}
+
+		if _, exists := uniqueAccounts[proof.Account]; exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate proof of external owned account: %s", proof.Account)
+		}
+		uniqueAccounts[proof.Account] = struct{}{}
+	}
+
 	return nil
 }
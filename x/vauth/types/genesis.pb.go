@@ -25,6 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
+	// proofs_external_owned_account is the list of the proofs that accounts are external owned account (EOA).
+	ProofsExternalOwnedAccount []ProofExternalOwnedAccount `protobuf:"bytes,1,rep,name=proofs_external_owned_account,json=proofsExternalOwnedAccount,proto3" json:"proofs_external_owned_account"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -60,6 +64,20 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetProofsExternalOwnedAccount() []ProofExternalOwnedAccount {
+	if m != nil {
+		return m.ProofsExternalOwnedAccount
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "everlast.vauth.v1.GenesisState")
 }
@@ -67,17 +85,24 @@ func init() {
 func init() { proto.RegisterFile("everlast/vauth/v1/genesis.proto", fileDescriptor_0257aca7c561e378) }
 
 var fileDescriptor_0257aca7c561e378 = []byte{
-	// 156 bytes of a gzipped FileDescriptorProto
+	// 259 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2d, 0x4b, 0x2d,
 	0xca, 0x49, 0x2c, 0x2e, 0xd1, 0x2f, 0x4b, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
 	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x29, 0xd0,
 	0x03, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x4a, 0x7c, 0x5c, 0x3c, 0xee, 0x10, 0x9d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x4e, 0xce,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x99, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x5a, 0x9c, 0x9c, 0x98, 0xe7, 0xe4, 0xaa, 0x0f, 0x77,
-	0x46, 0x05, 0xd4, 0x21, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xb3, 0x8d, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x98, 0x65, 0x62, 0xcb, 0xa7, 0x00, 0x00, 0x00,
+	0x10, 0x85, 0x52, 0xb2, 0x98, 0x26, 0x41, 0x74, 0x80, 0xa5, 0x95, 0xf6, 0x31, 0x72, 0xf1, 0xb8,
+	0x43, 0x4c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x2a, 0xe5, 0x92, 0x2d, 0x28, 0xca, 0xcf, 0x4f,
+	0x2b, 0x8e, 0x4f, 0xad, 0x28, 0x49, 0x2d, 0xca, 0x4b, 0xcc, 0x89, 0xcf, 0x2f, 0xcf, 0x4b, 0x4d,
+	0x89, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0xd2,
+	0xd1, 0xc3, 0x70, 0x80, 0x5e, 0x00, 0x48, 0x9f, 0x2b, 0x54, 0x97, 0x3f, 0x48, 0x93, 0x23, 0x44,
+	0x8f, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x52, 0x10, 0x83, 0xb1, 0xa9, 0x10, 0x32, 0xe7,
+	0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xc4,
+	0x66, 0x3e, 0x58, 0x01, 0xd4, 0x30, 0xa8, 0x72, 0x27, 0xe7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
+	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
+	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
+	0x77, 0x2d, 0x4e, 0x4e, 0xcc, 0x73, 0x72, 0xd5, 0x87, 0x07, 0x46, 0x05, 0x34, 0x38, 0x4a, 0x2a,
+	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x61, 0x0c, 0x18, 0x00, 0xdb, 0x6c, 0xf5, 0x19, 0x77,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -100,6 +125,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProofsExternalOwnedAccount) > 0 {
+		for iNdEx := len(m.ProofsExternalOwnedAccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofsExternalOwnedAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +169,14 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.ProofsExternalOwnedAccount) > 0 {
+		for _, e := range m.ProofsExternalOwnedAccount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -158,6 +215,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsExternalOwnedAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofsExternalOwnedAccount = append(m.ProofsExternalOwnedAccount, ProofExternalOwnedAccount{})
+			if err := m.ProofsExternalOwnedAccount[len(m.ProofsExternalOwnedAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
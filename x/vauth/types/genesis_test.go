@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+//goland:noinspection SpellCheckingInspection
+func TestGenesisState_Validate(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA("fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19")
+	require.NoError(t, err)
+
+	signature, err := crypto.Sign(crypto.Keccak256([]byte(MessageToSign)), privateKey)
+	require.NoError(t, err)
+
+	validProof := func() ProofExternalOwnedAccount {
+		return ProofExternalOwnedAccount{
+			Account:   "evl1jcsksjwyjdvtzqjhed2m9r4xq0y8fvz7f7mr5s",
+			Hash:      common.BytesToHash(crypto.Keccak256([]byte(MessageToSign))).String(),
+			Signature: "0x" + hex.EncodeToString(signature),
+		}
+	}
+
+	tests := []struct {
+		name            string
+		genesis         GenesisState
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "pass - default genesis",
+			genesis: *DefaultGenesis(),
+			wantErr: false,
+		},
+		{
+			name: "pass - valid proof",
+			genesis: GenesisState{
+				Params:                     DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{validProof()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - invalid params",
+			genesis: GenesisState{
+				Params: Params{
+					SubmissionFee:      sdkmath.NewInt(-1),
+					SubmissionFeeDenom: "aevl",
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "submission fee cannot be nil or negative",
+		},
+		{
+			name: "fail - duplicate proof",
+			genesis: GenesisState{
+				Params:                     DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{validProof(), validProof()},
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate proof of external owned account",
+		},
+		{
+			name: "fail - signature does not match the account",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{func() ProofExternalOwnedAccount {
+					proof := validProof()
+					proof.Account = "evl13zqksjwyjdvtzqjhed2m9r4xq0y8fvz7wc9mdx"
+					return proof
+				}()},
+			},
+			wantErr:         true,
+			wantErrContains: "mis-match signature with provided address",
+		},
+		{
+			name: "fail - hash is not hash of the message to sign",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{func() ProofExternalOwnedAccount {
+					proof := validProof()
+					proof.Hash = common.BytesToHash(crypto.Keccak256([]byte("another"))).String()
+					return proof
+				}()},
+			},
+			wantErr:         true,
+			wantErrContains: "hash of proof must be hash of the message to sign",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.genesis.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -16,9 +16,13 @@ const (
 // prefix bytes for the VAuth persistent store.
 const (
 	prefixProofExternalOwnedAccount = iota + 1
+	prefixParams
 )
 
-var KeyPrefixProofExternalOwnedAccount = []byte{prefixProofExternalOwnedAccount}
+var (
+	KeyPrefixProofExternalOwnedAccount = []byte{prefixProofExternalOwnedAccount}
+	KeyPrefixParams                    = []byte{prefixParams}
+)
 
 func KeyProofExternalOwnedAccountByAddress(accAddr sdk.AccAddress) []byte {
 	return append(KeyPrefixProofExternalOwnedAccount, accAddr.Bytes()...)
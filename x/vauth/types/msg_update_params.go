@@ -0,0 +1,21 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// ValidateBasic performs basic validation for the MsgUpdateParams.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidAddress, err), "invalid authority address: %s", m.Authority)
+	}
+
+	return m.NewParams.Validate()
+}
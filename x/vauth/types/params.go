@@ -0,0 +1,40 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/EscanBE/everlast/constants"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultSubmissionFee is the default cost for each submission of the proof of external owned account.
+const DefaultSubmissionFee int64 = 1e18
+
+func DefaultParams() Params {
+	return Params{
+		SubmissionFee:                sdkmath.NewInt(DefaultSubmissionFee),
+		SubmissionFeeDenom:           constants.BaseDenom,
+		SubmissionFeeToCommunityPool: false,
+	}
+}
+
+func (m Params) Validate() error {
+	if m.SubmissionFee.IsNil() || m.SubmissionFee.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "submission fee cannot be nil or negative")
+	}
+
+	if err := sdk.ValidateDenom(m.SubmissionFeeDenom); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "invalid submission fee denom: %s", m.SubmissionFeeDenom)
+	}
+
+	return nil
+}
+
+// SubmissionFeeCoins returns the fee for each submission of the proof of external owned account.
+func (m Params) SubmissionFeeCoins() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(m.SubmissionFeeDenom, m.SubmissionFee))
+}
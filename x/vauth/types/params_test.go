@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		params          Params
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "pass - default params",
+			params:  DefaultParams(),
+			wantErr: false,
+		},
+		{
+			name: "pass - zero submission fee",
+			params: Params{
+				SubmissionFee:      sdkmath.ZeroInt(),
+				SubmissionFeeDenom: "aevl",
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - fund community pool",
+			params: Params{
+				SubmissionFee:                sdkmath.NewInt(1),
+				SubmissionFeeDenom:           "aevl",
+				SubmissionFeeToCommunityPool: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - nil submission fee",
+			params: Params{
+				SubmissionFeeDenom: "aevl",
+			},
+			wantErr:         true,
+			wantErrContains: "submission fee cannot be nil or negative",
+		},
+		{
+			name: "fail - negative submission fee",
+			params: Params{
+				SubmissionFee:      sdkmath.NewInt(-1),
+				SubmissionFeeDenom: "aevl",
+			},
+			wantErr:         true,
+			wantErrContains: "submission fee cannot be nil or negative",
+		},
+		{
+			name: "fail - empty submission fee denom",
+			params: Params{
+				SubmissionFee: sdkmath.NewInt(1),
+			},
+			wantErr:         true,
+			wantErrContains: "invalid submission fee denom",
+		},
+		{
+			name: "fail - invalid submission fee denom",
+			params: Params{
+				SubmissionFee:      sdkmath.NewInt(1),
+				SubmissionFeeDenom: "1",
+			},
+			wantErr:         true,
+			wantErrContains: "invalid submission fee denom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
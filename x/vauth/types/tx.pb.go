@@ -136,38 +136,140 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitProofExternalOwnedAccountResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines a Msg for updating the x/vauth module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// new_params for the x/vauth module.
+	NewParams Params `protobuf:"bytes,2,opt,name=new_params,json=newParams,proto3" json:"new_params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d95ded4300ad4b34, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetNewParams() Params {
+	if m != nil {
+		return m.NewParams
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d95ded4300ad4b34, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProofExternalOwnedAccount)(nil), "everlast.vauth.v1.MsgSubmitProofExternalOwnedAccount")
 	proto.RegisterType((*MsgSubmitProofExternalOwnedAccountResponse)(nil), "everlast.vauth.v1.MsgSubmitProofExternalOwnedAccountResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "everlast.vauth.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "everlast.vauth.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("everlast/vauth/v1/tx.proto", fileDescriptor_d95ded4300ad4b34) }
 
 var fileDescriptor_d95ded4300ad4b34 = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x41, 0x4b, 0xeb, 0x40,
-	0x10, 0xc7, 0xbb, 0x2d, 0xbc, 0x47, 0xf7, 0xf0, 0xe0, 0x05, 0xc1, 0x18, 0x4a, 0x94, 0x9c, 0xb4,
-	0x48, 0x96, 0x2a, 0x1e, 0x54, 0x3c, 0xb4, 0xd2, 0x63, 0x51, 0xea, 0xcd, 0x4b, 0xd8, 0xc6, 0xed,
-	0x36, 0xd0, 0xec, 0x84, 0xec, 0x26, 0xd6, 0x6b, 0x8f, 0x9e, 0x04, 0xbf, 0x88, 0x1f, 0xc3, 0x63,
-	0xc1, 0x8b, 0x78, 0x92, 0x54, 0xf0, 0x6b, 0x48, 0xb2, 0x09, 0x3d, 0x28, 0x14, 0xbc, 0xed, 0xcc,
-	0x6f, 0xe6, 0x3f, 0xb3, 0xf3, 0xc7, 0x16, 0x4b, 0x59, 0x3c, 0xa5, 0x52, 0x91, 0x94, 0x26, 0x6a,
-	0x42, 0xd2, 0x0e, 0x51, 0x33, 0x37, 0x8a, 0x41, 0x81, 0xf1, 0xbf, 0x62, 0x6e, 0xc1, 0xdc, 0xb4,
-	0x63, 0x6d, 0x70, 0xe0, 0x50, 0x50, 0x92, 0xbf, 0x74, 0xa1, 0xd5, 0xe2, 0x00, 0x7c, 0xca, 0x08,
-	0x8d, 0x02, 0x42, 0x85, 0x00, 0x45, 0x55, 0x00, 0x42, 0x96, 0x74, 0xd3, 0x07, 0x19, 0x82, 0x24,
-	0xa1, 0xe4, 0xb9, 0x7c, 0x28, 0x79, 0x09, 0xb6, 0x34, 0xf0, 0xb4, 0x9e, 0x0e, 0x34, 0x72, 0xee,
-	0x11, 0x76, 0x06, 0x92, 0x5f, 0x25, 0xa3, 0x30, 0x50, 0x97, 0x31, 0xc0, 0xb8, 0x3f, 0x53, 0x2c,
-	0x16, 0x74, 0x7a, 0x71, 0x2b, 0xd8, 0x4d, 0xd7, 0xf7, 0x21, 0x11, 0xca, 0x68, 0xe1, 0xa6, 0x2c,
-	0x4a, 0x14, 0x8b, 0x4d, 0xb4, 0x83, 0x76, 0x9b, 0xc3, 0x55, 0xc2, 0x30, 0xf1, 0x5f, 0xaa, 0x0b,
-	0xcd, 0x7a, 0xc1, 0xaa, 0xb0, 0xe8, 0x0b, 0xb8, 0xa0, 0x2a, 0x89, 0x99, 0xd9, 0x28, 0xfb, 0xaa,
-	0xc4, 0xc9, 0xbf, 0xf9, 0xe7, 0x53, 0x7b, 0xa5, 0xe3, 0xec, 0xe3, 0xf6, 0xfa, 0x5d, 0x86, 0x4c,
-	0x46, 0x20, 0x24, 0x3b, 0xc8, 0x10, 0x6e, 0x0c, 0x24, 0x37, 0xde, 0x10, 0xde, 0x5e, 0xb7, 0xff,
-	0x91, 0xfb, 0xed, 0xc4, 0xee, 0xfa, 0x51, 0xd6, 0xd9, 0xaf, 0xda, 0xaa, 0x0d, 0x9d, 0xee, 0xfc,
-	0xe5, 0xe3, 0xb1, 0x7e, 0x6a, 0x1c, 0x93, 0x9f, 0xcc, 0x27, 0xfa, 0xe3, 0xb9, 0x2b, 0x30, 0xf6,
-	0x58, 0x29, 0xe4, 0x41, 0xae, 0xe4, 0x95, 0x07, 0xec, 0x9d, 0x3f, 0x67, 0x36, 0x5a, 0x64, 0x36,
-	0x7a, 0xcf, 0x6c, 0xf4, 0xb0, 0xb4, 0x6b, 0x8b, 0xa5, 0x5d, 0x7b, 0x5d, 0xda, 0xb5, 0xeb, 0x3d,
-	0x1e, 0xa8, 0x49, 0x32, 0x72, 0x7d, 0x08, 0x49, 0x5f, 0xfa, 0x54, 0xf4, 0xfa, 0xab, 0x31, 0xb3,
-	0x72, 0x90, 0xba, 0x8b, 0x98, 0x1c, 0xfd, 0x29, 0xbc, 0x3e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0x8d, 0xf9, 0xa8, 0x2a, 0x84, 0x02, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x6e, 0xba, 0xe2, 0xd2, 0x51, 0x14, 0xc3, 0xc2, 0xa6, 0x61, 0xcd, 0x4a, 0x4e, 0x5a, 0x34,
+	0xc3, 0xae, 0x28, 0xa8, 0x28, 0xb4, 0xd2, 0x63, 0x71, 0xe9, 0xe2, 0xc5, 0x83, 0x61, 0x9a, 0xce,
+	0xce, 0x06, 0x9a, 0x79, 0x61, 0xde, 0xa4, 0xed, 0x5e, 0xf7, 0xe8, 0x49, 0xf0, 0xe4, 0xbf, 0xf0,
+	0xe0, 0x2f, 0xf0, 0xb4, 0xc7, 0x45, 0x2f, 0xe2, 0x41, 0xa4, 0x15, 0xfc, 0x1b, 0x92, 0x4c, 0xb2,
+	0x5d, 0xdd, 0x42, 0x61, 0x6f, 0xc9, 0xfb, 0xbe, 0xf7, 0xe5, 0xfb, 0xde, 0x7b, 0x21, 0x2e, 0x1f,
+	0x73, 0x35, 0x62, 0xa8, 0xe9, 0x98, 0x65, 0xfa, 0x90, 0x8e, 0x77, 0xa8, 0x9e, 0x06, 0xa9, 0x02,
+	0x0d, 0xf6, 0xad, 0x0a, 0x0b, 0x0a, 0x2c, 0x18, 0xef, 0xb8, 0x1b, 0x02, 0x04, 0x14, 0x28, 0xcd,
+	0x9f, 0x0c, 0xd1, 0xdd, 0x12, 0x00, 0x62, 0xc4, 0x29, 0x4b, 0x63, 0xca, 0xa4, 0x04, 0xcd, 0x74,
+	0x0c, 0x12, 0x4b, 0x74, 0x33, 0x02, 0x4c, 0x00, 0x69, 0x82, 0x22, 0x97, 0x4f, 0x50, 0x94, 0x40,
+	0xd3, 0x00, 0xa1, 0xd1, 0x33, 0x2f, 0x25, 0x74, 0xfb, 0xa2, 0x2d, 0xe3, 0xa1, 0x80, 0xfd, 0x77,
+	0x16, 0xf1, 0x7b, 0x28, 0xf6, 0xb3, 0x41, 0x12, 0xeb, 0x3d, 0x05, 0x70, 0xd0, 0x9d, 0x6a, 0xae,
+	0x24, 0x1b, 0xbd, 0x9a, 0x48, 0x3e, 0x6c, 0x47, 0x11, 0x64, 0x52, 0xdb, 0x5b, 0xa4, 0x81, 0x05,
+	0x45, 0x73, 0xe5, 0x58, 0x77, 0xac, 0xbb, 0x8d, 0xfe, 0xa2, 0x60, 0x3b, 0x64, 0x9d, 0x19, 0xa2,
+	0x53, 0x2f, 0xb0, 0x75, 0x76, 0xae, 0x2f, 0x16, 0x92, 0xe9, 0x4c, 0x71, 0x67, 0xad, 0xec, 0xab,
+	0x0a, 0x4f, 0x6f, 0x1c, 0xff, 0xf9, 0xd4, 0x5a, 0xe8, 0xf8, 0xf7, 0x49, 0x6b, 0xb5, 0x97, 0x3e,
+	0xc7, 0x14, 0x24, 0x72, 0xff, 0xa3, 0x45, 0x6e, 0xf6, 0x50, 0xbc, 0x4e, 0x87, 0x4c, 0xf3, 0x3d,
+	0xa6, 0x58, 0x82, 0xf6, 0x63, 0xd2, 0xc8, 0xc3, 0x81, 0x8a, 0xf5, 0x91, 0xf1, 0xd9, 0x71, 0xbe,
+	0x7e, 0x7e, 0xb0, 0x51, 0x8e, 0xa4, 0x3d, 0x1c, 0x2a, 0x8e, 0xb8, 0xaf, 0x55, 0x2c, 0x45, 0x7f,
+	0x41, 0xb5, 0x5f, 0x10, 0x22, 0xf9, 0x24, 0x4c, 0x0b, 0x95, 0x22, 0xc4, 0xb5, 0xdd, 0x66, 0x70,
+	0x61, 0x6b, 0x81, 0xf9, 0x4c, 0xe7, 0xca, 0xc9, 0xcf, 0xed, 0x5a, 0xbf, 0x21, 0xf9, 0xc4, 0x14,
+	0xca, 0x24, 0x67, 0x7a, 0x7e, 0x93, 0x6c, 0xfe, 0x67, 0xad, 0xb2, 0xbd, 0xfb, 0xa5, 0x4e, 0xd6,
+	0x7a, 0x28, 0xec, 0x1f, 0x16, 0xd9, 0x5e, 0x35, 0xf6, 0x47, 0x4b, 0x2c, 0xac, 0x9e, 0x90, 0xfb,
+	0xfc, 0x52, 0x6d, 0x67, 0x83, 0x6d, 0x1f, 0x7f, 0xfb, 0xfd, 0xa1, 0xfe, 0xcc, 0x7e, 0x42, 0x97,
+	0x9d, 0x34, 0x35, 0xfb, 0xca, 0x6f, 0x0d, 0x0e, 0x42, 0x5e, 0x0a, 0x85, 0x90, 0x2b, 0x85, 0xd5,
+	0xde, 0xdf, 0x92, 0xeb, 0xff, 0xec, 0xc5, 0x5f, 0xee, 0xe8, 0x3c, 0xc7, 0x6d, 0xad, 0xe6, 0x54,
+	0x16, 0x3b, 0x2f, 0x4f, 0x66, 0x9e, 0x75, 0x3a, 0xf3, 0xac, 0x5f, 0x33, 0xcf, 0x7a, 0x3f, 0xf7,
+	0x6a, 0xa7, 0x73, 0xaf, 0xf6, 0x7d, 0xee, 0xd5, 0xde, 0xdc, 0x13, 0xb1, 0x3e, 0xcc, 0x06, 0x41,
+	0x04, 0x09, 0xed, 0x62, 0xc4, 0x64, 0xa7, 0xbb, 0x88, 0x31, 0x2d, 0x83, 0xe8, 0xa3, 0x94, 0xe3,
+	0xe0, 0x6a, 0xf1, 0x0b, 0x3c, 0xfc, 0x3b, 0x00, 0x88, 0xd3, 0x12, 0x90, 0xba, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -184,6 +286,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SubmitProofExternalOwnedAccount submit proof that an account is external owned account (EOA)
 	SubmitProofExternalOwnedAccount(ctx context.Context, in *MsgSubmitProofExternalOwnedAccount, opts ...grpc.CallOption) (*MsgSubmitProofExternalOwnedAccountResponse, error)
+	// UpdateParams defined a governance operation for updating the x/vauth module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -203,10 +308,22 @@ func (c *msgClient) SubmitProofExternalOwnedAccount(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/everlast.vauth.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProofExternalOwnedAccount submit proof that an account is external owned account (EOA)
 	SubmitProofExternalOwnedAccount(context.Context, *MsgSubmitProofExternalOwnedAccount) (*MsgSubmitProofExternalOwnedAccountResponse, error)
+	// UpdateParams defined a governance operation for updating the x/vauth module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -216,6 +333,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitProofExternalOwnedAccount(ctx context.Context, req *MsgSubmitProofExternalOwnedAccount) (*MsgSubmitProofExternalOwnedAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofExternalOwnedAccount not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -239,6 +359,24 @@ func _Msg_SubmitProofExternalOwnedAccount_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/everlast.vauth.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "everlast.vauth.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -247,6 +385,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitProofExternalOwnedAccount",
 			Handler:    _Msg_SubmitProofExternalOwnedAccount_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "everlast/vauth/v1/tx.proto",
@@ -319,6 +461,69 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -360,6 +565,30 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -562,6 +791,171 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
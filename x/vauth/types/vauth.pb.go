@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -87,28 +88,95 @@ func (m *ProofExternalOwnedAccount) GetSignature() string {
 	return ""
 }
 
+// Params defines the vauth module params
+type Params struct {
+	// submission_fee is the amount charged for each submission of the proof of external owned account,
+	// used as an anti-spam fee.
+	SubmissionFee cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=submission_fee,json=submissionFee,proto3,customtype=cosmossdk.io/math.Int" json:"submission_fee"`
+	// submission_fee_denom is the denom of the submission fee.
+	SubmissionFeeDenom string `protobuf:"bytes,2,opt,name=submission_fee_denom,json=submissionFeeDenom,proto3" json:"submission_fee_denom,omitempty"`
+	// submission_fee_to_community_pool defines if the submission fee is funded to the community pool,
+	// otherwise the submission fee is burned.
+	SubmissionFeeToCommunityPool bool `protobuf:"varint,3,opt,name=submission_fee_to_community_pool,json=submissionFeeToCommunityPool,proto3" json:"submission_fee_to_community_pool,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e506c933a2ca858, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSubmissionFeeDenom() string {
+	if m != nil {
+		return m.SubmissionFeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetSubmissionFeeToCommunityPool() bool {
+	if m != nil {
+		return m.SubmissionFeeToCommunityPool
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ProofExternalOwnedAccount)(nil), "everlast.vauth.v1.ProofExternalOwnedAccount")
+	proto.RegisterType((*Params)(nil), "everlast.vauth.v1.Params")
 }
 
 func init() { proto.RegisterFile("everlast/vauth/v1/vauth.proto", fileDescriptor_0e506c933a2ca858) }
 
 var fileDescriptor_0e506c933a2ca858 = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2d, 0x4b, 0x2d,
-	0xca, 0x49, 0x2c, 0x2e, 0xd1, 0x2f, 0x4b, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x84, 0x30, 0xf4,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x61, 0xd2, 0x7a, 0x10, 0xd1, 0x32, 0x43, 0x29, 0x91,
-	0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0xac, 0x3e, 0x88, 0x05, 0x51, 0xa8, 0x94, 0xce, 0x25, 0x19, 0x50,
-	0x94, 0x9f, 0x9f, 0xe6, 0x5a, 0x51, 0x92, 0x5a, 0x94, 0x97, 0x98, 0xe3, 0x5f, 0x9e, 0x97, 0x9a,
-	0xe2, 0x98, 0x9c, 0x9c, 0x5f, 0x9a, 0x57, 0x22, 0x24, 0xc1, 0xc5, 0x9e, 0x08, 0x61, 0x4a, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0x19, 0x89, 0xc5, 0x19, 0x12,
-	0x4c, 0x60, 0x61, 0x30, 0x5b, 0x48, 0x86, 0x8b, 0xb3, 0x38, 0x33, 0x3d, 0x2f, 0xb1, 0xa4, 0xb4,
-	0x28, 0x55, 0x82, 0x19, 0x2c, 0x81, 0x10, 0x70, 0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0xcd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d,
-	0xd7, 0xe2, 0xe4, 0xc4, 0x3c, 0x27, 0x57, 0x7d, 0xb8, 0xef, 0x2a, 0xa0, 0xfe, 0x2b, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xda, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xb5, 0x52,
-	0x0d, 0xfe, 0x00, 0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4e, 0xf2, 0x40,
+	0x14, 0xc5, 0xdb, 0xef, 0x33, 0x28, 0x93, 0x68, 0xe2, 0x04, 0x93, 0x6a, 0xa0, 0x10, 0x56, 0xba,
+	0x69, 0x25, 0x3e, 0x81, 0xfc, 0x4b, 0x5c, 0x49, 0x88, 0x2b, 0x37, 0xcd, 0x50, 0x86, 0xb6, 0xb1,
+	0x33, 0x97, 0xf4, 0xde, 0x56, 0x78, 0x0b, 0x1f, 0x8b, 0x25, 0x4b, 0xe3, 0x82, 0x18, 0x78, 0x11,
+	0x43, 0x0b, 0x12, 0xdc, 0x9d, 0x39, 0xbf, 0x73, 0x6e, 0x6e, 0xe6, 0xb2, 0x9a, 0xcc, 0x64, 0x12,
+	0x0b, 0x24, 0x37, 0x13, 0x29, 0x85, 0x6e, 0xd6, 0x2a, 0x84, 0x33, 0x4d, 0x80, 0x80, 0x5f, 0xee,
+	0xb1, 0x53, 0xb8, 0x59, 0xeb, 0xa6, 0x12, 0x40, 0x00, 0x39, 0x75, 0xb7, 0xaa, 0x08, 0x36, 0x03,
+	0x76, 0x3d, 0x48, 0x00, 0x26, 0xbd, 0x19, 0xc9, 0x44, 0x8b, 0xf8, 0xf9, 0x5d, 0xcb, 0xf1, 0xa3,
+	0xef, 0x43, 0xaa, 0x89, 0x5b, 0xec, 0x54, 0x14, 0xd2, 0x32, 0x1b, 0xe6, 0x6d, 0x79, 0xb8, 0x7f,
+	0x72, 0xce, 0x4e, 0x42, 0x81, 0xa1, 0xf5, 0x2f, 0xb7, 0x73, 0xcd, 0xab, 0xac, 0x8c, 0x51, 0xa0,
+	0x05, 0xa5, 0x89, 0xb4, 0xfe, 0xe7, 0xe0, 0x60, 0x34, 0x17, 0x26, 0x2b, 0x0d, 0x44, 0x22, 0x14,
+	0xf2, 0x2e, 0xbb, 0xc0, 0x74, 0xa4, 0x22, 0xc4, 0x08, 0xb4, 0x37, 0x91, 0xb2, 0x98, 0xde, 0xae,
+	0x2d, 0x56, 0x75, 0xe3, 0x6b, 0x55, 0xbf, 0xf2, 0x01, 0x15, 0x20, 0x8e, 0xdf, 0x9c, 0x08, 0x5c,
+	0x25, 0x28, 0x74, 0x9e, 0x34, 0x0d, 0xcf, 0x0f, 0xa5, 0xbe, 0x94, 0xfc, 0x9e, 0x55, 0x8e, 0xa7,
+	0x78, 0x63, 0xa9, 0x41, 0xed, 0x56, 0xe2, 0x47, 0xe1, 0xee, 0x96, 0xf0, 0x3e, 0x6b, 0xfc, 0x69,
+	0x10, 0x78, 0x3e, 0x28, 0x95, 0xea, 0x88, 0xe6, 0xde, 0x14, 0x20, 0xce, 0xf7, 0x3e, 0x1b, 0x56,
+	0x8f, 0xda, 0x2f, 0xd0, 0xd9, 0x87, 0x06, 0x00, 0x71, 0xbb, 0xb3, 0x58, 0xdb, 0xe6, 0x72, 0x6d,
+	0x9b, 0xdf, 0x6b, 0xdb, 0xfc, 0xd8, 0xd8, 0xc6, 0x72, 0x63, 0x1b, 0x9f, 0x1b, 0xdb, 0x78, 0xbd,
+	0x0b, 0x22, 0x0a, 0xd3, 0x91, 0xe3, 0x83, 0x72, 0x7b, 0xe8, 0x0b, 0xdd, 0xee, 0xb9, 0xbf, 0x87,
+	0x9a, 0xed, 0x4e, 0x45, 0xf3, 0xa9, 0xc4, 0x51, 0x29, 0xff, 0xff, 0x87, 0x9f, 0x01, 0x00, 0x5c,
+	0x78, 0xcd, 0x6a, 0xc9, 0x01, 0x00, 0x00,
 }
 
 func (m *ProofExternalOwnedAccount) Marshal() (dAtA []byte, err error) {
@@ -155,6 +223,56 @@ func (m *ProofExternalOwnedAccount) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmissionFeeToCommunityPool {
+		i--
+		if m.SubmissionFeeToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SubmissionFeeDenom) > 0 {
+		i -= len(m.SubmissionFeeDenom)
+		copy(dAtA[i:], m.SubmissionFeeDenom)
+		i = encodeVarintVauth(dAtA, i, uint64(len(m.SubmissionFeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SubmissionFee.Size()
+		i -= size
+		if _, err := m.SubmissionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVauth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVauth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVauth(v)
 	base := offset
@@ -187,6 +305,24 @@ func (m *ProofExternalOwnedAccount) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubmissionFee.Size()
+	n += 1 + l + sovVauth(uint64(l))
+	l = len(m.SubmissionFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovVauth(uint64(l))
+	}
+	if m.SubmissionFeeToCommunityPool {
+		n += 2
+	}
+	return n
+}
+
 func sovVauth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -339,6 +475,142 @@ func (m *ProofExternalOwnedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVauth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmissionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmissionFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionFeeToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SubmissionFeeToCommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVauth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVauth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0